@@ -20,6 +20,7 @@ import (
 	"github.com/maticnetwork/heimdall/bor"
 	borTypes "github.com/maticnetwork/heimdall/bor/types"
 	"github.com/maticnetwork/heimdall/chainmanager"
+	chainmanagerClient "github.com/maticnetwork/heimdall/chainmanager/client"
 	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/checkpoint"
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
@@ -45,6 +46,9 @@ import (
 	topupTypes "github.com/maticnetwork/heimdall/topup/types"
 	"github.com/maticnetwork/heimdall/types"
 	hmModule "github.com/maticnetwork/heimdall/types/module"
+	"github.com/maticnetwork/heimdall/upgrade"
+	upgradeClient "github.com/maticnetwork/heimdall/upgrade/client"
+	upgradeTypes "github.com/maticnetwork/heimdall/upgrade/types"
 	"github.com/maticnetwork/heimdall/version"
 )
 
@@ -78,7 +82,13 @@ var (
 		clerk.AppModuleBasic{},
 		topup.AppModuleBasic{},
 		slashing.AppModuleBasic{},
-		gov.NewAppModuleBasic(paramsClient.ProposalHandler),
+		gov.NewAppModuleBasic(
+			paramsClient.ProposalHandler,
+			chainmanagerClient.ProposalHandler,
+			upgradeClient.ProposalHandler,
+			upgradeClient.CancelProposalHandler,
+		),
+		upgrade.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -116,6 +126,7 @@ type HeimdallApp struct {
 	ClerkKeeper       clerk.Keeper
 	TopupKeeper       topup.Keeper
 	SlashingKeeper    slashing.Keeper
+	UpgradeKeeper     upgrade.Keeper
 
 	// param keeper
 	ParamsKeeper params.Keeper
@@ -219,6 +230,7 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		clerkTypes.StoreKey,
 		topupTypes.StoreKey,
 		paramsTypes.StoreKey,
+		upgradeTypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramsTypes.TStoreKey)
 
@@ -329,11 +341,21 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		app.BankKeeper,
 	)
 
+	// upgrade keeper
+	app.UpgradeKeeper = upgrade.NewKeeper(
+		app.cdc,
+		keys[upgradeTypes.StoreKey], // target store
+		upgradeTypes.DefaultCodespace,
+	)
+	app.registerUpgradeHandlers()
+
 	// register the proposal types
 	govRouter := gov.NewRouter()
 	govRouter.
 		AddRoute(govTypes.RouterKey, govTypes.ProposalHandler).
-		AddRoute(paramsTypes.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper))
+		AddRoute(paramsTypes.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(chainmanagerTypes.RouterKey, chainmanager.NewChainParamsProposalHandler(app.ChainKeeper)).
+		AddRoute(upgradeTypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper))
 
 	app.GovKeeper = gov.NewKeeper(
 		app.cdc,
@@ -386,7 +408,9 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
+	// Upgrade module comes first, so the node halts before other begin blockers run at upgrade height.
 	app.mm = module.NewManager(
+		upgrade.NewAppModule(app.UpgradeKeeper),
 		sidechannel.NewAppModule(app.SidechannelKeeper),
 		auth.NewAppModule(app.AccountKeeper, &app.caller, []authTypes.AccountProcessor{
 			supplyTypes.AccountProcessor,
@@ -418,6 +442,7 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		borTypes.ModuleName,
 		clerkTypes.ModuleName,
 		topupTypes.ModuleName,
+		upgradeTypes.ModuleName,
 	)
 
 	// register message routes and query routes
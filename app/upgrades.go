@@ -0,0 +1,16 @@
+package app
+
+// registerUpgradeHandlers registers handlers of upgrade plans this binary knows.
+//
+// A hard fork is shipped as a software upgrade proposal with a plan name and height. Nodes halt at the
+// plan height until they are restarted with a binary which registers the handler for the plan name here,
+// the handler then runs state migrations of the fork at that height. For example:
+//
+//	app.UpgradeKeeper.SetUpgradeHandler("v0.3.0", func(ctx sdk.Context, plan upgradeTypes.Plan) {
+//		// migrate state
+//	})
+//
+// Nodes syncing from genesis need the old binary till each plan height, since a binary halts
+// before the height of a plan it has handler for.
+func (app *HeimdallApp) registerUpgradeHandlers() {
+}
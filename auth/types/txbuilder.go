@@ -179,12 +179,16 @@ func (bldr TxBuilder) WithAccountNumber(accnum uint64) TxBuilder {
 
 // BuildSignMsg builds a single message to be signed from a TxBuilder given a
 // set of messages. It returns an error if a fee is supplied but cannot be
-// parsed.
+// parsed, or if there isn't exactly one message as StdTx carries only one.
 func (bldr TxBuilder) BuildSignMsg(msgs []sdk.Msg) (StdSignMsg, error) {
 	if bldr.chainID == "" {
 		return StdSignMsg{}, fmt.Errorf("chain ID required but not specified")
 	}
 
+	if len(msgs) != 1 {
+		return StdSignMsg{}, fmt.Errorf("tx must have exactly one message, got %d", len(msgs))
+	}
+
 	return StdSignMsg{
 		ChainID:       bldr.chainID,
 		AccountNumber: bldr.accountNumber,
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildSignMsg(t *testing.T) {
+	msg := sdk.NewTestMsg(addr)
+	bldr := NewTxBuilder(nil, 1, 2, 0, 0, false, "mychainid", "memo", nil, nil).WithAccountNumber(3)
+
+	signMsg, err := bldr.BuildSignMsg([]sdk.Msg{msg})
+	require.NoError(t, err)
+	require.Equal(t, msg, signMsg.Msg)
+	require.Equal(t, uint64(3), signMsg.AccountNumber)
+	require.Equal(t, uint64(2), signMsg.Sequence)
+
+	// StdTx carries one message, others would be dropped
+	_, err = bldr.BuildSignMsg([]sdk.Msg{msg, sdk.NewTestMsg(addr)})
+	require.Error(t, err)
+
+	_, err = bldr.BuildSignMsg(nil)
+	require.Error(t, err)
+}
@@ -60,7 +60,7 @@ func GetSpan(cdc *codec.Codec) *cobra.Command {
 			}
 
 			// get query params
-			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQuerySpanParams(spanID))
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryChainSpanParams(viper.GetString(FlagBorChainId), spanID))
 			if err != nil {
 				return err
 			}
@@ -81,6 +81,7 @@ func GetSpan(cdc *codec.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().Uint64(FlagSpanId, 0, "--id=<span ID here>")
+	cmd.Flags().String(FlagBorChainId, "", "--bor-chain-id=<bor chain ID here, default bor chain if empty>")
 	if err := cmd.MarkFlagRequired(FlagSpanId); err != nil {
 		cliLogger.Error("GetSpan | MarkFlagRequired | FlagSpanId", "Error", err)
 	}
@@ -96,8 +97,14 @@ func GetLatestSpan(cdc *codec.Codec) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorChainID(viper.GetString(FlagBorChainId)))
+			if err != nil {
+				return err
+			}
+
 			// fetch latest span
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLatestSpan), nil)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLatestSpan), queryParams)
 
 			// fetch span
 			if err != nil {
@@ -113,6 +120,8 @@ func GetLatestSpan(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagBorChainId, "", "--bor-chain-id=<bor chain ID here, default bor chain if empty>")
+
 	return cmd
 }
 
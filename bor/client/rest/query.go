@@ -45,7 +45,14 @@ func fetchNextSpanSeedHandlerFn(
 			return
 		}
 
-		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNextSpanSeed), nil)
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorChainID(r.URL.Query().Get("bor_chain_id")))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNextSpanSeed), queryParams)
 		RestLogger.Debug("nextSpanSeed querier response", "res", res)
 
 		if err != nil {
@@ -90,7 +97,7 @@ func spanListHandlerFn(
 		}
 
 		// get query params
-		queryParams, err := cliCtx.Codec.MarshalJSON(hmTypes.NewQueryPaginationParams(page, limit))
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQuerySpanListParams(vars.Get("bor_chain_id"), page, limit))
 		if err != nil {
 			return
 		}
@@ -126,6 +133,9 @@ func spanHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		// spans of additional bor chains are queried by bor chain id
+		borChainID := r.URL.Query().Get("bor_chain_id")
+
 		var (
 			res            []byte
 			height         int64
@@ -136,7 +146,7 @@ func spanHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			loadSpanOverrides()
 		}
 
-		if span, ok := spanOverrides[spanID]; ok {
+		if span, ok := spanOverrides[spanID]; ok && borChainID == "" {
 			res = span.Result
 			height = span.Height
 			spanOverridden = true
@@ -144,7 +154,7 @@ func spanHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 
 		if !spanOverridden {
 			// get query params
-			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQuerySpanParams(spanID))
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryChainSpanParams(borChainID, spanID))
 			if err != nil {
 				return
 			}
@@ -174,8 +184,15 @@ func latestSpanHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorChainID(r.URL.Query().Get("bor_chain_id")))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		// fetch latest span
-		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLatestSpan), nil)
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLatestSpan), queryParams)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -282,7 +299,13 @@ func prepareNextSpanHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		// Fetching SelectedProducers
 		//
 
-		nextProducerBytes, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNextProducers), nil)
+		nextProducerParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorChainID(chainID))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		nextProducerBytes, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNextProducers), nextProducerParams)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -14,15 +14,25 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	if len(data.Spans) > 0 {
 		// sort data spans before inserting to ensure lastspanId fetched is correct
 		hmTypes.SortSpanByID(data.Spans)
-		// add new span
+		// add new span, keeping last span of each bor chain
+		chainParams := keeper.chainKeeper.GetParams(ctx).ChainParams
+		lastSpanIDs := make(map[string]uint64)
 		for _, span := range data.Spans {
 			if err := keeper.AddNewRawSpan(ctx, *span); err != nil {
 				keeper.Logger(ctx).Error("Error AddNewRawSpan", "error", err)
 			}
+
+			chainID := ""
+			if chainParams.IsAdditionalBorChain(span.ChainID) {
+				chainID = span.ChainID
+			}
+			lastSpanIDs[chainID] = span.ID
 		}
 
 		// update last span
-		keeper.UpdateLastSpan(ctx, data.Spans[len(data.Spans)-1].ID)
+		for chainID, spanID := range lastSpanIDs {
+			keeper.UpdateChainLastSpan(ctx, chainID, spanID)
+		}
 	}
 }
 
@@ -37,20 +37,31 @@ func HandleMsgProposeSpan(ctx sdk.Context, msg types.MsgProposeSpan, k Keeper) s
 	chainParams := params.ChainParams
 
 	// check chain id
-	if chainParams.BorChainID != msg.ChainID {
+	if msg.ChainID == "" || !chainParams.IsKnownBorChain(msg.ChainID) {
 		k.Logger(ctx).Error("Invalid Bor chain id", "msgChainID", msg.ChainID)
 		return common.ErrInvalidBorChainID(k.Codespace()).Result()
 	}
 
 	// check if last span is up or if greater diff than threshold is found between validator set
-	lastSpan, err := k.GetLastSpan(ctx)
+	lastSpan, err := k.GetChainLastSpan(ctx, msg.ChainID)
 	if err != nil {
-		k.Logger(ctx).Error("Unable to fetch last span", "Error", err)
-		return common.ErrSpanNotFound(k.Codespace()).Result()
-	}
+		// first span of an additional bor chain starts its span sequence at zero
+		if !chainParams.IsAdditionalBorChain(msg.ChainID) || k.HasChainSpan(ctx, msg.ChainID, 0) {
+			k.Logger(ctx).Error("Unable to fetch last span", "Error", err)
+			return common.ErrSpanNotFound(k.Codespace()).Result()
+		}
 
-	// Validate span continuity
-	if lastSpan.ID+1 != msg.ID || msg.StartBlock != lastSpan.EndBlock+1 || msg.EndBlock < msg.StartBlock {
+		if msg.ID != 0 || msg.StartBlock != 0 || msg.EndBlock < msg.StartBlock {
+			k.Logger(ctx).Error("First span of bor chain not starting at zero",
+				"chainID", msg.ChainID,
+				"spanId", msg.ID,
+				"spanStartBlock", msg.StartBlock,
+				"spanEndBlock", msg.EndBlock,
+			)
+			return common.ErrSpanNotInCountinuity(k.Codespace()).Result()
+		}
+	} else if lastSpan.ID+1 != msg.ID || msg.StartBlock != lastSpan.EndBlock+1 || msg.EndBlock < msg.StartBlock {
+		// Validate span continuity
 		k.Logger(ctx).Error("Blocks not in countinuity",
 			"lastSpanId", lastSpan.ID,
 			"spanId", msg.ID,
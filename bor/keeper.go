@@ -83,9 +83,14 @@ func GetSpanKey(id uint64) []byte {
 	return append(SpanPrefixKey, []byte(strconv.FormatUint(id, 10))...)
 }
 
+// chainStore returns the store keeping spans of the bor chain
+func (k *Keeper) chainStore(ctx sdk.Context, chainID string) sdk.KVStore {
+	return k.chainKeeper.BorChainStore(ctx, ctx.KVStore(k.storeKey), chainID)
+}
+
 // AddNewSpan adds new span for bor to store
 func (k *Keeper) AddNewSpan(ctx sdk.Context, span hmTypes.Span) error {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx, span.ChainID)
 	out, err := k.cdc.MarshalBinaryBare(span)
 	if err != nil {
 		k.Logger(ctx).Error("Error marshalling span", "error", err)
@@ -96,13 +101,13 @@ func (k *Keeper) AddNewSpan(ctx sdk.Context, span hmTypes.Span) error {
 	store.Set(GetSpanKey(span.ID), out)
 
 	// update last span
-	k.UpdateLastSpan(ctx, span.ID)
+	k.UpdateChainLastSpan(ctx, span.ChainID, span.ID)
 	return nil
 }
 
 // AddNewRawSpan adds new span for bor to store
 func (k *Keeper) AddNewRawSpan(ctx sdk.Context, span hmTypes.Span) error {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx, span.ChainID)
 	out, err := k.cdc.MarshalBinaryBare(span)
 	if err != nil {
 		k.Logger(ctx).Error("Error marshalling span", "error", err)
@@ -114,7 +119,12 @@ func (k *Keeper) AddNewRawSpan(ctx sdk.Context, span hmTypes.Span) error {
 
 // GetSpan fetches span indexed by id from store
 func (k *Keeper) GetSpan(ctx sdk.Context, id uint64) (*hmTypes.Span, error) {
-	store := ctx.KVStore(k.storeKey)
+	return k.GetChainSpan(ctx, "", id)
+}
+
+// GetChainSpan fetches span of the bor chain indexed by id from store
+func (k *Keeper) GetChainSpan(ctx sdk.Context, chainID string, id uint64) (*hmTypes.Span, error) {
+	store := k.chainStore(ctx, chainID)
 	spanKey := GetSpanKey(id)
 
 	// If we are starting from 0 there will be no spanKey present
@@ -131,15 +141,29 @@ func (k *Keeper) GetSpan(ctx sdk.Context, id uint64) (*hmTypes.Span, error) {
 }
 
 func (k *Keeper) HasSpan(ctx sdk.Context, id uint64) bool {
-	store := ctx.KVStore(k.storeKey)
+	return k.HasChainSpan(ctx, "", id)
+}
+
+// HasChainSpan returns true if span of the bor chain is present in store
+func (k *Keeper) HasChainSpan(ctx sdk.Context, chainID string, id uint64) bool {
+	store := k.chainStore(ctx, chainID)
 	spanKey := GetSpanKey(id)
 	return store.Has(spanKey)
 }
 
-// GetAllSpans fetches all indexed by id from store
+// GetAllSpans fetches spans of all bor chains from store
 func (k *Keeper) GetAllSpans(ctx sdk.Context) (spans []*hmTypes.Span) {
+	for _, chainID := range k.chainKeeper.GetParams(ctx).ChainParams.BorChainIDs() {
+		spans = append(spans, k.GetAllChainSpans(ctx, chainID)...)
+	}
+
+	return
+}
+
+// GetAllChainSpans fetches all spans of the bor chain indexed by id from store
+func (k *Keeper) GetAllChainSpans(ctx sdk.Context, chainID string) (spans []*hmTypes.Span) {
 	// iterate through spans and create span update array
-	k.IterateSpansAndApplyFn(ctx, func(span hmTypes.Span) error {
+	k.IterateChainSpansAndApplyFn(ctx, chainID, func(span hmTypes.Span) error {
 		// append to list of validatorUpdates
 		spans = append(spans, &span)
 		return nil
@@ -150,7 +174,12 @@ func (k *Keeper) GetAllSpans(ctx sdk.Context) (spans []*hmTypes.Span) {
 
 // GetSpanList returns all spans with params like page and limit
 func (k *Keeper) GetSpanList(ctx sdk.Context, page uint64, limit uint64) ([]hmTypes.Span, error) {
-	store := ctx.KVStore(k.storeKey)
+	return k.GetChainSpanList(ctx, "", page, limit)
+}
+
+// GetChainSpanList returns spans of the bor chain with params like page and limit
+func (k *Keeper) GetChainSpanList(ctx sdk.Context, chainID string, page uint64, limit uint64) ([]hmTypes.Span, error) {
+	store := k.chainStore(ctx, chainID)
 
 	// create spans
 	var spans []hmTypes.Span
@@ -176,7 +205,12 @@ func (k *Keeper) GetSpanList(ctx sdk.Context, page uint64, limit uint64) ([]hmTy
 
 // GetLastSpan fetches last span using lastStartBlock
 func (k *Keeper) GetLastSpan(ctx sdk.Context) (*hmTypes.Span, error) {
-	store := ctx.KVStore(k.storeKey)
+	return k.GetChainLastSpan(ctx, "")
+}
+
+// GetChainLastSpan fetches last span of the bor chain
+func (k *Keeper) GetChainLastSpan(ctx sdk.Context, chainID string) (*hmTypes.Span, error) {
+	store := k.chainStore(ctx, chainID)
 
 	var lastSpanID uint64
 	if store.Has(LastSpanIDKey) {
@@ -188,7 +222,7 @@ func (k *Keeper) GetLastSpan(ctx sdk.Context) (*hmTypes.Span, error) {
 		}
 	}
 
-	return k.GetSpan(ctx, lastSpanID)
+	return k.GetChainSpan(ctx, chainID, lastSpanID)
 }
 
 // FreezeSet freezes validator set for next span
@@ -200,7 +234,7 @@ func (k *Keeper) FreezeSet(ctx sdk.Context, id uint64, startBlock uint64, endBlo
 	}
 
 	// increment last eth block
-	k.IncrementLastEthBlock(ctx)
+	k.IncrementChainLastEthBlock(ctx, borChainID)
 
 	// generate new span
 	newSpan := hmTypes.NewSpan(
@@ -259,13 +293,23 @@ func (k *Keeper) SelectNextProducers(ctx sdk.Context, seed common.Hash) (vals []
 
 // UpdateLastSpan updates the last span start block
 func (k *Keeper) UpdateLastSpan(ctx sdk.Context, id uint64) {
-	store := ctx.KVStore(k.storeKey)
+	k.UpdateChainLastSpan(ctx, "", id)
+}
+
+// UpdateChainLastSpan updates the last span of the bor chain
+func (k *Keeper) UpdateChainLastSpan(ctx sdk.Context, chainID string, id uint64) {
+	store := k.chainStore(ctx, chainID)
 	store.Set(LastSpanIDKey, []byte(strconv.FormatUint(id, 10)))
 }
 
 // IncrementLastEthBlock increment last eth block
 func (k *Keeper) IncrementLastEthBlock(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
+	k.IncrementChainLastEthBlock(ctx, "")
+}
+
+// IncrementChainLastEthBlock increment last eth block used for seed of the bor chain
+func (k *Keeper) IncrementChainLastEthBlock(ctx sdk.Context, chainID string) {
+	store := k.chainStore(ctx, chainID)
 	lastEthBlock := big.NewInt(0)
 	if store.Has(LastProcessedEthBlock) {
 		lastEthBlock = lastEthBlock.SetBytes(store.Get(LastProcessedEthBlock))
@@ -281,7 +325,12 @@ func (k *Keeper) SetLastEthBlock(ctx sdk.Context, blockNumber *big.Int) {
 
 // GetLastEthBlock get last processed Eth block for seed
 func (k *Keeper) GetLastEthBlock(ctx sdk.Context) *big.Int {
-	store := ctx.KVStore(k.storeKey)
+	return k.GetChainLastEthBlock(ctx, "")
+}
+
+// GetChainLastEthBlock get last processed Eth block for seed of the bor chain
+func (k *Keeper) GetChainLastEthBlock(ctx sdk.Context, chainID string) *big.Int {
+	store := k.chainStore(ctx, chainID)
 	lastEthBlock := big.NewInt(0)
 	if store.Has(LastProcessedEthBlock) {
 		lastEthBlock = lastEthBlock.SetBytes(store.Get(LastProcessedEthBlock))
@@ -290,7 +339,12 @@ func (k *Keeper) GetLastEthBlock(ctx sdk.Context) *big.Int {
 }
 
 func (k Keeper) GetNextSpanSeed(ctx sdk.Context) (common.Hash, error) {
-	lastEthBlock := k.GetLastEthBlock(ctx)
+	return k.GetChainNextSpanSeed(ctx, "")
+}
+
+// GetChainNextSpanSeed returns seed of the next span of the bor chain
+func (k Keeper) GetChainNextSpanSeed(ctx sdk.Context, chainID string) (common.Hash, error) {
+	lastEthBlock := k.GetChainLastEthBlock(ctx, chainID)
 
 	// increment last processed header block number
 	newEthBlock := lastEthBlock.Add(lastEthBlock, big.NewInt(1))
@@ -326,7 +380,12 @@ func (k *Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 
 // IterateSpansAndApplyFn interate spans and apply the given function.
 func (k *Keeper) IterateSpansAndApplyFn(ctx sdk.Context, f func(span hmTypes.Span) error) {
-	store := ctx.KVStore(k.storeKey)
+	k.IterateChainSpansAndApplyFn(ctx, "", f)
+}
+
+// IterateChainSpansAndApplyFn interate spans of the bor chain and apply the given function.
+func (k *Keeper) IterateChainSpansAndApplyFn(ctx sdk.Context, chainID string, f func(span hmTypes.Span) error) {
+	store := k.chainStore(ctx, chainID)
 
 	// get span iterator
 	iterator := sdk.KVStorePrefixIterator(store, SpanPrefixKey)
@@ -8,6 +8,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/maticnetwork/heimdall/bor/types"
+	"github.com/maticnetwork/heimdall/common"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
@@ -81,7 +82,11 @@ func handleQuerySpan(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]b
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
-	span, err := keeper.GetSpan(ctx, params.RecordID)
+	if !keeper.chainKeeper.IsKnownBorChain(ctx, params.BorChainID) {
+		return nil, common.ErrInvalidBorChainID(keeper.Codespace())
+	}
+
+	span, err := keeper.GetChainSpan(ctx, params.BorChainID, params.RecordID)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not get span", err.Error()))
 	}
@@ -100,12 +105,16 @@ func handleQuerySpan(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]b
 }
 
 func handleQuerySpanList(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	var params hmTypes.QueryPaginationParams
+	var params types.QuerySpanListParams
 	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
-	res, err := keeper.GetSpanList(ctx, params.Page, params.Limit)
+	if !keeper.chainKeeper.IsKnownBorChain(ctx, params.BorChainID) {
+		return nil, common.ErrInvalidBorChainID(keeper.Codespace())
+	}
+
+	res, err := keeper.GetChainSpanList(ctx, params.BorChainID, params.Page, params.Limit)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch span list with page %v and limit %v", params.Page, params.Limit), err.Error()))
 	}
@@ -118,8 +127,13 @@ func handleQuerySpanList(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 }
 
 func handleQueryLatestSpan(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	chainID, sdkErr := queryBorChainID(ctx, req, keeper)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
 	var defaultSpan hmTypes.Span
-	spans := keeper.GetAllSpans(ctx)
+	spans := keeper.GetAllChainSpans(ctx, chainID)
 	// if this is the first span return empty span
 	if len(spans) == 0 {
 		// json record
@@ -131,7 +145,7 @@ func handleQueryLatestSpan(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 	}
 
 	// explcitly fetch the last span
-	span, err := keeper.GetLastSpan(ctx)
+	span, err := keeper.GetChainLastSpan(ctx, chainID)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not get span", err.Error()))
 	}
@@ -150,7 +164,12 @@ func handleQueryLatestSpan(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 }
 
 func handleQueryNextProducers(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	nextSpanSeed, err := keeper.GetNextSpanSeed(ctx)
+	chainID, sdkErr := queryBorChainID(ctx, req, keeper)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	nextSpanSeed, err := keeper.GetChainNextSpanSeed(ctx, chainID)
 	if err != nil {
 		return nil, sdk.ErrInternal((sdk.AppendMsgToErr("cannot fetch next span seed from keeper", err.Error())))
 	}
@@ -168,7 +187,12 @@ func handleQueryNextProducers(ctx sdk.Context, req abci.RequestQuery, keeper Kee
 }
 
 func handlerQueryNextSpanSeed(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	nextSpanSeed, err := keeper.GetNextSpanSeed(ctx)
+	chainID, sdkErr := queryBorChainID(ctx, req, keeper)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	nextSpanSeed, err := keeper.GetChainNextSpanSeed(ctx, chainID)
 
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("Error fetching next span seed", err.Error()))
@@ -181,3 +205,21 @@ func handlerQueryNextSpanSeed(ctx sdk.Context, req abci.RequestQuery, keeper Kee
 	}
 	return bz, nil
 }
+
+// queryBorChainID returns bor chain id of optional query params, empty for the default bor chain
+func queryBorChainID(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (string, sdk.Error) {
+	if len(req.Data) == 0 {
+		return "", nil
+	}
+
+	var params types.QueryBorChainID
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return "", sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if !keeper.chainKeeper.IsKnownBorChain(ctx, params.BorChainID) {
+		return "", common.ErrInvalidBorChainID(keeper.Codespace())
+	}
+
+	return params.BorChainID, nil
+}
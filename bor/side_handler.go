@@ -52,7 +52,7 @@ func SideHandleMsgSpan(ctx sdk.Context, k Keeper, msg types.MsgProposeSpan, cont
 	)
 
 	// calculate next span seed locally
-	nextSpanSeed, err := k.GetNextSpanSeed(ctx)
+	nextSpanSeed, err := k.GetChainNextSpanSeed(ctx, msg.ChainID)
 	if err != nil {
 		k.Logger(ctx).Error("Error fetching next span seed from mainchain")
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
@@ -68,15 +68,29 @@ func SideHandleMsgSpan(ctx sdk.Context, k Keeper, msg types.MsgProposeSpan, cont
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
 	}
 
+	// contract caller of the bor chain
+	borChainCaller, err := k.chainKeeper.GetBorChainCaller(ctx, contractCaller, msg.ChainID)
+	if err != nil {
+		k.Logger(ctx).Error("Error fetching contract caller of bor chain", "chainID", msg.ChainID, "error", err)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
 	// fetch current child block
-	childBlock, err := contractCaller.GetMaticChainBlock(nil)
+	childBlock, err := borChainCaller.GetMaticChainBlock(nil)
 	if err != nil {
 		k.Logger(ctx).Error("Error fetching current child block", "error", err)
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
 	}
 
-	lastSpan, err := k.GetLastSpan(ctx)
+	lastSpan, err := k.GetChainLastSpan(ctx, msg.ChainID)
 	if err != nil {
+		// first span of an additional bor chain has no span to be in-turn with
+		if msg.ID == 0 && k.chainKeeper.GetParams(ctx).ChainParams.IsAdditionalBorChain(msg.ChainID) {
+			k.Logger(ctx).Debug("✅ Succesfully validated External call for first span msg of bor chain", "chainID", msg.ChainID)
+			result.Result = abci.SideTxResultType_Yes
+			return
+		}
+
 		k.Logger(ctx).Error("Error fetching last span", "error", err)
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
 	}
@@ -107,7 +121,7 @@ func PostHandleMsgEventSpan(ctx sdk.Context, k Keeper, msg types.MsgProposeSpan,
 	}
 
 	// check for replay
-	if k.HasSpan(ctx, msg.ID) {
+	if k.HasChainSpan(ctx, msg.ChainID, msg.ID) {
 		k.Logger(ctx).Debug("Skipping new span as it's already processed")
 		return hmCommon.ErrOldTx(k.Codespace()).Result()
 	}
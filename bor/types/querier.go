@@ -18,10 +18,38 @@ const (
 
 // QuerySpanParams defines the params for querying accounts.
 type QuerySpanParams struct {
-	RecordID uint64
+	RecordID   uint64
+	BorChainID string
 }
 
 // NewQuerySpanParams creates a new instance of QuerySpanParams.
 func NewQuerySpanParams(recordID uint64) QuerySpanParams {
 	return QuerySpanParams{RecordID: recordID}
 }
+
+// NewQueryChainSpanParams creates a new instance of QuerySpanParams for span of the bor chain.
+func NewQueryChainSpanParams(borChainID string, recordID uint64) QuerySpanParams {
+	return QuerySpanParams{RecordID: recordID, BorChainID: borChainID}
+}
+
+// QuerySpanListParams defines the params for querying spans of a bor chain.
+type QuerySpanListParams struct {
+	BorChainID string
+	Page       uint64
+	Limit      uint64
+}
+
+// NewQuerySpanListParams creates a new instance of QuerySpanListParams.
+func NewQuerySpanListParams(borChainID string, page uint64, limit uint64) QuerySpanListParams {
+	return QuerySpanListParams{BorChainID: borChainID, Page: page, Limit: limit}
+}
+
+// QueryBorChainID defines the params for querying with bor chain id
+type QueryBorChainID struct {
+	BorChainID string
+}
+
+// NewQueryBorChainID creates a new instance of QueryBorChainID with give chain id
+func NewQueryBorChainID(chainID string) QueryBorChainID {
+	return QueryBorChainID{BorChainID: chainID}
+}
@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	httpClient "github.com/tendermint/tendermint/rpc/client"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/bridge/setu/listener"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const (
+	cursorListenerFlag = "listener"
+	replayFromFlag     = "from"
+	replayToFlag       = "to"
+)
+
+// cursorCmd represents the listener cursor commands
+var cursorCmd = &cobra.Command{
+	Use:   "cursor",
+	Short: "Inspect and move last processed block of a listener (bridge must be stopped)",
+}
+
+var cursorGetCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Show last processed block of listener",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		db := getBridgeDB()
+		defer util.CloseBridgeDBInstance()
+
+		name, _ := cmd.Flags().GetString(cursorListenerFlag)
+		block, found, err := listener.GetCursor(db, name)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			fmt.Println("No cursor stored for", name, "listener")
+			return nil
+		}
+
+		fmt.Println(block)
+		return nil
+	},
+}
+
+var cursorSetCmd = &cobra.Command{
+	Use:   "set [block]",
+	Short: "Set last processed block of listener, it continues from the next block",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		block, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid block %v: %v", args[0], err)
+		}
+
+		db := getBridgeDB()
+		defer util.CloseBridgeDBInstance()
+
+		name, _ := cmd.Flags().GetString(cursorListenerFlag)
+		if err := listener.SetCursor(db, name, block); err != nil {
+			return err
+		}
+
+		fmt.Println("Set", name, "cursor to", block)
+		return nil
+	},
+}
+
+var cursorRewindCmd = &cobra.Command{
+	Use:   "rewind [blocks]",
+	Short: "Move last processed block of listener back by given number of blocks",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		blocks, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number of blocks %v: %v", args[0], err)
+		}
+
+		db := getBridgeDB()
+		defer util.CloseBridgeDBInstance()
+
+		name, _ := cmd.Flags().GetString(cursorListenerFlag)
+		block, err := listener.RewindCursor(db, name, blocks)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println("Rewound", name, "cursor to", block)
+		return nil
+	},
+}
+
+var cursorReplayCmd = &cobra.Command{
+	Use:   "replay",
+	Short: "Send tasks for listener events between --from and --to blocks once and exit, cursor is not moved",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name, _ := cmd.Flags().GetString(cursorListenerFlag)
+		fromBlock, _ := cmd.Flags().GetUint64(replayFromFlag)
+		toBlock, _ := cmd.Flags().GetUint64(replayToFlag)
+
+		getBridgeDB()
+		defer util.CloseBridgeDBInstance()
+
+		client := httpClient.NewHTTP(helper.GetConfig().TendermintRPCUrl, "/websocket")
+		if err := client.Start(); err != nil {
+			return err
+		}
+		defer func() {
+			if err := client.Stop(); err != nil {
+				helper.Logger.Error("cursorReplayCmd | client.Stop", "Error", err)
+			}
+		}()
+
+		queueConnector := queue.NewQueueConnector(helper.GetConfig().TaskQueueBackend, helper.GetConfig().AmqpURL)
+		if err := listener.ReplayRange(app.MakeCodec(), queueConnector, client, name, fromBlock, toBlock); err != nil {
+			return err
+		}
+
+		fmt.Println("Sent", name, "tasks for blocks", fromBlock, "to", toBlock)
+		return nil
+	},
+}
+
+func init() {
+	for _, c := range []*cobra.Command{cursorGetCmd, cursorSetCmd, cursorRewindCmd, cursorReplayCmd} {
+		c.Flags().String(cursorListenerFlag, "", fmt.Sprintf("listener (%v, %v or %v)", listener.RootChainListenerStr, listener.HeimdallListenerStr, listener.MaticChainListenerStr))
+		if err := c.MarkFlagRequired(cursorListenerFlag); err != nil {
+			helper.Logger.Error("init | MarkFlagRequired | listener", "Error", err)
+		}
+	}
+
+	cursorReplayCmd.Flags().Uint64(replayFromFlag, 0, "first block to replay")
+	cursorReplayCmd.Flags().Uint64(replayToFlag, 0, "last block to replay")
+	for _, flag := range []string{replayFromFlag, replayToFlag} {
+		if err := cursorReplayCmd.MarkFlagRequired(flag); err != nil {
+			helper.Logger.Error("init | MarkFlagRequired | "+flag, "Error", err)
+		}
+	}
+
+	cursorCmd.AddCommand(cursorGetCmd, cursorSetCmd, cursorRewindCmd, cursorReplayCmd)
+	rootCmd.AddCommand(cursorCmd)
+}
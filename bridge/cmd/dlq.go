@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const (
+	dlqAllFlag        = "all"
+	dlqRetryCountFlag = "retry-count"
+)
+
+// dlqCmd represents the dead-letter queue commands
+var dlqCmd = &cobra.Command{
+	Use:   "dlq",
+	Short: "Inspect and re-drive bridge tasks which failed all retries (bridge must be stopped)",
+}
+
+var dlqListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List failed tasks",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store := getDeadLetterStore()
+		defer util.CloseBridgeDBInstance()
+
+		deadLetters, err := store.List()
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "UUID\tTASK\tATTEMPTS\tFAILED AT\tLAST ERROR")
+		for _, deadLetter := range deadLetters {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", deadLetter.UUID, deadLetter.TaskName, deadLetter.Attempts, deadLetter.FailedAt.Format("2006-01-02T15:04:05Z"), deadLetter.LastError)
+		}
+		return w.Flush()
+	},
+}
+
+var dlqShowCmd = &cobra.Command{
+	Use:   "show [uuid]",
+	Short: "Show failed task with its arguments",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store := getDeadLetterStore()
+		defer util.CloseBridgeDBInstance()
+
+		deadLetter, err := store.Get(args[0])
+		if err != nil {
+			return err
+		}
+
+		out, err := json.MarshalIndent(deadLetter, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(string(out))
+		return nil
+	},
+}
+
+var dlqReplayCmd = &cobra.Command{
+	Use:   "replay [uuid...]",
+	Short: "Send failed tasks back to the task queue",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store := getDeadLetterStore()
+		defer util.CloseBridgeDBInstance()
+
+		uuids, err := selectDeadLetters(cmd, store, args)
+		if err != nil {
+			return err
+		}
+
+		retryCount, _ := cmd.Flags().GetInt(dlqRetryCountFlag)
+		queueConnector := queue.NewQueueConnector(helper.GetConfig().TaskQueueBackend, helper.GetConfig().AmqpURL)
+		for _, uuid := range uuids {
+			if err := store.Replay(queueConnector, uuid, retryCount); err != nil {
+				return fmt.Errorf("replaying %v: %v", uuid, err)
+			}
+			fmt.Println("Replayed", uuid)
+		}
+		return nil
+	},
+}
+
+var dlqDropCmd = &cobra.Command{
+	Use:   "drop [uuid...]",
+	Short: "Remove failed tasks without replaying them",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store := getDeadLetterStore()
+		defer util.CloseBridgeDBInstance()
+
+		uuids, err := selectDeadLetters(cmd, store, args)
+		if err != nil {
+			return err
+		}
+
+		for _, uuid := range uuids {
+			if err := store.Delete(uuid); err != nil {
+				return fmt.Errorf("dropping %v: %v", uuid, err)
+			}
+			fmt.Println("Dropped", uuid)
+		}
+		return nil
+	},
+}
+
+func getDeadLetterStore() *queue.DeadLetterStore {
+	return queue.NewDeadLetterStore(getBridgeDB())
+}
+
+// selectDeadLetters returns uuids from args or all stored uuids with --all
+func selectDeadLetters(cmd *cobra.Command, store *queue.DeadLetterStore, args []string) ([]string, error) {
+	all, _ := cmd.Flags().GetBool(dlqAllFlag)
+	if !all {
+		if len(args) == 0 {
+			return nil, fmt.Errorf("provide task uuids or --%v", dlqAllFlag)
+		}
+		return args, nil
+	}
+
+	deadLetters, err := store.List()
+	if err != nil {
+		return nil, err
+	}
+
+	uuids := make([]string, 0, len(deadLetters))
+	for _, deadLetter := range deadLetters {
+		uuids = append(uuids, deadLetter.UUID)
+	}
+	return uuids, nil
+}
+
+func init() {
+	dlqReplayCmd.Flags().Bool(dlqAllFlag, false, "replay all failed tasks")
+	dlqReplayCmd.Flags().Int(dlqRetryCountFlag, queue.DefaultReplayRetryCount, "retry count for replayed tasks")
+	dlqDropCmd.Flags().Bool(dlqAllFlag, false, "drop all failed tasks")
+
+	dlqCmd.AddCommand(dlqListCmd, dlqShowCmd, dlqReplayCmd, dlqDropCmd)
+	rootCmd.AddCommand(dlqCmd)
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/outbox"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+// outboxCmd represents the commands for persisted rootchain submissions
+var outboxCmd = &cobra.Command{
+	Use:   "outbox",
+	Short: "Inspect rootchain checkpoint and tick submissions of bridge (bridge must be stopped)",
+}
+
+var outboxListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List submissions with their state",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		txOutbox := getOutbox()
+		defer util.CloseBridgeDBInstance()
+
+		submissions, err := txOutbox.List()
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tKIND\tSTATE\tNONCE\tTX HASH\tUPDATED AT\tLAST ERROR")
+		for _, submission := range submissions {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\t%s\n", submission.ID, submission.Kind, submission.State, submission.Nonce, submission.TxHash.Hex(), submission.UpdatedAt.Format("2006-01-02T15:04:05Z"), submission.LastError)
+		}
+		return w.Flush()
+	},
+}
+
+var outboxShowCmd = &cobra.Command{
+	Use:   "show [id]",
+	Short: "Show submission with its call data and sent transactions",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		txOutbox := getOutbox()
+		defer util.CloseBridgeDBInstance()
+
+		submission, err := txOutbox.Get(args[0])
+		if err != nil {
+			return err
+		}
+
+		out, err := json.MarshalIndent(submission, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(string(out))
+		return nil
+	},
+}
+
+func getOutbox() *outbox.Outbox {
+	return outbox.NewOutbox(getBridgeDB(), outbox.NewMainChain())
+}
+
+func init() {
+	outboxCmd.AddCommand(outboxListCmd, outboxShowCmd)
+	rootCmd.AddCommand(outboxCmd)
+}
@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/spf13/cobra"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/processor"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// quarantineCmd represents the quarantined state sync commands
+var quarantineCmd = &cobra.Command{
+	Use:   "quarantine",
+	Short: "Inspect state syncs heimdall will never accept, kept instead of being retried and skipped by their successors (bridge must be stopped)",
+}
+
+var quarantineListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List quarantined state syncs",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store := getQuarantineStore()
+		defer util.CloseBridgeDBInstance()
+
+		quarantined, err := store.List()
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tTX HASH\tLOG INDEX\tQUARANTINED AT\tREASON")
+		for _, q := range quarantined {
+			fmt.Fprintf(w, "%d\t%s\t%d\t%s\t%s\n", q.ID, q.TxHash, q.LogIndex, q.QuarantinedAt.Format("2006-01-02T15:04:05Z"), q.Reason)
+		}
+		return w.Flush()
+	},
+}
+
+var quarantineShowCmd = &cobra.Command{
+	Use:   "show [id]",
+	Short: "Show quarantined state sync with its log",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ids, err := parseStateIDs(args)
+		if err != nil {
+			return err
+		}
+
+		store := getQuarantineStore()
+		defer util.CloseBridgeDBInstance()
+
+		quarantined, err := store.Get(ids[0])
+		if err != nil {
+			return err
+		}
+
+		out, err := json.MarshalIndent(quarantined, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(string(out))
+		return nil
+	},
+}
+
+var quarantineReleaseCmd = &cobra.Command{
+	Use:   "release [id...]",
+	Short: "Send quarantined state syncs back to the task queue, e.g. after chain params are fixed",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ids, err := parseStateIDs(args)
+		if err != nil {
+			return err
+		}
+
+		store := getQuarantineStore()
+		defer util.CloseBridgeDBInstance()
+
+		queueConnector := queue.NewQueueConnector(helper.GetConfig().TaskQueueBackend, helper.GetConfig().AmqpURL)
+		for _, id := range ids {
+			quarantined, err := store.Get(id)
+			if err != nil {
+				return fmt.Errorf("releasing %v: %v", id, err)
+			}
+
+			signature := &tasks.Signature{
+				Name: "sendStateSyncedToHeimdall",
+				Args: []tasks.Arg{
+					{
+						Type:  "string",
+						Value: quarantined.EventName,
+					},
+					{
+						Type:  "string",
+						Value: quarantined.LogBytes,
+					},
+				},
+			}
+			if _, err := queueConnector.Server.SendTask(signature); err != nil {
+				return fmt.Errorf("releasing %v: %v", id, err)
+			}
+
+			if err := store.Delete(id); err != nil {
+				return fmt.Errorf("releasing %v: %v", id, err)
+			}
+			fmt.Println("Released", id)
+		}
+		return nil
+	},
+}
+
+var quarantineDropCmd = &cobra.Command{
+	Use:   "drop [id...]",
+	Short: "Remove quarantined state syncs",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ids, err := parseStateIDs(args)
+		if err != nil {
+			return err
+		}
+
+		store := getQuarantineStore()
+		defer util.CloseBridgeDBInstance()
+
+		for _, id := range ids {
+			if err := store.Delete(id); err != nil {
+				return fmt.Errorf("dropping %v: %v", id, err)
+			}
+			fmt.Println("Dropped", id)
+		}
+		return nil
+	},
+}
+
+func getQuarantineStore() *processor.QuarantineStore {
+	return processor.NewQuarantineStore(getBridgeDB())
+}
+
+// parseStateIDs parses state ids given as args
+func parseStateIDs(args []string) ([]uint64, error) {
+	ids := make([]uint64, len(args))
+	for i, arg := range args {
+		id, err := strconv.ParseUint(arg, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid state id %v: %v", arg, err)
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+func init() {
+	quarantineCmd.AddCommand(quarantineListCmd, quarantineShowCmd, quarantineReleaseCmd, quarantineDropCmd)
+	rootCmd.AddCommand(quarantineCmd)
+}
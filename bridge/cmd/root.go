@@ -7,7 +7,9 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/syndtr/goleveldb/leveldb"
 
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/version"
 )
@@ -53,6 +55,16 @@ func InitTendermintViperConfig(cmd *cobra.Command) {
 	helper.InitHeimdallConfig("")
 }
 
+// getBridgeDB opens bridge db for commands which edit it, bridge must be stopped
+func getBridgeDB() *leveldb.DB {
+	db := util.GetBridgeDBInstance(viper.GetString(bridgeDBFlag))
+	if db == nil {
+		panic("Unable to open bridge db, make sure bridge is stopped")
+	}
+
+	return db
+}
+
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/broadcaster"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+// shadowCmd represents the commands for transactions recorded in shadow mode
+var shadowCmd = &cobra.Command{
+	Use:   "shadow",
+	Short: "Inspect transactions recorded by bridge in shadow mode (bridge must be stopped)",
+}
+
+var shadowListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List recorded transactions",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		recorder := getTxRecorder()
+		defer util.CloseBridgeDBInstance()
+
+		recordedTxs, err := recorder.List()
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tCHAIN\tKIND\tRECORDED AT\tPAYLOAD")
+		for _, recordedTx := range recordedTxs {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", recordedTx.ID, recordedTx.Chain, recordedTx.Kind, recordedTx.RecordedAt.Format("2006-01-02T15:04:05Z"), string(recordedTx.Payload))
+		}
+		return w.Flush()
+	},
+}
+
+var shadowClearCmd = &cobra.Command{
+	Use:   "clear",
+	Short: "Remove all recorded transactions",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		recorder := getTxRecorder()
+		defer util.CloseBridgeDBInstance()
+
+		count, err := recorder.Clear()
+		if err != nil {
+			return err
+		}
+
+		fmt.Println("Removed", count, "recorded transactions")
+		return nil
+	},
+}
+
+func getTxRecorder() *broadcaster.TxRecorder {
+	return broadcaster.NewTxRecorder(getBridgeDB())
+}
+
+func init() {
+	shadowCmd.AddCommand(shadowListCmd, shadowClearCmd)
+	rootCmd.AddCommand(shadowCmd)
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"os/signal"
 	"sync"
@@ -19,8 +20,11 @@ import (
 	"github.com/maticnetwork/heimdall/app"
 	"github.com/maticnetwork/heimdall/bridge/setu/broadcaster"
 	"github.com/maticnetwork/heimdall/bridge/setu/listener"
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
+	"github.com/maticnetwork/heimdall/bridge/setu/outbox"
 	"github.com/maticnetwork/heimdall/bridge/setu/processor"
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/status"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
 )
@@ -28,6 +32,12 @@ import (
 const (
 	waitDuration = 1 * time.Minute
 	logLevel     = "log_level"
+	metricsAddr  = "metrics_addr"
+	statusAddr   = "status_addr"
+	shadowMode   = "shadow"
+
+	defaultMetricsAddr = "127.0.0.1:2112"
+	defaultStatusAddr  = "127.0.0.1:8646"
 )
 
 // GetStartCmd returns the start command to start bridge
@@ -41,19 +51,61 @@ func GetStartCmd() *cobra.Command {
 			// create codec
 			cdc := app.MakeCodec()
 			// queue connector & http client
-			_queueConnector := queue.NewQueueConnector(helper.GetConfig().AmqpURL)
+			_queueConnector := queue.NewQueueConnector(helper.GetConfig().TaskQueueBackend, helper.GetConfig().AmqpURL)
 			_queueConnector.StartWorker()
 
+			// metrics server
+			var metricsServer *http.Server
+			if addr := viper.GetString(metricsAddr); addr != "" {
+				metricsServer = metrics.StartServer(addr, logger)
+			}
+
 			_txBroadcaster := broadcaster.NewTxBroadcaster(cdc)
+
+			// shadow mode, record transactions instead of sending them
+			var txOutbox *outbox.Outbox
+			if viper.GetBool(shadowMode) {
+				recorder := broadcaster.NewTxRecorder(util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag)))
+				_txBroadcaster.SetRecorder(recorder)
+				helper.SetTxRecorder(recorder)
+				logger.Info("Running in shadow mode, transactions are recorded in bridge db instead of being sent")
+				if _queueConnector.Backend == queue.AMQPBackend {
+					logger.Info("Shadow bridge uses amqp task queue, make sure it is not shared with another bridge", "url", helper.GetConfig().AmqpURL)
+				}
+			} else {
+				// persist rootchain submissions
+				txOutbox = outbox.NewOutbox(util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag)), outbox.NewMainChain())
+				helper.SetTxOutbox(txOutbox)
+			}
 			_httpClient := httpClient.NewHTTP(helper.GetConfig().TendermintRPCUrl, "/websocket")
 
 			// selected services to start
+			_listenerService := listener.NewListenerService(cdc, _queueConnector, _httpClient)
+			_processorService := processor.NewProcessorService(cdc, _queueConnector, _httpClient, _txBroadcaster)
 			services := []common.Service{}
 			services = append(services,
-				listener.NewListenerService(cdc, _queueConnector, _httpClient),
-				processor.NewProcessorService(cdc, _queueConnector, _httpClient, _txBroadcaster),
+				_listenerService,
+				_processorService,
 			)
 
+			// cli context
+			cliCtx := cliContext.NewCLIContext().WithCodec(cdc)
+			cliCtx.BroadcastMode = client.BroadcastAsync
+			cliCtx.TrustNode = true
+
+			// outbox reconciler, resumes submissions of previous run once heimdall is synced
+			var reconciler *outbox.Reconciler
+			if txOutbox != nil {
+				reconciler = outbox.NewReconciler(txOutbox, cliCtx)
+			}
+
+			// serve status while waiting for heimdall sync, readiness reports it
+			var statusServer *status.Server
+			if addr := viper.GetString(statusAddr); addr != "" {
+				statusServer = status.NewServer(cliCtx, _txBroadcaster, _listenerService, _processorService)
+				statusServer.Start(addr)
+			}
+
 			// sync group
 			var wg sync.WaitGroup
 
@@ -71,11 +123,30 @@ func GetStartCmd() *cobra.Command {
 						}
 					}
 
+					// stop outbox reconciler
+					if reconciler != nil {
+						reconciler.Stop()
+					}
+
 					// stop http client
 					if err := _httpClient.Stop(); err != nil {
 						logger.Error("GetStartCmd | _httpClient.Stop", "Error", err)
 					}
 
+					// stop status server
+					if statusServer != nil {
+						if err := statusServer.Stop(); err != nil {
+							logger.Error("GetStartCmd | statusServer.Stop", "Error", err)
+						}
+					}
+
+					// stop metrics server
+					if metricsServer != nil {
+						if err := metricsServer.Close(); err != nil {
+							logger.Error("GetStartCmd | metricsServer.Close", "Error", err)
+						}
+					}
+
 					// stop db instance
 					util.CloseBridgeDBInstance()
 
@@ -90,11 +161,6 @@ func GetStartCmd() *cobra.Command {
 				panic(fmt.Sprintf("Error connecting to server %v", err))
 			}
 
-			// cli context
-			cliCtx := cliContext.NewCLIContext().WithCodec(cdc)
-			cliCtx.BroadcastMode = client.BroadcastAsync
-			cliCtx.TrustNode = true
-
 			// start bridge services only when node fully synced
 			for {
 				if !util.IsCatchingUp(cliCtx) {
@@ -106,6 +172,10 @@ func GetStartCmd() *cobra.Command {
 				time.Sleep(waitDuration)
 			}
 
+			if reconciler != nil {
+				reconciler.Start()
+			}
+
 			// strt all processes
 			for _, service := range services {
 				go func(serv common.Service) {
@@ -128,6 +198,21 @@ func GetStartCmd() *cobra.Command {
 		logger.Error("GetStartCmd | BindPFlag | logLevel", "Error", err)
 	}
 
+	startCmd.Flags().String(metricsAddr, defaultMetricsAddr, "Address to serve prometheus metrics on (empty to disable)")
+	if err := viper.BindPFlag(metricsAddr, startCmd.Flags().Lookup(metricsAddr)); err != nil {
+		logger.Error("GetStartCmd | BindPFlag | metricsAddr", "Error", err)
+	}
+
+	startCmd.Flags().String(statusAddr, defaultStatusAddr, "Address to serve bridge status, liveness (/healthz) and readiness (/readyz) on (empty to disable)")
+	if err := viper.BindPFlag(statusAddr, startCmd.Flags().Lookup(statusAddr)); err != nil {
+		logger.Error("GetStartCmd | BindPFlag | statusAddr", "Error", err)
+	}
+
+	startCmd.Flags().Bool(shadowMode, false, "Run all listeners and processors but record transactions in bridge db instead of sending them")
+	if err := viper.BindPFlag(shadowMode, startCmd.Flags().Lookup(shadowMode)); err != nil {
+		logger.Error("GetStartCmd | BindPFlag | shadowMode", "Error", err)
+	}
+
 	startCmd.Flags().Bool("all", false, "start all bridge services")
 	if err := viper.BindPFlag("all", startCmd.Flags().Lookup("all")); err != nil {
 		logger.Error("GetStartCmd | BindPFlag | all", "Error", err)
@@ -2,8 +2,12 @@ package broadcaster
 
 import (
 	"context"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	cliContext "github.com/cosmos/cosmos-sdk/client/context"
@@ -12,24 +16,47 @@ import (
 	bor "github.com/maticnetwork/bor"
 	"github.com/maticnetwork/bor/core/types"
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
 
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/mempool"
+	tmTypes "github.com/tendermint/tendermint/types"
 )
 
+// interval at which in-flight heimdall txs are checked for inclusion
+const confirmPollInterval = 5 * time.Second
+
+// inFlightTx is a heimdall tx accepted by mempool but not confirmed in a block yet
+type inFlightTx struct {
+	seq    uint64
+	txHash string
+	msg    sdk.Msg
+	sentAt time.Time
+}
+
 // TxBroadcaster uses to broadcast transaction to each chain
 type TxBroadcaster struct {
 	logger log.Logger
 
 	cliCtx cliContext.CLIContext
 
-	heimdallMutex sync.Mutex
+	heimdallMutex sync.Mutex // guards lastSeqNo and inFlight
 	maticMutex    sync.Mutex
 
-	lastSeqNo uint64
+	lastSeqNo uint64 // sequence of next heimdall tx
 	accNum    uint64
+
+	inFlight  []*inFlightTx // ordered by sequence
+	startOnce sync.Once
+
+	// sends signed tx to heimdall, signAndBroadcast if nil
+	sendTx func(msg sdk.Msg, seq uint64) (sdk.TxResponse, error)
+
+	// shadow mode, records transactions instead of sending them
+	recorder *TxRecorder
 }
 
 // NewTxBroadcaster creates new broadcaster
@@ -56,11 +83,100 @@ func NewTxBroadcaster(cdc *codec.Codec) *TxBroadcaster {
 	return &txBroadcaster
 }
 
-// BroadcastToHeimdall broadcast to heimdall
+// BroadcastToHeimdall broadcast to heimdall.
+// Every message is sent in its own tx, and it returns once the tx is accepted by mempool.
+// Inclusion is confirmed asynchronously and txs which fail to get included are re-signed and re-sent.
 func (tb *TxBroadcaster) BroadcastToHeimdall(msg sdk.Msg) error {
+	// shadow mode, record instead of sending
+	if tb.recorder != nil {
+		return tb.recorder.Record(helper.HeimdallTxChain, msg.Type(), json.RawMessage(msg.GetSignBytes()))
+	}
+
+	tb.startOnce.Do(func() {
+		go tb.confirmLoop()
+	})
+
+	return tb.broadcastMsg(msg)
+}
+
+// SetRecorder turns on shadow mode, transactions are recorded instead of being sent
+func (tb *TxBroadcaster) SetRecorder(recorder *TxRecorder) {
+	tb.recorder = recorder
+}
+
+// GetSequences returns cached account sequence used for next heimdall tx and sequence of the account on heimdall
+func (tb *TxBroadcaster) GetSequences() (uint64, uint64, error) {
+	tb.heimdallMutex.Lock()
+	lastSeqNo := tb.lastSeqNo
+	tb.heimdallMutex.Unlock()
+
+	account, err := util.GetAccount(tb.cliCtx, hmTypes.BytesToHeimdallAddress(helper.GetAddress()))
+	if err != nil {
+		return lastSeqNo, 0, err
+	}
+
+	return lastSeqNo, account.GetSequence(), nil
+}
+
+// InFlight returns number of heimdall txs sent but not confirmed yet
+func (tb *TxBroadcaster) InFlight() int {
+	tb.heimdallMutex.Lock()
+	defer tb.heimdallMutex.Unlock()
+
+	return len(tb.inFlight)
+}
+
+// broadcastMsg sends message in one tx with next sequence and tracks it until confirmed
+func (tb *TxBroadcaster) broadcastMsg(msg sdk.Msg) error {
 	tb.heimdallMutex.Lock()
 	defer tb.heimdallMutex.Unlock()
 
+	txResponse, err := tb.send(msg, tb.lastSeqNo)
+	if err == nil && txResponse.Code == uint32(sdk.CodeUnauthorized) {
+		// signature is checked against account sequence, resync and try once more
+		tb.logger.Info("Heimdall tx rejected with local sequence, resyncing", "accSeq", tb.lastSeqNo, "log", txResponse.RawLog)
+		if err := tb.resync(); err != nil {
+			return err
+		}
+		txResponse, err = tb.send(msg, tb.lastSeqNo)
+	}
+
+	if err != nil {
+		tb.logger.Error("Error while broadcasting the heimdall transaction", "error", err)
+		return err
+	}
+
+	if txResponse.Code != uint32(sdk.CodeOK) {
+		tb.logger.Error("Heimdall transaction rejected", "code", txResponse.Code, "log", txResponse.RawLog)
+		return fmt.Errorf("heimdall tx rejected with code %d: %s", txResponse.Code, txResponse.RawLog)
+	}
+
+	tb.logger.Info("Tx sent on heimdall", "txHash", txResponse.TxHash, "accSeq", tb.lastSeqNo, "accNum", tb.accNum, "msgType", msg.Type())
+	tb.logger.Debug("Tx successful on heimdall", "txResponse", txResponse)
+
+	tb.inFlight = append(tb.inFlight, &inFlightTx{
+		seq:    tb.lastSeqNo,
+		txHash: txResponse.TxHash,
+		msg:    msg,
+		sentAt: time.Now(),
+	})
+	metrics.BroadcasterInFlightTxs.Set(float64(len(tb.inFlight)))
+
+	// increment account sequence
+	tb.lastSeqNo++
+	return nil
+}
+
+// send signs message with given sequence and sends it to mempool
+func (tb *TxBroadcaster) send(msg sdk.Msg, seq uint64) (sdk.TxResponse, error) {
+	if tb.sendTx != nil {
+		return tb.sendTx(msg, seq)
+	}
+	return tb.signAndBroadcast(msg, seq)
+}
+
+// signAndBroadcast signs message with given sequence and sends it to mempool
+func (tb *TxBroadcaster) signAndBroadcast(msg sdk.Msg, seq uint64) (sdk.TxResponse, error) {
 	// tx encoder
 	txEncoder := helper.GetTxEncoder(tb.cliCtx.Codec)
 	// chain id
@@ -70,36 +186,127 @@ func (tb *TxBroadcaster) BroadcastToHeimdall(msg sdk.Msg) error {
 	txBldr := authTypes.NewTxBuilderFromCLI().
 		WithTxEncoder(txEncoder).
 		WithAccountNumber(tb.accNum).
-		WithSequence(tb.lastSeqNo).
+		WithSequence(seq).
 		WithChainID(chainID)
 
-	txResponse, err := helper.BuildAndBroadcastMsgs(tb.cliCtx, txBldr, []sdk.Msg{msg})
+	txBytes, err := helper.GetSignedTxBytes(tb.cliCtx, txBldr, []sdk.Msg{msg})
 	if err != nil {
-		tb.logger.Error("Error while broadcasting the heimdall transaction", "error", err)
+		return sdk.TxResponse{}, err
+	}
 
-		// current address
-		address := hmTypes.BytesToHeimdallAddress(helper.GetAddress())
+	txResponse, err := helper.BroadcastTxBytes(tb.cliCtx, txBytes, "")
+	if err != nil && strings.Contains(err.Error(), mempool.ErrTxInCache.Error()) {
+		// signing is deterministic, same message with same sequence are already in mempool
+		return sdk.TxResponse{TxHash: strings.ToUpper(hex.EncodeToString(tmTypes.Tx(txBytes).Hash()))}, nil
+	}
 
-		// fetch from APIs
-		account, errAcc := util.GetAccount(tb.cliCtx, address)
-		if errAcc != nil {
-			tb.logger.Error("Error fetching account from rest-api", "url", helper.GetHeimdallServerEndpoint(fmt.Sprintf(util.AccountDetailsURL, helper.GetAddress())))
-			return errAcc
+	return txResponse, err
+}
+
+// confirmLoop periodically checks in-flight txs for inclusion
+func (tb *TxBroadcaster) confirmLoop() {
+	ticker := time.NewTicker(confirmPollInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		tb.confirm()
+	}
+}
+
+// confirm forgets included txs and re-sends the ones which are not included within confirm timeout
+func (tb *TxBroadcaster) confirm() {
+	tb.heimdallMutex.Lock()
+	defer tb.heimdallMutex.Unlock()
+
+	for len(tb.inFlight) > 0 {
+		tx := tb.inFlight[0]
+
+		txResponse, err := helper.QueryTx(tb.cliCtx, tx.txHash)
+		if err == nil {
+			if txResponse.Code != uint32(sdk.CodeOK) {
+				tb.logger.Error("Heimdall tx included but failed", "txHash", tx.txHash, "accSeq", tx.seq, "code", txResponse.Code, "log", txResponse.RawLog)
+			} else {
+				tb.logger.Debug("Heimdall tx included", "txHash", tx.txHash, "accSeq", tx.seq, "height", txResponse.Height)
+			}
+			tb.inFlight = tb.inFlight[1:]
+			continue
+		}
+
+		if time.Since(tx.sentAt) >= helper.GetConfig().HeimdallTxConfirmTimeout {
+			tb.logger.Info("Heimdall tx not included in time, resyncing", "txHash", tx.txHash, "accSeq", tx.seq)
+			if err := tb.resync(); err != nil {
+				tb.logger.Error("Error while resyncing heimdall txs", "error", err)
+			}
 		}
+		break
+	}
 
-		// update seqNo for safety
-		tb.lastSeqNo = account.GetSequence()
+	metrics.BroadcasterInFlightTxs.Set(float64(len(tb.inFlight)))
+}
 
+// resync resets local sequence to the account sequence on heimdall and re-signs in-flight txs
+// which did not use their sequence, caller must hold heimdallMutex
+func (tb *TxBroadcaster) resync() error {
+	// current address
+	address := hmTypes.BytesToHeimdallAddress(helper.GetAddress())
+
+	// fetch from APIs
+	account, err := util.GetAccount(tb.cliCtx, address)
+	if err != nil {
+		tb.logger.Error("Error fetching account from rest-api", "url", helper.GetHeimdallServerEndpoint(fmt.Sprintf(util.AccountDetailsURL, helper.GetAddress())))
 		return err
 	}
 
-	tb.logger.Info("Tx sent on heimdall", "txHash", txResponse.TxHash, "accSeq", tb.lastSeqNo, "accNum", tb.accNum)
-	tb.logger.Debug("Tx successful on heimdall", "txResponse", txResponse)
-	// increment account sequence
-	tb.lastSeqNo += 1
+	accountSeq := account.GetSequence()
+	failed := unusedInFlight(tb.inFlight, accountSeq)
+	if expected := accountSeq + uint64(len(failed)); expected != tb.lastSeqNo {
+		tb.logger.Info("Account sequence mismatch, resyncing", "localSeq", tb.lastSeqNo, "heimdallSeq", accountSeq, "inFlight", len(failed))
+		metrics.BroadcasterSequenceMismatches.Inc()
+	}
+
+	tb.lastSeqNo = accountSeq
+	tb.inFlight = nil
+
+	// re-sign only txs whose sequence is not used yet, in their original order
+	for _, tx := range failed {
+		txResponse, err := tb.send(tx.msg, tb.lastSeqNo)
+		if err == nil && txResponse.Code != uint32(sdk.CodeOK) {
+			err = fmt.Errorf("heimdall tx rejected with code %d: %s", txResponse.Code, txResponse.RawLog)
+		}
+		if err != nil {
+			tb.logger.Error("Error while re-sending heimdall tx, dropping it", "oldTxHash", tx.txHash, "msgType", tx.msg.Type(), "error", err)
+			continue
+		}
+
+		if txResponse.TxHash != tx.txHash {
+			tb.logger.Info("Re-signed heimdall tx", "oldTxHash", tx.txHash, "txHash", txResponse.TxHash, "oldSeq", tx.seq, "accSeq", tb.lastSeqNo)
+			metrics.BroadcasterResignedTxs.Inc()
+		}
+
+		tb.inFlight = append(tb.inFlight, &inFlightTx{
+			seq:    tb.lastSeqNo,
+			txHash: txResponse.TxHash,
+			msg:    tx.msg,
+			sentAt: time.Now(),
+		})
+		tb.lastSeqNo++
+	}
+
+	metrics.BroadcasterInFlightTxs.Set(float64(len(tb.inFlight)))
 	return nil
 }
 
+// unusedInFlight returns in-flight txs whose sequence is not used on heimdall yet
+func unusedInFlight(inFlight []*inFlightTx, accountSeq uint64) []*inFlightTx {
+	var result []*inFlightTx
+	for _, tx := range inFlight {
+		if tx.seq >= accountSeq {
+			result = append(result, tx)
+		}
+	}
+	return result
+}
+
 // BroadcastToMatic broadcast to matic
 func (tb *TxBroadcaster) BroadcastToMatic(msg bor.CallMsg) error {
 	tb.maticMutex.Lock()
@@ -116,6 +323,11 @@ func (tb *TxBroadcaster) BroadcastToMatic(msg bor.CallMsg) error {
 		return err
 	}
 
+	// shadow mode, record instead of sending (gas estimation above still validates the call)
+	if tb.recorder != nil {
+		return tb.recorder.Record(helper.BorTxChain, "call", helper.ContractTx{To: *msg.To, Data: msg.Data})
+	}
+
 	// Create the transaction, sign it and schedule it for execution
 	rawTx := types.NewTransaction(auth.Nonce.Uint64(), *msg.To, msg.Value, auth.GasLimit, auth.GasPrice, msg.Data)
 
@@ -5,12 +5,15 @@ import (
 	"os"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/app"
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
 	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
 )
 
 // Parallel test - to check BroadcastToHeimdall syncronization
@@ -52,3 +55,49 @@ func TestBroadcastToHeimdall(t *testing.T) {
 		})
 	}
 }
+
+func TestBroadcastSendsEveryMessage(t *testing.T) {
+	t.Parallel()
+
+	var sent []sdk.Msg
+	var seqs []uint64
+	tb := &TxBroadcaster{
+		logger:    log.NewNopLogger(),
+		lastSeqNo: 5,
+		sendTx: func(msg sdk.Msg, seq uint64) (sdk.TxResponse, error) {
+			sent = append(sent, msg)
+			seqs = append(seqs, seq)
+			return sdk.TxResponse{TxHash: fmt.Sprint(seq)}, nil
+		},
+	}
+	// confirm loop is not tested here
+	tb.startOnce.Do(func() {})
+
+	msgs := []sdk.Msg{
+		clerkTypes.MsgEventRecord{ID: 1},
+		clerkTypes.MsgEventRecord{ID: 2},
+		clerkTypes.MsgEventRecord{ID: 3},
+	}
+	for _, msg := range msgs {
+		assert.NoError(t, tb.BroadcastToHeimdall(msg))
+	}
+
+	// every message is sent in its own tx, with consecutive sequences
+	assert.Equal(t, msgs, sent)
+	assert.Equal(t, []uint64{5, 6, 7}, seqs)
+	assert.Equal(t, uint64(8), tb.lastSeqNo)
+	assert.Equal(t, 3, tb.InFlight())
+	for i, tx := range tb.inFlight {
+		assert.Equal(t, msgs[i], tx.msg)
+	}
+}
+
+func TestUnusedInFlight(t *testing.T) {
+	t.Parallel()
+
+	inFlight := []*inFlightTx{{seq: 5}, {seq: 6}, {seq: 7}}
+
+	assert.Len(t, unusedInFlight(inFlight, 5), 3)
+	assert.Len(t, unusedInFlight(inFlight, 7), 1)
+	assert.Empty(t, unusedInFlight(inFlight, 8))
+}
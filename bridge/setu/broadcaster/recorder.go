@@ -0,0 +1,101 @@
+package broadcaster
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	levelUtil "github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+const recordedTxPrefix = "shadow-" // storage key prefix
+
+// RecordedTx is a transaction which shadow bridge would have sent
+type RecordedTx struct {
+	ID         string          `json:"id"`
+	Chain      string          `json:"chain"`
+	Kind       string          `json:"kind"`
+	Payload    json.RawMessage `json:"payload"`
+	RecordedAt time.Time       `json:"recordedAt"`
+}
+
+// TxRecorder logs and stores transactions in bridge db instead of sending them
+type TxRecorder struct {
+	logger log.Logger
+	db     *leveldb.DB
+
+	counter uint64
+}
+
+// NewTxRecorder creates recorder on given db
+func NewTxRecorder(db *leveldb.DB) *TxRecorder {
+	return &TxRecorder{
+		logger: util.Logger().With("module", "txRecorder"),
+		db:     db,
+	}
+}
+
+// Record stores transaction payload, it implements helper.TxRecorder
+func (r *TxRecorder) Record(chain string, kind string, payload interface{}) error {
+	value, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	recordedTx := RecordedTx{
+		// ids sort in recording order
+		ID:         fmt.Sprintf("%020d-%06d", now.UnixNano(), atomic.AddUint64(&r.counter, 1)%1000000),
+		Chain:      chain,
+		Kind:       kind,
+		Payload:    value,
+		RecordedAt: now,
+	}
+
+	r.logger.Info("Recorded tx instead of sending it", "id", recordedTx.ID, "chain", chain, "kind", kind, "payload", string(value))
+
+	data, err := json.Marshal(recordedTx)
+	if err != nil {
+		return err
+	}
+
+	return r.db.Put([]byte(recordedTxPrefix+recordedTx.ID), data, nil)
+}
+
+// List returns all recorded transactions in recording order
+func (r *TxRecorder) List() ([]*RecordedTx, error) {
+	result := make([]*RecordedTx, 0)
+
+	iter := r.db.NewIterator(levelUtil.BytesPrefix([]byte(recordedTxPrefix)), nil)
+	defer iter.Release()
+	for iter.Next() {
+		var recordedTx RecordedTx
+		if err := json.Unmarshal(iter.Value(), &recordedTx); err != nil {
+			return nil, err
+		}
+		result = append(result, &recordedTx)
+	}
+
+	return result, iter.Error()
+}
+
+// Clear removes all recorded transactions
+func (r *TxRecorder) Clear() (int, error) {
+	batch := new(leveldb.Batch)
+
+	iter := r.db.NewIterator(levelUtil.BytesPrefix([]byte(recordedTxPrefix)), nil)
+	defer iter.Release()
+	for iter.Next() {
+		batch.Delete(append([]byte{}, iter.Key()...))
+	}
+	if err := iter.Error(); err != nil {
+		return 0, err
+	}
+
+	return batch.Len(), r.db.Write(batch, nil)
+}
@@ -0,0 +1,42 @@
+package broadcaster
+
+import (
+	"testing"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+func TestTxRecorder(t *testing.T) {
+	t.Parallel()
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	recorder := NewTxRecorder(db)
+	require.NoError(t, recorder.Record(helper.HeimdallTxChain, "event-record", map[string]uint64{"id": 1}))
+	require.NoError(t, recorder.Record(helper.RootchainTxChain, helper.CheckpointTxKind, helper.ContractTx{To: common.HexToAddress("0x1"), Data: []byte{0xab}}))
+
+	recordedTxs, err := recorder.List()
+	require.NoError(t, err)
+	require.Len(t, recordedTxs, 2)
+
+	// recorded in order
+	require.Equal(t, helper.HeimdallTxChain, recordedTxs[0].Chain)
+	require.JSONEq(t, `{"id":1}`, string(recordedTxs[0].Payload))
+	require.Equal(t, helper.CheckpointTxKind, recordedTxs[1].Kind)
+	require.JSONEq(t, `{"to":"0x0000000000000000000000000000000000000001","data":"0xab"}`, string(recordedTxs[1].Payload))
+
+	count, err := recorder.Clear()
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	recordedTxs, err = recorder.List()
+	require.NoError(t, err)
+	require.Empty(t, recordedTxs)
+}
@@ -0,0 +1,318 @@
+// Package dedup indexes rootchain events which heimdall already processed, or which are on their way
+// into heimdall, so processors can skip them without asking heimdall server.
+//
+// Events are identified by (event type, rootchain tx hash, log index). Heimdall listener fills the index
+// from heimdall blocks: txs included in a block are in flight, and their side-tx result makes them
+// processed (approved) or removes them (rejected or skipped). Processors mark events they sent in flight.
+//
+//	in-flight -> processed
+//	     \-> (removed, sent again)
+package dedup
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/syndtr/goleveldb/leveldb"
+	levelUtil "github.com/syndtr/goleveldb/leveldb/util"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
+	"github.com/maticnetwork/heimdall/helper"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	topupTypes "github.com/maticnetwork/heimdall/topup/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// entry states
+const (
+	StateInFlight  = "in-flight" // sent to heimdall, waiting for side-tx result
+	StateProcessed = "processed" // approved by heimdall
+)
+
+const (
+	entryPrefix = "dedup-event-" // storage key prefix of events
+	txPrefix    = "dedup-tx-"    // storage key prefix of heimdall txs waiting for side-tx result
+
+	// DefaultRetention is how long processed entries are kept, rootchain events older than that are not sent again
+	DefaultRetention = 7 * 24 * time.Hour
+)
+
+// Ref identifies rootchain event sent to heimdall
+type Ref struct {
+	Event    util.BridgeEvent `json:"event"`
+	TxHash   string           `json:"txHash"`
+	LogIndex uint64           `json:"logIndex"`
+}
+
+// NewRef creates ref of rootchain event, tx hash is hex with 0x prefix
+func NewRef(event util.BridgeEvent, txHash string, logIndex uint64) Ref {
+	return Ref{Event: event, TxHash: strings.ToLower(txHash), LogIndex: logIndex}
+}
+
+// MsgRef returns ref of rootchain event carried by heimdall msg, false if msg doesn't carry one
+func MsgRef(msg sdk.Msg) (Ref, bool) {
+	var event util.BridgeEvent
+	var txHash hmTypes.HeimdallHash
+	var logIndex uint64
+
+	switch m := msg.(type) {
+	case clerkTypes.MsgEventRecord:
+		event, txHash, logIndex = util.ClerkEvent, m.TxHash, m.LogIndex
+	case stakingTypes.MsgValidatorJoin:
+		event, txHash, logIndex = util.StakingEvent, m.TxHash, m.LogIndex
+	case stakingTypes.MsgStakeUpdate:
+		event, txHash, logIndex = util.StakingEvent, m.TxHash, m.LogIndex
+	case stakingTypes.MsgSignerUpdate:
+		event, txHash, logIndex = util.StakingEvent, m.TxHash, m.LogIndex
+	case stakingTypes.MsgValidatorExit:
+		event, txHash, logIndex = util.StakingEvent, m.TxHash, m.LogIndex
+	case stakingTypes.MsgShareMinted:
+		event, txHash, logIndex = util.StakingEvent, m.TxHash, m.LogIndex
+	case stakingTypes.MsgShareBurned:
+		event, txHash, logIndex = util.StakingEvent, m.TxHash, m.LogIndex
+	case stakingTypes.MsgDelegatorClaimedRewards:
+		event, txHash, logIndex = util.StakingEvent, m.TxHash, m.LogIndex
+	case stakingTypes.MsgUpdateCommissionRate:
+		event, txHash, logIndex = util.StakingEvent, m.TxHash, m.LogIndex
+	case topupTypes.MsgTopup:
+		event, txHash, logIndex = util.TopupEvent, m.TxHash, m.LogIndex
+	case slashingTypes.MsgTickAck:
+		event, txHash, logIndex = util.SlashingEvent, m.TxHash, m.LogIndex
+	case slashingTypes.MsgUnjail:
+		event, txHash, logIndex = util.SlashingEvent, m.TxHash, m.LogIndex
+	default:
+		return Ref{}, false
+	}
+
+	return NewRef(event, txHash.EthHash().Hex(), logIndex), true
+}
+
+// Entry is indexed state of rootchain event
+type Entry struct {
+	Ref
+	State     string    `json:"state"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Tx is heimdall tx carrying rootchain events, waiting for side-tx result
+type Tx struct {
+	Hash      string    `json:"hash"`
+	Refs      []Ref     `json:"refs"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Index stores entries in bridge db
+type Index struct {
+	db *leveldb.DB
+
+	// in-flight entries older than this are ignored, so events which never got in are sent again
+	inFlightTTL time.Duration
+}
+
+// serializes state changes, listener and processors use their own index on the same bridge db
+var mutex sync.Mutex
+
+// NewIndex creates index on given db
+func NewIndex(db *leveldb.DB, inFlightTTL time.Duration) *Index {
+	return &Index{db: db, inFlightTTL: inFlightTTL}
+}
+
+// InFlightTTL returns how long sent events are in flight, twice the heimdall tx confirm timeout like clerk submit loop
+func InFlightTTL() time.Duration {
+	return 2 * helper.GetConfig().HeimdallTxConfirmTimeout
+}
+
+// Lookup returns state of event, false if event is unknown or its in-flight entry expired
+func (i *Index) Lookup(ref Ref) (string, bool) {
+	entry, err := i.get(ref)
+	if err != nil || entry == nil {
+		return "", false
+	}
+
+	if entry.State == StateInFlight && time.Since(entry.UpdatedAt) >= i.inFlightTTL {
+		return "", false
+	}
+
+	return entry.State, true
+}
+
+// RetryIn returns how long until in-flight entry of event expires, zero if event isn't in flight
+func (i *Index) RetryIn(ref Ref) time.Duration {
+	entry, err := i.get(ref)
+	if err != nil || entry == nil || entry.State != StateInFlight {
+		return 0
+	}
+
+	if left := i.inFlightTTL - time.Since(entry.UpdatedAt); left > 0 {
+		return left
+	}
+
+	return 0
+}
+
+// MarkInFlight marks event in flight, processed events stay processed
+func (i *Index) MarkInFlight(ref Ref) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	entry, err := i.get(ref)
+	if err != nil {
+		return err
+	}
+
+	if entry != nil && entry.State == StateProcessed {
+		return nil
+	}
+
+	return i.put(&Entry{Ref: ref, State: StateInFlight, UpdatedAt: time.Now().UTC()})
+}
+
+// MarkProcessed marks event processed
+func (i *Index) MarkProcessed(ref Ref) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	return i.put(&Entry{Ref: ref, State: StateProcessed, UpdatedAt: time.Now().UTC()})
+}
+
+// Forget removes in-flight entry of event, so it is sent again
+func (i *Index) Forget(ref Ref) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	entry, err := i.get(ref)
+	if err != nil || entry == nil || entry.State != StateInFlight {
+		return err
+	}
+
+	return i.db.Delete(key(ref), nil)
+}
+
+// TrackTx marks events of heimdall tx included in a block in flight, until ResolveTx is called with its side-tx result
+func (i *Index) TrackTx(hash string, refs []Ref) error {
+	if len(refs) == 0 {
+		return nil
+	}
+
+	for _, ref := range refs {
+		if err := i.MarkInFlight(ref); err != nil {
+			return err
+		}
+	}
+
+	value, err := json.Marshal(&Tx{Hash: strings.ToLower(hash), Refs: refs, UpdatedAt: time.Now().UTC()})
+	if err != nil {
+		return err
+	}
+	return i.db.Put(txKey(hash), value, nil)
+}
+
+// ResolveTx marks events of tracked heimdall tx processed if approved, otherwise they are sent again.
+// Unknown txs are ignored.
+func (i *Index) ResolveTx(hash string, approved bool) error {
+	value, err := i.db.Get(txKey(hash), nil)
+	if err == leveldb.ErrNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var tx Tx
+	if err := json.Unmarshal(value, &tx); err != nil {
+		return err
+	}
+
+	for _, ref := range tx.Refs {
+		if approved {
+			err = i.MarkProcessed(ref)
+		} else {
+			err = i.Forget(ref)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return i.db.Delete(txKey(hash), nil)
+}
+
+// Prune removes processed entries last updated before retention, expired in-flight entries
+// and tracked txs, it returns number of removed entries
+func (i *Index) Prune(retention time.Duration) (int, error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	batch := new(leveldb.Batch)
+
+	txIter := i.db.NewIterator(levelUtil.BytesPrefix([]byte(txPrefix)), nil)
+	defer txIter.Release()
+	for txIter.Next() {
+		var tx Tx
+		if err := json.Unmarshal(txIter.Value(), &tx); err != nil {
+			return 0, err
+		}
+
+		if time.Since(tx.UpdatedAt) >= i.inFlightTTL {
+			batch.Delete(append([]byte(nil), txIter.Key()...))
+		}
+	}
+	if err := txIter.Error(); err != nil {
+		return 0, err
+	}
+
+	iter := i.db.NewIterator(levelUtil.BytesPrefix([]byte(entryPrefix)), nil)
+	defer iter.Release()
+	for iter.Next() {
+		var entry Entry
+		if err := json.Unmarshal(iter.Value(), &entry); err != nil {
+			return 0, err
+		}
+
+		age := time.Since(entry.UpdatedAt)
+		if (entry.State == StateProcessed && age >= retention) || (entry.State == StateInFlight && age >= i.inFlightTTL) {
+			batch.Delete(append([]byte(nil), iter.Key()...))
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return 0, err
+	}
+
+	return batch.Len(), i.db.Write(batch, nil)
+}
+
+func (i *Index) get(ref Ref) (*Entry, error) {
+	value, err := i.db.Get(key(ref), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(value, &entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (i *Index) put(entry *Entry) error {
+	value, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return i.db.Put(key(entry.Ref), value, nil)
+}
+
+func key(ref Ref) []byte {
+	return []byte(fmt.Sprintf("%s%s-%s-%d", entryPrefix, ref.Event, ref.TxHash, ref.LogIndex))
+}
+
+func txKey(hash string) []byte {
+	return []byte(txPrefix + strings.ToLower(hash))
+}
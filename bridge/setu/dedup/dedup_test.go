@@ -0,0 +1,115 @@
+package dedup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const txHash = "0xAB00000000000000000000000000000000000000000000000000000000000001"
+
+func TestIndex(t *testing.T) {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	index := NewIndex(db, time.Minute)
+	ref := NewRef(util.ClerkEvent, txHash, 2)
+
+	_, ok := index.Lookup(ref)
+	require.False(t, ok)
+
+	// sent, then approved
+	require.NoError(t, index.MarkInFlight(ref))
+	state, ok := index.Lookup(ref)
+	require.True(t, ok)
+	require.Equal(t, StateInFlight, state)
+	require.True(t, index.RetryIn(ref) > 0)
+
+	require.NoError(t, index.MarkProcessed(ref))
+	require.Zero(t, index.RetryIn(ref))
+	require.NoError(t, index.MarkInFlight(ref))
+	require.NoError(t, index.Forget(ref))
+	state, _ = index.Lookup(NewRef(util.ClerkEvent, txHash, 2))
+	require.Equal(t, StateProcessed, state)
+
+	// other log index and event type are different events
+	_, ok = index.Lookup(NewRef(util.ClerkEvent, txHash, 3))
+	require.False(t, ok)
+	_, ok = index.Lookup(NewRef(util.StakingEvent, txHash, 2))
+	require.False(t, ok)
+
+	// rejected in-flight event is sent again
+	other := NewRef(util.StakingEvent, txHash, 2)
+	require.NoError(t, index.MarkInFlight(other))
+	require.NoError(t, index.Forget(other))
+	_, ok = index.Lookup(other)
+	require.False(t, ok)
+
+	// expired in-flight entries are ignored and pruned
+	expiring := NewIndex(db, 0)
+	require.NoError(t, expiring.MarkInFlight(other))
+	_, ok = expiring.Lookup(other)
+	require.False(t, ok)
+
+	pruned, err := expiring.Prune(time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, 1, pruned)
+
+	pruned, err = expiring.Prune(0)
+	require.NoError(t, err)
+	require.Equal(t, 1, pruned)
+	_, ok = index.Lookup(ref)
+	require.False(t, ok)
+}
+
+func TestTrackTx(t *testing.T) {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	index := NewIndex(db, time.Minute)
+	approved := NewRef(util.ClerkEvent, txHash, 1)
+	rejected := NewRef(util.ClerkEvent, txHash, 2)
+
+	// included in a block
+	require.NoError(t, index.TrackTx("0xAA", []Ref{approved}))
+	require.NoError(t, index.TrackTx("0xBB", []Ref{rejected}))
+	state, _ := index.Lookup(approved)
+	require.Equal(t, StateInFlight, state)
+
+	// side-tx results of next block, hash case doesn't matter
+	require.NoError(t, index.ResolveTx("0xaa", true))
+	require.NoError(t, index.ResolveTx("0xBB", false))
+	require.NoError(t, index.ResolveTx("0xCC", true))
+
+	state, _ = index.Lookup(approved)
+	require.Equal(t, StateProcessed, state)
+	_, ok := index.Lookup(rejected)
+	require.False(t, ok)
+
+	// unresolved txs are pruned after in-flight ttl
+	require.NoError(t, index.TrackTx("0xDD", []Ref{rejected}))
+	pruned, err := NewIndex(db, 0).Prune(time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, 2, pruned)
+}
+
+func TestMsgRef(t *testing.T) {
+	msg := clerkTypes.MsgEventRecord{TxHash: hmTypes.HexToHeimdallHash(txHash), LogIndex: 2}
+	ref, ok := MsgRef(msg)
+	require.True(t, ok)
+	require.Equal(t, NewRef(util.ClerkEvent, txHash, 2), ref)
+
+	// checkpoints are not rootchain events sent by processors
+	_, ok = MsgRef(checkpointTypes.MsgCheckpointAck{})
+	require.False(t, ok)
+}
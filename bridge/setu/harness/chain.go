@@ -0,0 +1,254 @@
+package harness
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http/httptest"
+	"sync"
+
+	ethereum "github.com/maticnetwork/bor"
+	"github.com/maticnetwork/bor/accounts/abi"
+	"github.com/maticnetwork/bor/accounts/abi/bind"
+	"github.com/maticnetwork/bor/accounts/abi/bind/backends"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
+	"github.com/maticnetwork/bor/core"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/maticnetwork/bor/eth/filters"
+	"github.com/maticnetwork/bor/rpc"
+)
+
+const (
+	simulatedGasLimit = 10000000
+	emitLogGas        = 1000000
+)
+
+// emitterCode is the runtime code deployed at simulated contract addresses without their own code.
+// It emits a log from its calldata laid out as
+// `topic count | topic0 | topic1 | topic2 | topic3 | data`, each but data being 32 bytes,
+// so any event of the real contracts can be logged from their address.
+var emitterCode = common.FromHex(
+	"60a0360380" + // size = calldatasize - 0xa0
+		"60a0600037" + // copy data to memory 0
+		"608035606035604035602035" + // load topic3..topic0
+		"846000" + // size, offset
+		"600035600302602301" + // jump to 0x23 + 3 * topic count
+		"56" +
+		"5ba000" + // LOG0
+		"5ba100" + // LOG1
+		"5ba200" + // LOG2
+		"5ba300" + // LOG3
+		"5ba400", // LOG4
+)
+
+// stateSenderCode is the runtime code deployed at simulated state sender. It implements
+// `counter()` and `syncState(address,bytes)` of the real contract, so the state sender binding
+// can be used against it: every sync increments counter and emits StateSynced(counter, receiver, data).
+// Receiver registrations are not checked.
+var stateSenderCode = common.FromHex(
+	"600035" + "60e01c" + // selector = calldata[0:4]
+		"80" + "6361bc221a" + "14" + "601d57" + // counter() -> 0x1d
+		"6316f19831" + "14" + "602a57" + // syncState(address,bytes) -> 0x2a
+		"600080fd" + // revert
+		"5b" + "50" + "600054" + "600052" + "60206000f3" + // 0x1d: return counter
+		"5b" + "600054" + "600101" + "80600055" + // 0x2a: id = ++counter
+		"6020600052" + // memory: data offset
+		"60443603" + "6044602037" + // memory: data length and data, copied from calldata
+		"600435" + "90" + // receiver, id
+		"7f103fed9db65eac19c4d870f49ab7520fe03b99f1838e5996caf47e9e43308392" + // StateSynced
+		"60243603" + "6000" + "a3" + "00", // LOG3
+)
+
+// SimulatedChain is an in-process EVM chain served over JSON-RPC.
+//
+// Contract bindings of this repository are generated from ABI only and carry no deploy bytecode,
+// so the real contracts can't be deployed. State sender runs stateSenderCode, a minimal implementation
+// driven through its binding. Other contracts are log emitters, they don't hold the state of the real
+// contracts and their events are logged with EmitEvent from their addresses, as bridge only reads their logs.
+type SimulatedChain struct {
+	Backend *backends.SimulatedBackend
+	Server  *httptest.Server
+
+	auth *bind.TransactOpts
+
+	// serializes sending and mining
+	mutex sync.Mutex
+}
+
+// NewSimulatedChain starts simulated chain with contracts of given runtime code, emitterCode if nil
+func NewSimulatedChain(contracts map[common.Address][]byte) (*SimulatedChain, error) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	auth := bind.NewKeyedTransactor(key)
+
+	balance, _ := big.NewInt(0).SetString("1000000000000000000000", 10)
+	alloc := core.GenesisAlloc{
+		auth.From: {Balance: balance},
+	}
+	for contract, code := range contracts {
+		if code == nil {
+			code = emitterCode
+		}
+		alloc[contract] = core.GenesisAccount{Code: code, Balance: big.NewInt(0)}
+	}
+
+	chain := &SimulatedChain{
+		Backend: backends.NewSimulatedBackend(alloc, simulatedGasLimit),
+		auth:    auth,
+	}
+
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", &ethAPI{chain: chain}); err != nil {
+		return nil, err
+	}
+	chain.Server = httptest.NewServer(server)
+
+	return chain, nil
+}
+
+// URL returns JSON-RPC endpoint of chain
+func (c *SimulatedChain) URL() string {
+	return c.Server.URL
+}
+
+// Close stops the JSON-RPC server
+func (c *SimulatedChain) Close() {
+	c.Server.Close()
+}
+
+// Mine commits given number of empty blocks
+func (c *SimulatedChain) Mine(blocks int) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for i := 0; i < blocks; i++ {
+		c.Backend.Commit()
+	}
+}
+
+// BlockNumber returns latest block number
+func (c *SimulatedChain) BlockNumber() uint64 {
+	return c.Backend.Blockchain().CurrentHeader().Number.Uint64()
+}
+
+// EmitLog makes contract at given address log topics and data, and mines the tx in a new block
+func (c *SimulatedChain) EmitLog(contract common.Address, topics []common.Hash, data []byte) (*types.Receipt, error) {
+	if len(topics) > 4 {
+		return nil, fmt.Errorf("too many topics: %v", len(topics))
+	}
+
+	calldata := make([]byte, 0, 5*common.HashLength+len(data))
+	calldata = append(calldata, common.BigToHash(big.NewInt(int64(len(topics)))).Bytes()...)
+	for i := 0; i < 4; i++ {
+		topic := common.Hash{}
+		if i < len(topics) {
+			topic = topics[i]
+		}
+		calldata = append(calldata, topic.Bytes()...)
+	}
+	calldata = append(calldata, data...)
+
+	return c.Transact(func(auth *bind.TransactOpts) (*types.Transaction, error) {
+		nonce, err := c.Backend.PendingNonceAt(context.Background(), auth.From)
+		if err != nil {
+			return nil, err
+		}
+
+		tx, err := auth.Signer(types.HomesteadSigner{}, auth.From, types.NewTransaction(nonce, contract, big.NewInt(0), emitLogGas, big.NewInt(1), calldata))
+		if err != nil {
+			return nil, err
+		}
+
+		return tx, c.Backend.SendTransaction(context.Background(), tx)
+	})
+}
+
+// Transact sends tx created by send, typically through a contract binding, and mines it in a new block
+func (c *SimulatedChain) Transact(send func(auth *bind.TransactOpts) (*types.Transaction, error)) (*types.Receipt, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	tx, err := send(c.auth)
+	if err != nil {
+		return nil, err
+	}
+	c.Backend.Commit()
+
+	receipt, err := c.Backend.TransactionReceipt(context.Background(), tx.Hash())
+	if err != nil {
+		return nil, err
+	}
+	if receipt == nil || receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("tx to %v failed", tx.To().Hex())
+	}
+
+	return receipt, nil
+}
+
+// EmitEvent logs event of contract ABI from given address.
+// indexed are the topics of indexed inputs in order and args are values of the other inputs.
+func (c *SimulatedChain) EmitEvent(contract common.Address, contractABI abi.ABI, name string, indexed []common.Hash, args ...interface{}) (*types.Receipt, error) {
+	event, ok := contractABI.Events[name]
+	if !ok {
+		return nil, fmt.Errorf("no event %v in abi", name)
+	}
+
+	data, err := event.Inputs.NonIndexed().Pack(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.EmitLog(contract, append([]common.Hash{event.Id()}, indexed...), data)
+}
+
+//
+// JSON-RPC
+//
+
+// ethAPI serves the subset of `eth` namespace used by heimdall and bridge
+type ethAPI struct {
+	chain *SimulatedChain
+}
+
+// BlockNumber returns latest block number
+func (api *ethAPI) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(api.chain.BlockNumber())
+}
+
+// ChainId returns chain id of simulated backend
+func (api *ethAPI) ChainId() *hexutil.Big { // nolint: golint
+	return (*hexutil.Big)(api.chain.Backend.Blockchain().Config().ChainID)
+}
+
+// GetBlockByNumber returns header of block, transactions are not included
+func (api *ethAPI) GetBlockByNumber(number rpc.BlockNumber, fullTx bool) (*types.Header, error) {
+	blockchain := api.chain.Backend.Blockchain()
+	if number < 0 {
+		return blockchain.CurrentHeader(), nil
+	}
+
+	return blockchain.GetHeaderByNumber(uint64(number)), nil
+}
+
+// GetLogs returns logs matching filter
+func (api *ethAPI) GetLogs(ctx context.Context, crit filters.FilterCriteria) ([]types.Log, error) {
+	if crit.BlockHash != nil {
+		return nil, errors.New("filtering by block hash is not supported")
+	}
+
+	logs, err := api.chain.Backend.FilterLogs(ctx, ethereum.FilterQuery(crit))
+	if logs == nil {
+		logs = []types.Log{}
+	}
+	return logs, err
+}
+
+// GetTransactionReceipt returns receipt of mined tx
+func (api *ethAPI) GetTransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	return api.chain.Backend.TransactionReceipt(ctx, hash)
+}
@@ -0,0 +1,79 @@
+package harness
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/stretchr/testify/require"
+
+	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+var h *Harness
+
+func TestMain(m *testing.M) {
+	var err error
+	if h, err = Start("clerk"); err != nil {
+		fmt.Println("Error while starting harness", err)
+		os.Exit(1)
+	}
+
+	code := m.Run()
+	h.Stop()
+	os.Exit(code)
+}
+
+// waitForEventRecord waits until heimdall has event record of state id
+func waitForEventRecord(t *testing.T, id uint64) clerkTypes.EventRecord {
+	// task is delayed by validator position, see util.CalculateTaskDelay
+	var record clerkTypes.EventRecord
+	synced := h.WaitFor(2*time.Minute, func() bool {
+		result, err := h.Query(fmt.Sprintf("/clerk/event-record/%v", id))
+		return err == nil && json.Unmarshal(result, &record) == nil
+	})
+	require.True(t, synced, "event record %v was not added", id)
+	return record
+}
+
+func TestStateSyncedToEventRecord(t *testing.T) {
+	receiver := common.HexToAddress("0x0000000000000000000000000000000000001001")
+	data := []byte("state sync harness")
+
+	event, err := h.SyncState(receiver, data)
+	require.NoError(t, err)
+
+	record := waitForEventRecord(t, event.Id.Uint64())
+	require.Equal(t, event.Id.Uint64(), record.ID)
+	require.Equal(t, hmTypes.BytesToHeimdallAddress(receiver.Bytes()), record.Contract)
+	require.Equal(t, hmTypes.HexBytes(data), record.Data)
+	require.Equal(t, hmTypes.BytesToHeimdallHash(event.Raw.TxHash.Bytes()), record.TxHash)
+	require.Equal(t, uint64(event.Raw.Index), record.LogIndex)
+	require.Equal(t, h.ChainParams.ChainParams.BorChainID, record.ChainID)
+}
+
+func TestMultipleStateSyncsToEventRecords(t *testing.T) {
+	receiver := common.HexToAddress("0x0000000000000000000000000000000000001001")
+
+	// state syncs in consecutive blocks, every one of them is sent in its own heimdall tx
+	var ids []uint64
+	for i := 0; i < 5; i++ {
+		event, err := h.SyncState(receiver, []byte(fmt.Sprintf("state sync %v", i)))
+		require.NoError(t, err)
+		ids = append(ids, event.Id.Uint64())
+	}
+
+	for i, id := range ids {
+		if i > 0 {
+			require.Equal(t, ids[i-1]+1, id)
+		}
+
+		record := waitForEventRecord(t, id)
+		require.Equal(t, id, record.ID)
+		require.Equal(t, hmTypes.HexBytes(fmt.Sprintf("state sync %v", i)), record.Data)
+	}
+}
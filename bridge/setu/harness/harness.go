@@ -0,0 +1,317 @@
+// Package harness runs the bridge end-to-end in one process for integration tests.
+//
+// It wires simulated mainchain and bor EVM chains, a single validator heimdall node
+// running HeimdallApp with its REST server, and bridge listener and processor services
+// sharing an in-memory task queue, so no RabbitMQ, Ethereum or Heimdall deployment is needed.
+package harness
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/maticnetwork/bor/accounts/abi/bind"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/spf13/viper"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/tendermint/tendermint/libs/log"
+	httpClient "github.com/tendermint/tendermint/rpc/client"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/bridge/setu/broadcaster"
+	"github.com/maticnetwork/heimdall/bridge/setu/listener"
+	"github.com/maticnetwork/heimdall/bridge/setu/processor"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	"github.com/maticnetwork/heimdall/contracts/statesender"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	// MainchainTxConfirmations is the number of blocks mainchain events need before heimdall accepts them
+	MainchainTxConfirmations = 2
+
+	// startTimeout is how long Start waits for heimdall to produce blocks
+	startTimeout = 30 * time.Second
+)
+
+// addresses of simulated mainchain contracts
+var (
+	RootChainAddress   = common.HexToAddress("0x0000000000000000000000000000000000000a01")
+	StakingInfoAddress = common.HexToAddress("0x0000000000000000000000000000000000000a02")
+	StateSenderAddress = common.HexToAddress("0x0000000000000000000000000000000000000a03")
+)
+
+// only one harness can run at a time, helper config, viper and bridge db are process wide
+var running int32
+
+// Harness is a running bridge with simulated chains and heimdall node
+type Harness struct {
+	MainChain *SimulatedChain
+	BorChain  *SimulatedChain
+
+	// heimdall
+	App         *app.HeimdallApp
+	ChainParams chainmanagerTypes.Params
+	CliCtx      cliContext.CLIContext
+
+	// bridge
+	QueueConnector *queue.QueueConnector
+	TxBroadcaster  *broadcaster.TxBroadcaster
+
+	home       string
+	node       *heimdallNode
+	restServer *httptest.Server
+	httpClient *httpClient.HTTP
+	queueDB    *leveldb.DB
+	services   []interface{ Stop() error }
+}
+
+// Start starts chains, heimdall and bridge with given processors (`clerk`, `staking`, ...).
+// Helper config is initialized once per process, so Start can only be called once per test binary.
+func Start(processors ...string) (*Harness, error) {
+	if !atomic.CompareAndSwapInt32(&running, 0, 1) {
+		return nil, errors.New("harness is already started in this process")
+	}
+
+	// util helpers log through bridge logger
+	util.Logger()
+
+	home, err := ioutil.TempDir("", "heimdall-harness")
+	if err != nil {
+		return nil, err
+	}
+	h := &Harness{home: home}
+
+	if err := h.start(processors); err != nil {
+		h.Stop()
+		return nil, err
+	}
+
+	return h, nil
+}
+
+func (h *Harness) start(processors []string) (err error) {
+	// chains
+	h.MainChain, err = NewSimulatedChain(map[common.Address][]byte{
+		RootChainAddress:   nil,
+		StakingInfoAddress: nil,
+		StateSenderAddress: stateSenderCode,
+	})
+	if err != nil {
+		return err
+	}
+	if h.BorChain, err = NewSimulatedChain(nil); err != nil {
+		return err
+	}
+
+	// heimdall
+	rpcAddr, err := freeAddr()
+	if err != nil {
+		return err
+	}
+	p2pAddr, err := freeAddr()
+	if err != nil {
+		return err
+	}
+
+	h.restServer = httptest.NewServer(newRestServer(rpcAddr).Mux)
+
+	heimdallConfig := helper.GetDefaultHeimdallConfig()
+	heimdallConfig.EthRPCUrl = h.MainChain.URL()
+	heimdallConfig.BorRPCUrl = h.BorChain.URL()
+	heimdallConfig.TendermintRPCUrl = "http://" + rpcAddr
+	heimdallConfig.HeimdallServerURL = h.restServer.URL
+	heimdallConfig.SyncerPollInterval = time.Second
+	heimdallConfig.TaskQueueBackend = queue.EmbeddedBackend
+
+	h.ChainParams = chainmanagerTypes.DefaultParams()
+	h.ChainParams.MainchainTxConfirmations = MainchainTxConfirmations
+	h.ChainParams.ChainParams.RootChainAddress = hmTypes.BytesToHeimdallAddress(RootChainAddress.Bytes())
+	h.ChainParams.ChainParams.StakingInfoAddress = hmTypes.BytesToHeimdallAddress(StakingInfoAddress.Bytes())
+	h.ChainParams.ChainParams.StateSenderAddress = hmTypes.BytesToHeimdallAddress(StateSenderAddress.Bytes())
+	h.ChainParams.ChainParams.MaticTokenAddress = hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000000a04")
+	h.ChainParams.ChainParams.StakingManagerAddress = hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000000a05")
+	h.ChainParams.ChainParams.SlashManagerAddress = hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000000a06")
+
+	tmConfig := newTendermintConfig(h.home, rpcAddr, p2pAddr)
+	if err := writeHeimdallHome(h.home, tmConfig, heimdallConfig, h.ChainParams); err != nil {
+		return err
+	}
+	helper.InitHeimdallConfigWith(h.home, "")
+
+	if h.node, err = startHeimdallNode(tmConfig, log.NewNopLogger()); err != nil {
+		return err
+	}
+	h.App = h.node.app
+
+	cdc := app.MakeCodec()
+	h.CliCtx = cliContext.NewCLIContext().WithCodec(cdc)
+	h.CliCtx.BroadcastMode = client.BroadcastAsync
+	h.CliCtx.TrustNode = true
+
+	if !h.WaitFor(startTimeout, func() bool { return util.GetBlockHeight(h.CliCtx) > 1 }) {
+		return errors.New("heimdall didn't produce blocks")
+	}
+
+	// bridge
+	viper.Set(util.BridgeDBFlag, filepath.Join(h.home, "bridge"))
+	viper.Set("only", processors)
+
+	if h.queueDB, err = leveldb.Open(storage.NewMemStorage(), nil); err != nil {
+		return err
+	}
+	h.QueueConnector = queue.NewEmbeddedQueueConnector(h.queueDB)
+
+	h.httpClient = httpClient.NewHTTP(heimdallConfig.TendermintRPCUrl, "/websocket")
+	if err := h.httpClient.Start(); err != nil {
+		return err
+	}
+
+	h.TxBroadcaster = broadcaster.NewTxBroadcaster(cdc)
+
+	processorService := processor.NewProcessorService(cdc, h.QueueConnector, h.httpClient, h.TxBroadcaster)
+	if err := processorService.Start(); err != nil {
+		return err
+	}
+	h.services = append(h.services, processorService)
+
+	listenerService := listener.NewListenerService(cdc, h.QueueConnector, h.httpClient)
+	if err := listenerService.Start(); err != nil {
+		return err
+	}
+	h.services = append(h.services, listenerService)
+
+	h.QueueConnector.StartWorker()
+
+	// rootchain listener starts from the latest block, events are only picked up once it keeps a cursor
+	bridgeDB := util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag))
+	if !h.WaitFor(startTimeout, func() bool {
+		h.MainChain.Mine(1)
+		_, found, err := listener.GetCursor(bridgeDB, listener.RootChainListenerStr)
+		return err == nil && found
+	}) {
+		return errors.New("rootchain listener didn't start")
+	}
+
+	return nil
+}
+
+// Stop stops bridge, heimdall and chains and removes their data
+func (h *Harness) Stop() {
+	logger := util.Logger().With("module", "harness")
+
+	for i := len(h.services) - 1; i >= 0; i-- {
+		if err := h.services[i].Stop(); err != nil {
+			logger.Error("Stop | service.Stop", "Error", err)
+		}
+	}
+
+	if h.QueueConnector != nil {
+		h.QueueConnector.Server.GetBroker().StopConsuming()
+	}
+
+	if h.httpClient != nil && h.httpClient.IsRunning() {
+		if err := h.httpClient.Stop(); err != nil {
+			logger.Error("Stop | httpClient.Stop", "Error", err)
+		}
+	}
+
+	if h.node != nil {
+		if err := h.node.stop(); err != nil {
+			logger.Error("Stop | node.stop", "Error", err)
+		}
+	}
+
+	if h.restServer != nil {
+		h.restServer.Close()
+	}
+
+	for _, chain := range []*SimulatedChain{h.MainChain, h.BorChain} {
+		if chain != nil {
+			chain.Close()
+		}
+	}
+
+	if h.queueDB != nil {
+		if err := h.queueDB.Close(); err != nil {
+			logger.Error("Stop | queueDB.Close", "Error", err)
+		}
+	}
+	util.CloseBridgeDBInstance()
+
+	if err := os.RemoveAll(h.home); err != nil {
+		logger.Error("Stop | RemoveAll", "Error", err)
+	}
+}
+
+// SyncState calls syncState of state sender through its binding and mines enough blocks for
+// StateSynced to be confirmed. State id is assigned by the contract.
+func (h *Harness) SyncState(contract common.Address, data []byte) (*statesender.StatesenderStateSynced, error) {
+	stateSender, err := statesender.NewStatesender(StateSenderAddress, h.MainChain.Backend)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt, err := h.MainChain.Transact(func(auth *bind.TransactOpts) (*types.Transaction, error) {
+		return stateSender.SyncState(auth, contract, data)
+	})
+	if err != nil {
+		return nil, err
+	}
+	// bor also logs fee transfer of every tx
+	for _, vLog := range receipt.Logs {
+		if vLog.Address != StateSenderAddress {
+			continue
+		}
+
+		event, err := stateSender.ParseStateSynced(*vLog)
+		if err != nil {
+			return nil, err
+		}
+		// simulated backend leaves tx hash of receipt logs empty
+		event.Raw.TxHash = receipt.TxHash
+
+		h.MainChain.Mine(MainchainTxConfirmations)
+		return event, nil
+	}
+
+	return nil, fmt.Errorf("no StateSynced in tx %v", receipt.TxHash.Hex())
+}
+
+// Query fetches result of heimdall REST endpoint
+func (h *Harness) Query(endpoint string) (json.RawMessage, error) {
+	response, err := helper.FetchFromAPI(h.CliCtx, helper.GetHeimdallServerEndpoint(endpoint))
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Result, nil
+}
+
+// WaitFor polls condition until it holds or timeout passes, it returns whether condition holds
+func (h *Harness) WaitFor(timeout time.Duration, condition func() bool) bool {
+	deadline := time.Now().Add(timeout)
+	for {
+		if condition() {
+			return true
+		}
+
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+}
@@ -0,0 +1,199 @@
+package harness
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+	"path/filepath"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/lcd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/viper"
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/privval"
+	"github.com/tendermint/tendermint/proxy"
+	tmTypes "github.com/tendermint/tendermint/types"
+	tmTime "github.com/tendermint/tendermint/types/time"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/maticnetwork/heimdall/app"
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	borTypes "github.com/maticnetwork/heimdall/bor/types"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/server"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	topupTypes "github.com/maticnetwork/heimdall/topup/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	chainID = "heimdall-harness"
+
+	// blocks are produced quickly so side txs are voted on and applied within a second
+	blockTimeout = 200 * time.Millisecond
+)
+
+// heimdallNode is a single validator heimdall node running in process
+type heimdallNode struct {
+	app  *app.HeimdallApp
+	node *node.Node
+}
+
+// writeHeimdallHome writes tendermint, heimdall and genesis config of a single validator chain to home
+func writeHeimdallHome(home string, tmConfig *cfg.Config, heimdallConfig helper.Configuration, chainParams chainmanagerTypes.Params) error {
+	cfg.EnsureRoot(home)
+
+	if _, err := p2p.LoadOrGenNodeKey(tmConfig.NodeKeyFile()); err != nil {
+		return err
+	}
+
+	helper.WriteConfigFile(filepath.Join(home, "config", "heimdall-config.toml"), &heimdallConfig)
+
+	// validator with all of the voting power
+	filePV := privval.LoadOrGenFilePV(tmConfig.PrivValidatorKeyFile(), tmConfig.PrivValidatorStateFile())
+	pubkey := helper.GetPubObjects(filePV.GetPubKey())
+	validator := hmTypes.NewValidator(hmTypes.NewValidatorID(1), 0, 0, 1, 1,
+		hmTypes.NewPubKey(pubkey[:]),
+		hmTypes.BytesToHeimdallAddress(filePV.GetAddress().Bytes()))
+
+	vals := []*hmTypes.Validator{validator}
+	validatorSet := hmTypes.NewValidatorSet(vals)
+
+	valSigningInfo := hmTypes.NewValidatorSigningInfo(validator.ID, 0, 0, 0)
+	valSigningInfoMap := map[string]hmTypes.ValidatorSigningInfo{valSigningInfo.ValID.String(): valSigningInfo}
+
+	// validator account pays fees of bridge txs
+	account := authTypes.NewBaseAccountWithAddress(validator.Signer)
+	balance, _ := big.NewInt(0).SetString("1000000000000000000000", 10)
+	if err := account.SetCoins(sdk.Coins{sdk.Coin{Denom: authTypes.FeeToken, Amount: sdk.NewIntFromBigInt(balance)}}); err != nil {
+		return err
+	}
+	genesisAccount, err := authTypes.NewGenesisAccountI(&account)
+	if err != nil {
+		return err
+	}
+
+	appState := app.NewDefaultGenesisState()
+	if appState, err = authTypes.SetGenesisStateToAppState(appState, []authTypes.GenesisAccount{genesisAccount}); err != nil {
+		return err
+	}
+	if appState, err = stakingTypes.SetGenesisStateToAppState(appState, vals, *validatorSet); err != nil {
+		return err
+	}
+	if appState, err = slashingTypes.SetGenesisStateToAppState(appState, valSigningInfoMap); err != nil {
+		return err
+	}
+	if appState, err = borTypes.SetGenesisStateToAppState(appState, *validatorSet); err != nil {
+		return err
+	}
+	if appState, err = topupTypes.SetGenesisStateToAppState(appState, []hmTypes.DividendAccount{hmTypes.NewDividendAccount(validator.Signer, "0")}); err != nil {
+		return err
+	}
+	appState[chainmanagerTypes.ModuleName] = chainmanagerTypes.ModuleCdc.MustMarshalJSON(chainmanagerTypes.NewGenesisState(chainParams))
+
+	appStateJSON, err := json.Marshal(appState)
+	if err != nil {
+		return err
+	}
+
+	genDoc := tmTypes.GenesisDoc{
+		GenesisTime: tmTime.Now(),
+		ChainID:     chainID,
+		AppState:    appStateJSON,
+	}
+	if err := genDoc.ValidateAndComplete(); err != nil {
+		return err
+	}
+
+	return genDoc.SaveAs(tmConfig.GenesisFile())
+}
+
+// startHeimdallNode starts tendermint node with HeimdallApp, helper config must already be initialized
+func startHeimdallNode(tmConfig *cfg.Config, logger log.Logger) (*heimdallNode, error) {
+	nodeKey, err := p2p.LoadOrGenNodeKey(tmConfig.NodeKeyFile())
+	if err != nil {
+		return nil, err
+	}
+
+	heimdallApp := app.Setup(true)
+	tmNode, err := node.NewNode(
+		tmConfig,
+		privval.LoadOrGenFilePV(tmConfig.PrivValidatorKeyFile(), tmConfig.PrivValidatorStateFile()),
+		nodeKey,
+		proxy.NewLocalClientCreator(heimdallApp),
+		node.DefaultGenesisDocProviderFunc(tmConfig),
+		func(*node.DBContext) (dbm.DB, error) {
+			return dbm.NewMemDB(), nil
+		},
+		node.DefaultMetricsProvider(tmConfig.Instrumentation),
+		logger,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tmNode.Start(); err != nil {
+		return nil, err
+	}
+
+	return &heimdallNode{app: heimdallApp, node: tmNode}, nil
+}
+
+// stop stops tendermint node
+func (n *heimdallNode) stop() error {
+	if err := n.node.Stop(); err != nil {
+		return err
+	}
+	n.node.Wait()
+	return nil
+}
+
+// newTendermintConfig returns config of a node listening on given local addresses
+func newTendermintConfig(home string, rpcAddr string, p2pAddr string) *cfg.Config {
+	tmConfig := cfg.DefaultConfig()
+	tmConfig.SetRoot(home)
+	tmConfig.Moniker = chainID
+
+	tmConfig.RPC.ListenAddress = "tcp://" + rpcAddr
+	tmConfig.P2P.ListenAddress = "tcp://" + p2pAddr
+	tmConfig.P2P.AddrBookStrict = false
+	tmConfig.P2P.AllowDuplicateIP = true
+
+	tmConfig.Consensus.TimeoutPropose = blockTimeout
+	tmConfig.Consensus.TimeoutPrevote = blockTimeout
+	tmConfig.Consensus.TimeoutPrecommit = blockTimeout
+	tmConfig.Consensus.TimeoutCommit = blockTimeout
+
+	return tmConfig
+}
+
+// newRestServer creates heimdall REST server querying tendermint node at rpcAddr
+func newRestServer(rpcAddr string) *lcd.RestServer {
+	// rest server context reads node from viper
+	viper.Set(client.FlagNode, "tcp://"+rpcAddr)
+	viper.Set(client.FlagTrustNode, true)
+	viper.Set(client.FlagChainID, chainID)
+
+	rs := lcd.NewRestServer(app.MakeCodec())
+	server.RegisterRoutes(rs)
+	return rs
+}
+
+// freeAddr returns a local address with a free port
+func freeAddr() (string, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", fmt.Errorf("finding free port: %v", err)
+	}
+	defer l.Close()
+
+	return l.Addr().String(), nil
+}
@@ -143,12 +143,21 @@ func (bl *BaseListener) String() string {
 // startHeaderProcess starts header process when they get new header
 func (bl *BaseListener) StartHeaderProcess(ctx context.Context) {
 	bl.Logger.Info("Starting header process")
+
+	util.StartHeartbeat(bl.name, "header-process", util.DefaultHeartbeatTimeout)
+	ticker := time.NewTicker(util.HeartbeatInterval)
+	defer ticker.Stop()
+
 	for {
 		select {
 		case newHeader := <-bl.HeaderChannel:
 			bl.impl.ProcessHeader(newHeader)
+			util.Heartbeat(bl.name, "header-process")
+		case <-ticker.C:
+			util.Heartbeat(bl.name, "header-process")
 		case <-ctx.Done():
 			bl.Logger.Info("Header process stopped")
+			util.StopHeartbeat(bl.name, "header-process")
 			return
 		}
 	}
@@ -162,11 +171,13 @@ func (bl *BaseListener) StartPolling(ctx context.Context, pollInterval time.Dura
 	// Setup the ticket and the channel to signal
 	// the ending of the interval
 	ticker := time.NewTicker(interval)
+	util.StartHeartbeat(bl.name, "polling", util.HeartbeatTimeout(interval))
 
 	// start listening
 	for {
 		select {
 		case <-ticker.C:
+			util.Heartbeat(bl.name, "polling")
 			header, err := bl.chainClient.HeaderByNumber(ctx, nil)
 			if err == nil && header != nil {
 				// send data to channel
@@ -174,6 +185,7 @@ func (bl *BaseListener) StartPolling(ctx context.Context, pollInterval time.Dura
 			}
 		case <-ctx.Done():
 			bl.Logger.Info("Polling stopped")
+			util.StopHeartbeat(bl.name, "polling")
 			ticker.Stop()
 			return
 		}
@@ -181,10 +193,16 @@ func (bl *BaseListener) StartPolling(ctx context.Context, pollInterval time.Dura
 }
 
 func (bl *BaseListener) StartSubscription(ctx context.Context, subscription ethereum.Subscription) {
+	util.StartHeartbeat(bl.name, "subscription", util.DefaultHeartbeatTimeout)
+	ticker := time.NewTicker(util.HeartbeatInterval)
+	defer ticker.Stop()
+
 	for {
 		select {
+		case <-ticker.C:
+			util.Heartbeat(bl.name, "subscription")
 		case err := <-subscription.Err():
-			// stop service
+			// stop service, heartbeat goes stale
 			bl.Logger.Error("Error while subscribing new blocks", "error", err)
 			// bl.Stop()
 
@@ -195,6 +213,7 @@ func (bl *BaseListener) StartSubscription(ctx context.Context, subscription ethe
 			return
 		case <-ctx.Done():
 			bl.Logger.Info("Subscription stopped")
+			util.StopHeartbeat(bl.name, "subscription")
 			return
 		}
 	}
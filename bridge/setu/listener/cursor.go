@@ -0,0 +1,107 @@
+package listener
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	levelUtil "github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// cursorKeys maps listeners to storage key of their last processed block
+var cursorKeys = map[string]string{
+	RootChainListenerStr: lastRootBlockKey,
+	HeimdallListenerStr:  heimdallLastBlockKey,
+}
+
+// GetCursors returns stored last processed block of each listener which keeps one
+func GetCursors(db *leveldb.DB) (map[string]uint64, error) {
+	cursors := make(map[string]uint64)
+	for name := range cursorKeys {
+		block, found, err := GetCursor(db, name)
+		if err != nil {
+			return nil, err
+		} else if found {
+			cursors[name] = block
+		}
+	}
+
+	return cursors, nil
+}
+
+// GetCursor returns stored last processed block of listener, found is false if nothing is stored yet
+func GetCursor(db *leveldb.DB, listener string) (block uint64, found bool, err error) {
+	key, err := cursorKey(listener)
+	if err != nil {
+		return 0, false, err
+	}
+
+	value, err := db.Get([]byte(key), nil)
+	if err == leveldb.ErrNotFound {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, err
+	}
+
+	block, err = strconv.ParseUint(string(value), 10, 64)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return block, true, nil
+}
+
+// SetCursor stores last processed block of listener, listener continues from the next block.
+// Rootchain reorg records above the block are dropped so re-scanned blocks are tracked again.
+func SetCursor(db *leveldb.DB, listener string, block uint64) error {
+	key, err := cursorKey(listener)
+	if err != nil {
+		return err
+	}
+
+	batch := new(leveldb.Batch)
+	batch.Put([]byte(key), []byte(strconv.FormatUint(block, 10)))
+
+	if listener == RootChainListenerStr {
+		iter := db.NewIterator(levelUtil.BytesPrefix([]byte(rootBlockPrefix)), nil)
+		for iter.Next() {
+			number, err := strconv.ParseUint(string(iter.Key()[len(rootBlockPrefix):]), 10, 64)
+			if err != nil || number > block {
+				batch.Delete(append([]byte{}, iter.Key()...))
+			}
+		}
+		iter.Release()
+		if err := iter.Error(); err != nil {
+			return err
+		}
+	}
+
+	return db.Write(batch, nil)
+}
+
+// RewindCursor moves cursor of listener back by given number of blocks and returns new cursor
+func RewindCursor(db *leveldb.DB, listener string, blocks uint64) (uint64, error) {
+	block, found, err := GetCursor(db, listener)
+	if err != nil {
+		return 0, err
+	} else if !found {
+		return 0, fmt.Errorf("no cursor stored for %v listener", listener)
+	}
+
+	if blocks > block {
+		blocks = block
+	}
+
+	block = block - blocks
+	return block, SetCursor(db, listener, block)
+}
+
+// cursorKey returns storage key of listener cursor
+func cursorKey(listener string) (string, error) {
+	key, ok := cursorKeys[listener]
+	if !ok {
+		return "", fmt.Errorf("%v listener keeps no cursor, supported listeners: %v, %v", listener, RootChainListenerStr, HeimdallListenerStr)
+	}
+
+	return key, nil
+}
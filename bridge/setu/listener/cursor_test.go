@@ -0,0 +1,67 @@
+package listener
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+func TestGetCursors(t *testing.T) {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	// listeners without stored cursor are skipped
+	cursors, err := GetCursors(db)
+	require.NoError(t, err)
+	require.Empty(t, cursors)
+
+	require.NoError(t, db.Put([]byte(lastRootBlockKey), []byte("1200"), nil))
+	require.NoError(t, db.Put([]byte(heimdallLastBlockKey), []byte("56"), nil))
+
+	cursors, err = GetCursors(db)
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{RootChainListenerStr: 1200, HeimdallListenerStr: 56}, cursors)
+}
+
+func TestSetAndRewindCursor(t *testing.T) {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	// maticchain listener follows latest header and keeps no cursor
+	_, _, err = GetCursor(db, MaticChainListenerStr)
+	require.Error(t, err)
+	require.Error(t, SetCursor(db, MaticChainListenerStr, 10))
+
+	_, err = RewindCursor(db, HeimdallListenerStr, 10)
+	require.Error(t, err, "nothing to rewind")
+
+	require.NoError(t, db.Put(processedBlockKey(99), []byte("{}"), nil))
+	require.NoError(t, db.Put(processedBlockKey(100), []byte("{}"), nil))
+	require.NoError(t, SetCursor(db, RootChainListenerStr, 100))
+
+	block, err := RewindCursor(db, RootChainListenerStr, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(99), block)
+
+	block, found, err := GetCursor(db, RootChainListenerStr)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, uint64(99), block)
+
+	// reorg records above cursor are dropped
+	has, err := db.Has(processedBlockKey(100), nil)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = db.Has(processedBlockKey(99), nil)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// cursor doesn't go below zero
+	block, err = RewindCursor(db, RootChainListenerStr, 1000)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), block)
+}
@@ -4,25 +4,48 @@ import (
 	"context"
 	"encoding/json"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/RichardKnop/machinery/v1/tasks"
 	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/dedup"
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmTypes "github.com/tendermint/tendermint/types"
 )
 
 const (
 	heimdallLastBlockKey = "heimdall-last-block" // storage key
+
+	heimdallSubscriber  = "bridge-heimdall-listener"
+	resubscribeInterval = 10 * time.Second
+
+	dedupPruneInterval = time.Hour
 )
 
 // HeimdallListener - Listens to and process events from heimdall
 type HeimdallListener struct {
 	BaseListener
+
+	// serializes block processing of subscription and gap-filling polls
+	processMutex sync.Mutex
+
+	// time of last new block event, polling fills gaps only when events stop
+	lastEventAt time.Time
+
+	// rootchain events processed by heimdall or in flight, filled from processed blocks
+	dedupIndex *dedup.Index
 }
 
 // NewHeimdallListener - constructor func
@@ -44,8 +67,13 @@ func (hl *HeimdallListener) Start() error {
 		pollInterval = helper.GetConfig().CheckpointerPollInterval
 	}
 
-	hl.Logger.Info("Start polling for events", "pollInterval", pollInterval)
-	hl.StartPolling(headerCtx, pollInterval)
+	hl.Logger.Info("Start subscribing to new blocks, polling fills gaps", "pollInterval", pollInterval)
+	go hl.StartBlockSubscription(headerCtx)
+	go hl.StartPolling(headerCtx, pollInterval)
+
+	if hl.dedupIndex != nil {
+		go hl.startDedupPruning(headerCtx)
+	}
 	return nil
 }
 
@@ -54,7 +82,108 @@ func (hl *HeimdallListener) ProcessHeader(*types.Header) {
 
 }
 
-// StartPolling - starts polling for heimdall events
+// StartBlockSubscription - subscribes to new heimdall blocks and processes their begin block events
+func (hl *HeimdallListener) StartBlockSubscription(ctx context.Context) {
+	query := tmTypes.QueryForEvent(tmTypes.EventNewBlock).String()
+	util.StartHeartbeat(hl.name, "subscription", util.DefaultHeartbeatTimeout)
+
+	for {
+		eventCh, err := hl.httpClient.Subscribe(ctx, heimdallSubscriber, query)
+		if err != nil {
+			hl.Logger.Error("Error while subscribing to new blocks, retrying", "retryIn", resubscribeInterval, "error", err)
+			select {
+			case <-time.After(resubscribeInterval):
+				util.Heartbeat(hl.name, "subscription")
+				continue
+			case <-ctx.Done():
+				hl.Logger.Info("Subscription stopped")
+				util.StopHeartbeat(hl.name, "subscription")
+				return
+			}
+		}
+
+		hl.Logger.Info("Subscribed to new blocks")
+
+		// fill the gap since last processed block before handling events
+		hl.catchUp()
+
+		closed := hl.consumeBlockEvents(ctx, eventCh)
+		if err := hl.httpClient.Unsubscribe(context.Background(), heimdallSubscriber, query); err != nil {
+			hl.Logger.Debug("Error while unsubscribing from new blocks", "error", err)
+		}
+
+		if !closed {
+			hl.Logger.Info("Subscription stopped")
+			util.StopHeartbeat(hl.name, "subscription")
+			return
+		}
+	}
+}
+
+// consumeBlockEvents processes new block events until channel is closed (returns true) or context is done
+func (hl *HeimdallListener) consumeBlockEvents(ctx context.Context, eventCh <-chan ctypes.ResultEvent) bool {
+	ticker := time.NewTicker(util.HeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			util.Heartbeat(hl.name, "subscription")
+		case event, ok := <-eventCh:
+			if !ok {
+				hl.Logger.Info("New block subscription closed, resubscribing")
+				return true
+			}
+
+			if newBlock, ok := event.Data.(tmTypes.EventDataNewBlock); ok {
+				hl.processNewBlock(newBlock)
+			}
+			util.Heartbeat(hl.name, "subscription")
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// processNewBlock processes events of new block, fetching missed blocks first
+func (hl *HeimdallListener) processNewBlock(newBlock tmTypes.EventDataNewBlock) {
+	hl.processMutex.Lock()
+	defer hl.processMutex.Unlock()
+
+	hl.lastEventAt = time.Now()
+
+	height := uint64(newBlock.Block.Height)
+	metrics.SetListenerHead(hl.name, height)
+
+	fromBlock, err := hl.fetchFromBlock()
+	if err != nil {
+		return
+	}
+
+	// already processed
+	if height < fromBlock {
+		return
+	}
+
+	// events of some blocks were missed, e.g. during reconnect
+	if height > fromBlock {
+		hl.Logger.Info("Fetching events of missed blocks", "fromBlock", fromBlock, "toBlock", height-1)
+		if !hl.processBlocks(fromBlock, height-1) {
+			return
+		}
+	}
+
+	events := newBlock.ResultBeginBlock.GetEvents()
+	if err := hl.processBlockEvents(events, height); err != nil {
+		hl.Logger.Error("Error processing block events", "blockHeight", height, "error", err)
+		return
+	}
+
+	hl.indexBlock(events, newBlock.Block.Data.Txs, height)
+	hl.setLastBlock(height)
+}
+
+// StartPolling - fills gaps by polling heimdall when no new block event arrived for poll interval
 func (hl *HeimdallListener) StartPolling(ctx context.Context, pollInterval time.Duration) {
 	// How often to fire the passed in function in second
 	interval := pollInterval
@@ -62,6 +191,34 @@ func (hl *HeimdallListener) StartPolling(ctx context.Context, pollInterval time.
 	// Setup the ticket and the channel to signal
 	// the ending of the interval
 	ticker := time.NewTicker(interval)
+	util.StartHeartbeat(hl.name, "polling", util.HeartbeatTimeout(interval))
+
+	// start listening
+	for {
+		select {
+		case <-ticker.C:
+			util.Heartbeat(hl.name, "polling")
+			hl.processMutex.Lock()
+			idle := time.Since(hl.lastEventAt) >= interval
+			hl.processMutex.Unlock()
+
+			if idle {
+				hl.catchUp()
+			}
+
+		case <-ctx.Done():
+			hl.Logger.Info("Polling stopped")
+			util.StopHeartbeat(hl.name, "polling")
+			ticker.Stop()
+			return
+		}
+	}
+}
+
+// catchUp processes blocks from last processed block till latest block
+func (hl *HeimdallListener) catchUp() {
+	hl.processMutex.Lock()
+	defer hl.processMutex.Unlock()
 
 	// var eventTypes []string
 	// eventTypes = append(eventTypes, "message.action='checkpoint'")
@@ -69,77 +226,183 @@ func (hl *HeimdallListener) StartPolling(ctx context.Context, pollInterval time.
 	// eventTypes = append(eventTypes, "message.action='tick'")
 	// ADD EVENT TYPE for SLASH-LIMIT
 
-	// start listening
-	for {
-		select {
-		case <-ticker.C:
-			fromBlock, toBlock, err := hl.fetchFromAndToBlock()
-			if err != nil {
-				hl.Logger.Error("Error fetching fromBlock and toBlock...skipping events query", "error", err)
-			} else if fromBlock < toBlock {
+	fromBlock, toBlock, err := hl.fetchFromAndToBlock()
+	if err != nil {
+		hl.Logger.Error("Error fetching fromBlock and toBlock...skipping events query", "error", err)
+		return
+	}
 
-				hl.Logger.Info("Fetching new events between", "fromBlock", fromBlock, "toBlock", toBlock)
+	if fromBlock > toBlock {
+		return
+	}
 
-				// Querying and processing Begin events
-				for i := fromBlock; i <= toBlock; i++ {
-					events, err := helper.GetBeginBlockEvents(hl.httpClient, int64(i))
-					if err != nil {
-						hl.Logger.Error("Error fetching begin block events", "error", err)
-					}
-					for _, event := range events {
-						hl.ProcessBlockEvent(sdk.StringifyEvent(event), int64(i))
-					}
-				}
+	metrics.SetListenerHead(hl.name, toBlock)
 
-				// Querying and processing tx Events. Below for loop is kept for future purpose to process events from tx
-				/* 		for _, eventType := range eventTypes {
-					var query []string
-					query = append(query, eventType)
-					query = append(query, fmt.Sprintf("tx.height>=%v", fromBlock))
-					query = append(query, fmt.Sprintf("tx.height<=%v", toBlock))
-
-					limit := 50
-					for page := 1; page > 0; {
-						searchResult, err := helper.QueryTxsByEvents(hl.cliCtx, query, page, limit)
-						hl.Logger.Debug("Fetching new events using search query", "query", query, "page", page, "limit", limit)
-
-						if err != nil {
-							hl.Logger.Error("Error while searching events", "eventType", eventType, "error", err)
-							break
-						}
-
-						for _, tx := range searchResult.Txs {
-							for _, log := range tx.Logs {
-								event := helper.FilterEvents(log.Events, func(et sdk.StringEvent) bool {
-									return et.Type == checkpointTypes.EventTypeCheckpoint || et.Type == clerkTypes.EventTypeRecord
-								})
-								if event != nil {
-									hl.ProcessEvent(*event, tx)
-								}
-							}
-						}
-
-						if len(searchResult.Txs) == limit {
-							page = page + 1
-						} else {
-							page = 0
-						}
+	hl.Logger.Info("Fetching new events between", "fromBlock", fromBlock, "toBlock", toBlock)
+
+	// Querying and processing Begin events
+	hl.processBlocks(fromBlock, toBlock)
+
+	// Querying and processing tx Events. Below for loop is kept for future purpose to process events from tx
+	/* 		for _, eventType := range eventTypes {
+		var query []string
+		query = append(query, eventType)
+		query = append(query, fmt.Sprintf("tx.height>=%v", fromBlock))
+		query = append(query, fmt.Sprintf("tx.height<=%v", toBlock))
+
+		limit := 50
+		for page := 1; page > 0; {
+			searchResult, err := helper.QueryTxsByEvents(hl.cliCtx, query, page, limit)
+			hl.Logger.Debug("Fetching new events using search query", "query", query, "page", page, "limit", limit)
+
+			if err != nil {
+				hl.Logger.Error("Error while searching events", "eventType", eventType, "error", err)
+				break
+			}
+
+			for _, tx := range searchResult.Txs {
+				for _, log := range tx.Logs {
+					event := helper.FilterEvents(log.Events, func(et sdk.StringEvent) bool {
+						return et.Type == checkpointTypes.EventTypeCheckpoint || et.Type == clerkTypes.EventTypeRecord
+					})
+					if event != nil {
+						hl.ProcessEvent(*event, tx)
 					}
-				} */
-				// set last block to storage
-				if err := hl.storageClient.Put([]byte(heimdallLastBlockKey), []byte(strconv.FormatUint(toBlock, 10)), nil); err != nil {
-					hl.Logger.Error("hl.storageClient.Put", "Error", err)
 				}
 			}
 
+			if len(searchResult.Txs) == limit {
+				page = page + 1
+			} else {
+				page = 0
+			}
+		}
+	} */
+}
+
+// processBlocks fetches and processes begin block events of each block in range.
+// Cursor advances block by block and stops at the first block which failed, it returns false then.
+func (hl *HeimdallListener) processBlocks(fromBlock uint64, toBlock uint64) bool {
+	for i := fromBlock; i <= toBlock; i++ {
+		events, err := helper.GetBeginBlockEvents(hl.httpClient, int64(i))
+		if err != nil {
+			hl.Logger.Error("Error fetching begin block events", "blockHeight", i, "error", err)
+			return false
+		}
+
+		if err := hl.processBlockEvents(events, i); err != nil {
+			hl.Logger.Error("Error processing begin block events", "blockHeight", i, "error", err)
+			return false
+		}
+
+		if hl.dedupIndex != nil {
+			height := int64(i)
+			block, err := hl.httpClient.Block(&height)
+			if err != nil {
+				hl.Logger.Error("Error fetching block txs for dedup index", "blockHeight", i, "error", err)
+			} else {
+				hl.indexBlock(events, block.Block.Data.Txs, i)
+			}
+		}
+
+		hl.setLastBlock(i)
+	}
+
+	return true
+}
+
+// processBlockEvents sends tasks for all events of a block
+func (hl *HeimdallListener) processBlockEvents(events []abci.Event, blockHeight uint64) error {
+	for _, event := range events {
+		if err := hl.ProcessBlockEvent(sdk.StringifyEvent(event), int64(blockHeight)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// indexBlock stores rootchain events of block txs in dedup index as in flight, and side-tx results
+// of previous block txs found in begin block events as processed or rejected.
+// Index is a cache, errors are logged and don't stop the cursor.
+func (hl *HeimdallListener) indexBlock(events []abci.Event, txs tmTypes.Txs, blockHeight uint64) {
+	if hl.dedupIndex == nil {
+		return
+	}
+
+	for _, event := range events {
+		var txHash, result string
+		for _, attr := range event.Attributes {
+			switch string(attr.Key) {
+			case hmTypes.AttributeKeyTxHash:
+				txHash = string(attr.Value)
+			case hmTypes.AttributeKeySideTxResult:
+				result = string(attr.Value)
+			}
+		}
+
+		if txHash == "" || result == "" {
+			continue
+		}
+
+		if err := hl.dedupIndex.ResolveTx(txHash, result == abci.SideTxResultType_Yes.String()); err != nil {
+			hl.Logger.Error("Error while storing side-tx result in dedup index", "blockHeight", blockHeight, "txHash", txHash, "error", err)
+		}
+	}
+
+	decoder := helper.GetTxDecoder(hl.cliCtx.Codec)
+	for _, txBytes := range txs {
+		tx, err := decoder(txBytes)
+		if err != nil {
+			hl.Logger.Debug("Error decoding block tx for dedup index", "blockHeight", blockHeight, "error", err)
+			continue
+		}
+
+		var refs []dedup.Ref
+		for _, msg := range tx.GetMsgs() {
+			if ref, ok := dedup.MsgRef(msg); ok {
+				refs = append(refs, ref)
+			}
+		}
+
+		txHash := hmTypes.BytesToHeimdallHash(txBytes.Hash()).Hex()
+		if err := hl.dedupIndex.TrackTx(txHash, refs); err != nil {
+			hl.Logger.Error("Error while storing block tx in dedup index", "blockHeight", blockHeight, "txHash", txHash, "error", err)
+		}
+	}
+}
+
+// startDedupPruning removes old entries from dedup index periodically
+func (hl *HeimdallListener) startDedupPruning(ctx context.Context) {
+	ticker := time.NewTicker(dedupPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		pruned, err := hl.dedupIndex.Prune(dedup.DefaultRetention)
+		if err != nil {
+			hl.Logger.Error("Error while pruning dedup index", "error", err)
+		} else if pruned > 0 {
+			hl.Logger.Info("Pruned dedup index", "entries", pruned)
+		}
+
+		select {
+		case <-ticker.C:
 		case <-ctx.Done():
-			hl.Logger.Info("Polling stopped")
-			ticker.Stop()
 			return
 		}
 	}
 }
 
+// setLastBlock stores last processed block
+func (hl *HeimdallListener) setLastBlock(blockHeight uint64) {
+	// set last block to storage
+	if err := hl.storageClient.Put([]byte(heimdallLastBlockKey), []byte(strconv.FormatUint(blockHeight, 10)), nil); err != nil {
+		hl.Logger.Error("hl.storageClient.Put", "Error", err)
+	} else {
+		metrics.SetListenerLastProcessed(hl.name, blockHeight)
+	}
+}
+
 func (hl *HeimdallListener) fetchFromAndToBlock() (uint64, uint64, error) {
 	// toBlock - get latest blockheight from heimdall node
 	fromBlock := uint64(0)
@@ -153,48 +416,61 @@ func (hl *HeimdallListener) fetchFromAndToBlock() (uint64, uint64, error) {
 	toBlock = uint64(nodeStatus.SyncInfo.LatestBlockHeight)
 
 	// fromBlock - get last block from storage
+	fromBlock, err = hl.fetchFromBlock()
+	if err != nil {
+		toBlock = 0
+	}
+	return fromBlock, toBlock, err
+}
+
+// fetchFromBlock returns block after last processed block from storage
+func (hl *HeimdallListener) fetchFromBlock() (uint64, error) {
 	hasLastBlock, _ := hl.storageClient.Has([]byte(heimdallLastBlockKey), nil)
-	if hasLastBlock {
-		lastBlockBytes, err := hl.storageClient.Get([]byte(heimdallLastBlockKey), nil)
-		if err != nil {
-			hl.Logger.Info("Error while fetching last block bytes from storage", "error", err)
-			return fromBlock, toBlock, err
-		}
+	if !hasLastBlock {
+		// heimdall blocks start at 1
+		return 1, nil
+	}
 
-		if result, err := strconv.ParseUint(string(lastBlockBytes), 10, 64); err == nil {
-			hl.Logger.Debug("Got last block from bridge storage", "lastBlock", result)
-			fromBlock = uint64(result) + 1
-		} else {
-			hl.Logger.Info("Error parsing last block bytes from storage", "error", err)
-			toBlock = 0
-			return fromBlock, toBlock, err
-		}
+	lastBlockBytes, err := hl.storageClient.Get([]byte(heimdallLastBlockKey), nil)
+	if err != nil {
+		hl.Logger.Info("Error while fetching last block bytes from storage", "error", err)
+		return 0, err
 	}
-	return fromBlock, toBlock, err
+
+	result, err := strconv.ParseUint(string(lastBlockBytes), 10, 64)
+	if err != nil {
+		hl.Logger.Info("Error parsing last block bytes from storage", "error", err)
+		return 0, err
+	}
+
+	hl.Logger.Debug("Got last block from bridge storage", "lastBlock", result)
+	return result + 1, nil
 }
 
 // ProcessBlockEvent - process Blockevents (BeginBlock, EndBlock events) from heimdall.
-func (hl *HeimdallListener) ProcessBlockEvent(event sdk.StringEvent, blockHeight int64) {
+func (hl *HeimdallListener) ProcessBlockEvent(event sdk.StringEvent, blockHeight int64) error {
 	hl.Logger.Info("Received block event from Heimdall", "eventType", event.Type)
 	eventBytes, err := json.Marshal(event)
 	if err != nil {
 		hl.Logger.Error("Error while parsing block event", "error", err, "eventType", event.Type)
-		return
+		return err
 	}
 
 	switch event.Type {
 	case checkpointTypes.EventTypeCheckpoint:
-		hl.sendBlockTask("sendCheckpointToRootchain", eventBytes, blockHeight)
-	case slashingTypes.EventTypeSlashLimit:
-		hl.sendBlockTask("sendTickToHeimdall", eventBytes, blockHeight)
+		return hl.sendBlockTask("sendCheckpointToRootchain", eventBytes, blockHeight)
+	case slashingTypes.EventTypeSlashLimit, slashingTypes.EventTypeTickDue:
+		return hl.sendBlockTask("sendTickToHeimdall", eventBytes, blockHeight)
 	case slashingTypes.EventTypeTickConfirm:
-		hl.sendBlockTask("sendTickToRootchain", eventBytes, blockHeight)
+		return hl.sendBlockTask("sendTickToRootchain", eventBytes, blockHeight)
 	default:
 		hl.Logger.Debug("BlockEvent Type mismatch", "eventType", event.Type)
 	}
+
+	return nil
 }
 
-func (hl *HeimdallListener) sendBlockTask(taskName string, eventBytes []byte, blockHeight int64) {
+func (hl *HeimdallListener) sendBlockTask(taskName string, eventBytes []byte, blockHeight int64) error {
 	// create machinery task
 	signature := &tasks.Signature{
 		Name: taskName,
@@ -209,11 +485,12 @@ func (hl *HeimdallListener) sendBlockTask(taskName string, eventBytes []byte, bl
 			},
 		},
 	}
-	signature.RetryCount = 3
+	queue.ApplyRetryPolicy(signature)
 	hl.Logger.Info("Sending block level task", "taskName", taskName, "currentTime", time.Now(), "blockHeight", blockHeight)
 	// send task
 	_, err := hl.queueConnector.Server.SendTask(signature)
 	if err != nil {
 		hl.Logger.Error("Error sending block level task", "taskName", taskName, "blockHeight", blockHeight, "error", err)
 	}
+	return err
 }
@@ -0,0 +1,49 @@
+package listener
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+func TestHeimdallProcessNewBlock(t *testing.T) {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	hl := NewHeimdallListener()
+	hl.BaseListener = BaseListener{Logger: log.NewNopLogger(), name: HeimdallListenerStr, storageClient: db}
+
+	newBlock := func(height int64) tmTypes.EventDataNewBlock {
+		return tmTypes.EventDataNewBlock{
+			Block: &tmTypes.Block{Header: tmTypes.Header{Height: height}},
+			// events without bridge tasks
+			ResultBeginBlock: abci.ResponseBeginBlock{Events: []abci.Event{{Type: "transfer"}}},
+		}
+	}
+
+	// without cursor it starts from first block
+	fromBlock, err := hl.fetchFromBlock()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), fromBlock)
+
+	require.NoError(t, db.Put([]byte(heimdallLastBlockKey), []byte("9"), nil))
+
+	// next block advances cursor
+	hl.processNewBlock(newBlock(10))
+	fromBlock, err = hl.fetchFromBlock()
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), fromBlock)
+
+	// already processed blocks are ignored
+	hl.processNewBlock(newBlock(10))
+	hl.processNewBlock(newBlock(5))
+	fromBlock, err = hl.fetchFromBlock()
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), fromBlock)
+}
@@ -6,12 +6,17 @@ import (
 
 	"github.com/RichardKnop/machinery/v1/tasks"
 	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
 	"github.com/maticnetwork/heimdall/helper"
 )
 
 // MaticChainListener - Listens to and process headerblocks from maticchain
 type MaticChainListener struct {
 	BaseListener
+
+	// additional bor chain listened to, empty for the default chain
+	borChainID string
 }
 
 // NewMaticChainListener - constructor func
@@ -35,7 +40,7 @@ func (ml *MaticChainListener) Start() error {
 	go ml.StartHeaderProcess(headerCtx)
 
 	// subscribe to new head
-	subscription, err := ml.contractConnector.MaticChainClient.SubscribeNewHead(ctx, ml.HeaderChannel)
+	subscription, err := ml.chainClient.SubscribeNewHead(ctx, ml.HeaderChannel)
 	if err != nil {
 		// start go routine to poll for new header using client object
 		ml.Logger.Info("Start polling for header blocks", "pollInterval", helper.GetConfig().CheckpointerPollInterval)
@@ -61,7 +66,13 @@ func (ml *MaticChainListener) ProcessHeader(newHeader *types.Header) {
 		return
 	}
 
-	ml.sendTaskWithDelay("sendCheckpointToHeimdall", headerBytes, 0)
+	metrics.SetListenerHead(ml.name, newHeader.Number.Uint64())
+	if ml.borChainID == "" {
+		ml.sendTaskWithDelay("sendCheckpointToHeimdall", headerBytes, 0)
+	} else {
+		ml.sendTaskWithDelay("sendBorChainCheckpointToHeimdall", headerBytes, 0)
+	}
+	metrics.SetListenerLastProcessed(ml.name, newHeader.Number.Uint64())
 }
 
 func (ml *MaticChainListener) sendTaskWithDelay(taskName string, headerBytes []byte, delay time.Duration) {
@@ -75,7 +86,13 @@ func (ml *MaticChainListener) sendTaskWithDelay(taskName string, headerBytes []b
 			},
 		},
 	}
-	signature.RetryCount = 3
+	if ml.borChainID != "" {
+		signature.Args = append(signature.Args, tasks.Arg{
+			Type:  "string",
+			Value: ml.borChainID,
+		})
+	}
+	queue.ApplyRetryPolicy(signature)
 
 	// add delay for task so that multiple validators won't send same transaction at same time
 	eta := time.Now().Add(delay)
@@ -0,0 +1,225 @@
+package listener
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/syndtr/goleveldb/leveldb"
+	levelUtil "github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	rootBlockPrefix = "rootchain-block-" // storage key prefix for processed block records
+
+	// maximum number of processed rootchain blocks tracked for reorg detection.
+	// A reorg deeper than this can't be repaired by the listener.
+	maxReorgDepth = 64
+)
+
+// errDeepReorg is returned when the rootchain diverged below tracked blocks
+var errDeepReorg = errors.New("rootchain reorg deeper than tracked blocks")
+
+// processedBlock is a record of a processed rootchain block
+type processedBlock struct {
+	Hash common.Hash   `json:"hash"`
+	Logs []common.Hash `json:"logs"` // ids of logs emitted for this block
+}
+
+// checkReorg verifies that lastBlock is still canonical. If the chain diverged,
+// it re-scans the reorged range and emits only the logs which weren't emitted before.
+func (rl *RootChainListener) checkReorg(rootchainContext *RootChainListenerContext, lastBlock uint64) error {
+	changed, err := rl.rescanReorg(rootchainContext, lastBlock)
+	if err != nil {
+		return err
+	}
+
+	if len(changed) > 0 {
+		rl.broadcastEvents(changed)
+	}
+	return nil
+}
+
+// rescanReorg re-scans blocks after common ancestor if lastBlock was reorged out,
+// and returns logs of reorged range which weren't emitted before.
+func (rl *RootChainListener) rescanReorg(rootchainContext *RootChainListenerContext, lastBlock uint64) ([]types.Log, error) {
+	ancestor, reorged, err := rl.findCommonAncestor(lastBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	if !reorged {
+		return nil, nil
+	}
+
+	rl.Logger.Info("Rootchain reorg detected, re-scanning reorged blocks", "fromBlock", ancestor+1, "toBlock", lastBlock, "depth", lastBlock-ancestor)
+
+	// logs emitted before reorg
+	emitted := make(map[common.Hash]bool)
+	for number := ancestor + 1; number <= lastBlock; number++ {
+		record, err := rl.getProcessedBlock(number)
+		if err != nil {
+			return nil, err
+		}
+		if record != nil {
+			for _, id := range record.Logs {
+				emitted[id] = true
+			}
+		}
+	}
+
+	fromBlock := big.NewInt(0).SetUint64(ancestor + 1)
+	toBlock := big.NewInt(0).SetUint64(lastBlock)
+	logs, err := rl.queryEvents(rootchainContext, fromBlock, toBlock)
+	if err != nil {
+		rl.Logger.Error("Error while filtering logs for reorged blocks", "error", err)
+		return nil, err
+	}
+
+	// logs which changed with reorg
+	changed := make([]types.Log, 0, len(logs))
+	for _, vLog := range logs {
+		if !emitted[logID(vLog)] {
+			changed = append(changed, vLog)
+		}
+	}
+
+	rl.Logger.Info("Re-emitting changed logs from reorged blocks", "numberOfLogs", len(logs), "changedLogs", len(changed))
+	rl.trackProcessedBlocks(ancestor+1, lastBlock, logs)
+	return changed, nil
+}
+
+// findCommonAncestor walks back tracked blocks until the stored hash matches the canonical chain.
+func (rl *RootChainListener) findCommonAncestor(lastBlock uint64) (uint64, bool, error) {
+	for number := lastBlock; ; number-- {
+		record, err := rl.getProcessedBlock(number)
+		if err != nil {
+			return 0, false, err
+		}
+
+		if record == nil {
+			// nothing tracked yet (eg. first start), assume canonical
+			if number == lastBlock {
+				return lastBlock, false, nil
+			}
+
+			rl.Logger.Error("🚨 Deep rootchain reorg detected, stopping event processing. Manual intervention required",
+				"lastBlock", lastBlock,
+				"trackedUpto", number+1,
+				"maxReorgDepth", maxReorgDepth,
+			)
+			return 0, false, errDeepReorg
+		}
+
+		header, err := rl.getMainChainHeader(number)
+		if err != nil {
+			return 0, false, err
+		}
+
+		if header.Hash() == record.Hash {
+			return number, number != lastBlock, nil
+		}
+
+		rl.Logger.Debug("Processed rootchain block is not canonical anymore", "blockNumber", number, "storedHash", record.Hash.Hex(), "canonicalHash", header.Hash().Hex())
+		if number == 0 {
+			return 0, false, errDeepReorg
+		}
+	}
+}
+
+// trackProcessedBlocks stores hashes and emitted log ids of the most recent processed blocks
+func (rl *RootChainListener) trackProcessedBlocks(fromBlock uint64, toBlock uint64, logs []types.Log) {
+	if toBlock >= maxReorgDepth && fromBlock <= toBlock-maxReorgDepth {
+		fromBlock = toBlock - maxReorgDepth + 1
+	}
+
+	records := make(map[uint64]*processedBlock)
+	for number := fromBlock; number <= toBlock; number++ {
+		header, err := rl.getMainChainHeader(number)
+		if err != nil {
+			// without hash, block can't be checked for reorg later
+			rl.Logger.Error("Error while fetching rootchain header for reorg tracking", "blockNumber", number, "error", err)
+			return
+		}
+		records[number] = &processedBlock{Hash: header.Hash(), Logs: []common.Hash{}}
+	}
+
+	for _, vLog := range logs {
+		if record, ok := records[vLog.BlockNumber]; ok {
+			record.Logs = append(record.Logs, logID(vLog))
+		}
+	}
+
+	batch := new(leveldb.Batch)
+	for number, record := range records {
+		value, err := json.Marshal(record)
+		if err != nil {
+			rl.Logger.Error("Error while marshalling processed block", "blockNumber", number, "error", err)
+			return
+		}
+		batch.Put(processedBlockKey(number), value)
+	}
+
+	// prune blocks which are out of reorg window
+	if toBlock >= maxReorgDepth {
+		iter := rl.storageClient.NewIterator(levelUtil.BytesPrefix([]byte(rootBlockPrefix)), nil)
+		for iter.Next() {
+			number, err := strconv.ParseUint(string(iter.Key()[len(rootBlockPrefix):]), 10, 64)
+			if err != nil || number <= toBlock-maxReorgDepth {
+				batch.Delete(append([]byte{}, iter.Key()...))
+			}
+		}
+		iter.Release()
+	}
+
+	if err := rl.storageClient.Write(batch, nil); err != nil {
+		rl.Logger.Error("rl.storageClient.Write", "Error", err)
+	}
+}
+
+func (rl *RootChainListener) getProcessedBlock(number uint64) (*processedBlock, error) {
+	value, err := rl.storageClient.Get(processedBlockKey(number), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var record processedBlock
+	if err := json.Unmarshal(value, &record); err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+func (rl *RootChainListener) getMainChainHeader(number uint64) (*types.Header, error) {
+	return rl.rootChain().GetMainChainBlock(big.NewInt(0).SetUint64(number))
+}
+
+//
+// utils
+//
+
+// processedBlockKey returns storage key of processed block record
+func processedBlockKey(number uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", rootBlockPrefix, number))
+}
+
+// logID identifies a log by its transaction, position and content (independent of block hash)
+func logID(vLog types.Log) common.Hash {
+	data := make([]byte, 0, common.HashLength+8+len(vLog.Data)+len(vLog.Topics)*common.HashLength)
+	data = append(data, vLog.TxHash.Bytes()...)
+	data = append(data, []byte(strconv.FormatUint(uint64(vLog.Index), 10))...)
+	data = append(data, vLog.Address.Bytes()...)
+	for _, topic := range vLog.Topics {
+		data = append(data, topic.Bytes()...)
+	}
+	data = append(data, vLog.Data...)
+	return crypto.Keccak256Hash(data)
+}
@@ -0,0 +1,219 @@
+package listener
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/maticnetwork/bor"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/tendermint/tendermint/libs/log"
+
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+)
+
+// mockRootChain serves headers of a fork per block and logs of canonical chain
+type mockRootChain struct {
+	forks   map[uint64]string // fork of each block, "" for canonical
+	logs    []types.Log
+	queries []ethereum.FilterQuery
+
+	// error returned for log queries, if set
+	filterErr func(query ethereum.FilterQuery) error
+}
+
+func newMockRootChain() *mockRootChain {
+	return &mockRootChain{forks: make(map[uint64]string)}
+}
+
+func (m *mockRootChain) GetMainChainBlock(blockNum *big.Int) (*types.Header, error) {
+	return &types.Header{Number: new(big.Int).Set(blockNum), Extra: []byte(m.forks[blockNum.Uint64()])}, nil
+}
+
+func (m *mockRootChain) FilterMainChainLogs(query ethereum.FilterQuery) ([]types.Log, error) {
+	m.queries = append(m.queries, query)
+	if m.filterErr != nil {
+		if err := m.filterErr(query); err != nil {
+			return nil, err
+		}
+	}
+
+	var logs []types.Log
+	for _, vLog := range m.logs {
+		if vLog.BlockNumber >= query.FromBlock.Uint64() && vLog.BlockNumber <= query.ToBlock.Uint64() {
+			logs = append(logs, vLog)
+		}
+	}
+	return logs, nil
+}
+
+// reorg moves blocks from fromBlock to toBlock to fork
+func (m *mockRootChain) reorg(fromBlock uint64, toBlock uint64, fork string) {
+	for number := fromBlock; number <= toBlock; number++ {
+		m.forks[number] = fork
+	}
+}
+
+func newTestRootChainListener(db *leveldb.DB, source rootChainSource) *RootChainListener {
+	return &RootChainListener{
+		BaseListener: BaseListener{Logger: log.NewNopLogger(), name: RootChainListenerStr, storageClient: db},
+		source:       source,
+	}
+}
+
+func newTestRootChainContext() *RootChainListenerContext {
+	params := chainmanagerTypes.DefaultParams()
+	return &RootChainListenerContext{ChainmanagerParams: &params}
+}
+
+func testLog(blockNumber uint64, tx string) types.Log {
+	return types.Log{
+		BlockNumber: blockNumber,
+		TxHash:      common.HexToHash(tx),
+		Topics:      []common.Hash{common.HexToHash("0x1")},
+		Data:        []byte(tx),
+	}
+}
+
+func TestCheckReorg(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		tracked uint64 // blocks 1..tracked processed before reorg
+		logs    []types.Log
+		restart bool // reorg is checked by new listener on same db
+
+		reorgFrom uint64 // reorged blocks reorgFrom..tracked, none if 0
+		newLogs   []types.Log
+
+		wantErr     error
+		wantChanged []types.Log
+		wantQueries int
+	}{
+		{
+			name:    "no reorg",
+			tracked: 10,
+			logs:    []types.Log{testLog(9, "0xa")},
+		},
+		{
+			name:        "shallow reorg",
+			tracked:     10,
+			logs:        []types.Log{testLog(9, "0xa"), testLog(10, "0xb")},
+			reorgFrom:   9,
+			newLogs:     []types.Log{testLog(9, "0xa"), testLog(10, "0xc")},
+			wantChanged: []types.Log{testLog(10, "0xc")},
+			wantQueries: 1,
+		},
+		{
+			name:      "reorg deeper than tracked window",
+			tracked:   maxReorgDepth + 10,
+			reorgFrom: 1,
+			wantErr:   errDeepReorg,
+		},
+		{
+			name:        "restart with persisted window",
+			tracked:     10,
+			logs:        []types.Log{testLog(10, "0xb")},
+			restart:     true,
+			reorgFrom:   10,
+			newLogs:     []types.Log{testLog(10, "0xb"), testLog(10, "0xc")},
+			wantChanged: []types.Log{testLog(10, "0xc")},
+			wantQueries: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, err := leveldb.Open(storage.NewMemStorage(), nil)
+			require.NoError(t, err)
+			defer db.Close()
+
+			chain := newMockRootChain()
+			rl := newTestRootChainListener(db, chain)
+			rl.trackProcessedBlocks(1, tc.tracked, tc.logs)
+
+			if tc.restart {
+				rl = newTestRootChainListener(db, chain)
+			}
+
+			if tc.reorgFrom > 0 {
+				chain.reorg(tc.reorgFrom, tc.tracked, "fork")
+				chain.logs = tc.newLogs
+			}
+
+			changed, err := rl.rescanReorg(newTestRootChainContext(), tc.tracked)
+			if tc.wantErr != nil {
+				require.Equal(t, tc.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, len(tc.wantChanged), len(changed))
+			for i := range tc.wantChanged {
+				require.Equal(t, logID(tc.wantChanged[i]), logID(changed[i]))
+			}
+			require.Len(t, chain.queries, tc.wantQueries)
+
+			// reorged blocks are tracked with new hashes, so next check finds no reorg
+			changed, err = rl.rescanReorg(newTestRootChainContext(), tc.tracked)
+			require.NoError(t, err)
+			require.Empty(t, changed)
+		})
+	}
+}
+
+func TestFindCommonAncestor(t *testing.T) {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	chain := newMockRootChain()
+	rl := newTestRootChainListener(db, chain)
+
+	// nothing tracked on first start
+	ancestor, reorged, err := rl.findCommonAncestor(10)
+	require.NoError(t, err)
+	require.False(t, reorged)
+	require.Equal(t, uint64(10), ancestor)
+
+	rl.trackProcessedBlocks(1, 10, nil)
+	chain.reorg(7, 10, "fork")
+
+	ancestor, reorged, err = rl.findCommonAncestor(10)
+	require.NoError(t, err)
+	require.True(t, reorged)
+	require.Equal(t, uint64(6), ancestor)
+
+	// whole tracked range reorged
+	chain.reorg(0, 10, "fork")
+	_, _, err = rl.findCommonAncestor(10)
+	require.Equal(t, errDeepReorg, err)
+}
+
+func TestTrackProcessedBlocksWindow(t *testing.T) {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	rl := newTestRootChainListener(db, newMockRootChain())
+	toBlock := uint64(maxReorgDepth + 20)
+	rl.trackProcessedBlocks(1, toBlock/2, nil)
+	rl.trackProcessedBlocks(toBlock/2+1, toBlock, []types.Log{testLog(toBlock, "0xa")})
+
+	for number := uint64(1); number <= toBlock; number++ {
+		record, err := rl.getProcessedBlock(number)
+		require.NoError(t, err)
+		if number <= toBlock-maxReorgDepth {
+			require.Nil(t, record, fmt.Sprintf("block %v should be pruned", number))
+		} else {
+			require.NotNil(t, record, fmt.Sprintf("block %v should be tracked", number))
+		}
+	}
+
+	record, err := rl.getProcessedBlock(toBlock)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{logID(testLog(toBlock, "0xa"))}, record.Logs)
+}
@@ -0,0 +1,82 @@
+package listener
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	httpClient "github.com/tendermint/tendermint/rpc/client"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// ReplayRange enqueues tasks for events of listener in [fromBlock, toBlock] once, without moving its cursor
+func ReplayRange(cdc *codec.Codec, queueConnector *queue.QueueConnector, httpClient *httpClient.HTTP, listener string, fromBlock uint64, toBlock uint64) error {
+	if fromBlock > toBlock {
+		return fmt.Errorf("invalid range, from block %v is after to block %v", fromBlock, toBlock)
+	}
+
+	switch listener {
+	case RootChainListenerStr:
+		rl := NewRootChainListener()
+		rl.BaseListener = *NewBaseListener(cdc, queueConnector, httpClient, helper.GetMainClient(), RootChainListenerStr, rl)
+		return rl.replayRange(fromBlock, toBlock)
+	case HeimdallListenerStr:
+		hl := NewHeimdallListener()
+		hl.BaseListener = *NewBaseListener(cdc, queueConnector, httpClient, nil, HeimdallListenerStr, hl)
+		return hl.replayRange(fromBlock, toBlock)
+	default:
+		return fmt.Errorf("%v listener can't replay block ranges, supported listeners: %v, %v", listener, RootChainListenerStr, HeimdallListenerStr)
+	}
+}
+
+// replayRange queries rootchain logs in chunks and sends their tasks, reorg tracking is left untouched
+func (rl *RootChainListener) replayRange(fromBlock uint64, toBlock uint64) error {
+	rootchainContext, err := rl.getRootChainContext()
+	if err != nil {
+		return err
+	}
+
+	chunkSize := helper.GetConfig().MainchainLogChunkSize
+	if chunkSize == 0 {
+		chunkSize = helper.DefaultMainchainLogChunkSize
+	}
+
+	for start := fromBlock; start <= toBlock; {
+		end := start + chunkSize - 1
+		if end > toBlock || end < start {
+			end = toBlock
+		}
+
+		rl.Logger.Info("Replaying rootchain event logs", "fromBlock", start, "toBlock", end)
+		logs, err := rl.queryEvents(rootchainContext, big.NewInt(0).SetUint64(start), big.NewInt(0).SetUint64(end))
+		if err != nil {
+			return fmt.Errorf("querying logs between %v and %v: %v", start, end, err)
+		}
+		rl.broadcastEvents(logs)
+
+		if end == toBlock {
+			break
+		}
+		start = end + 1
+	}
+
+	return nil
+}
+
+// replayRange fetches begin block events of each heimdall block in range and sends their tasks
+func (hl *HeimdallListener) replayRange(fromBlock uint64, toBlock uint64) error {
+	for i := fromBlock; i <= toBlock; i++ {
+		events, err := helper.GetBeginBlockEvents(hl.httpClient, int64(i))
+		if err != nil {
+			return fmt.Errorf("fetching begin block events of %v: %v", i, err)
+		}
+
+		if err := hl.processBlockEvents(events, i); err != nil {
+			return fmt.Errorf("processing begin block events of %v: %v", i, err)
+		}
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"math/big"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/RichardKnop/machinery/v1/tasks"
@@ -13,6 +14,8 @@ import (
 	"github.com/maticnetwork/bor/accounts/abi"
 	ethCommon "github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
@@ -22,6 +25,8 @@ import (
 // RootChainListenerContext root chain listener context
 type RootChainListenerContext struct {
 	ChainmanagerParams *chainmanagerTypes.Params
+	// heimdall height the params were fetched at, decides accepted contract addresses
+	Height int64
 }
 
 // RootChainListener - Listens to and process events from rootchain
@@ -31,6 +36,18 @@ type RootChainListener struct {
 	abis []*abi.ABI
 
 	stakingInfoAbi *abi.ABI
+
+	// current block range of log queries
+	logChunkSize uint64
+
+	// headers and logs of rootchain, contract connector if not set
+	source rootChainSource
+}
+
+// rootChainSource reads rootchain headers and logs
+type rootChainSource interface {
+	GetMainChainBlock(blockNum *big.Int) (*types.Header, error)
+	FilterMainChainLogs(query ethereum.FilterQuery) ([]types.Log, error)
 }
 
 const (
@@ -90,6 +107,7 @@ func (rl *RootChainListener) Start() error {
 // ProcessHeader - process headerblock from rootchain
 func (rl *RootChainListener) ProcessHeader(newHeader *types.Header) {
 	rl.Logger.Debug("New block detected", "blockNumber", newHeader.Number)
+	metrics.SetListenerHead(rl.name, newHeader.Number.Uint64())
 
 	// fetch context
 	rootchainContext, err := rl.getRootChainContext()
@@ -124,6 +142,12 @@ func (rl *RootChainListener) ProcessHeader(newHeader *types.Header) {
 			if result >= newHeader.Number.Uint64() {
 				return
 			}
+
+			// make sure already processed blocks were not reorged out
+			if err := rl.checkReorg(rootchainContext, result); err != nil {
+				rl.Logger.Error("Error while checking rootchain reorg", "lastBlock", result, "error", err)
+				return
+			}
 			fromBlock = big.NewInt(0).SetUint64(result + 1)
 		}
 	}
@@ -132,48 +156,113 @@ func (rl *RootChainListener) ProcessHeader(newHeader *types.Header) {
 	toBlock := latestNumber
 
 	if toBlock.Cmp(fromBlock) == -1 {
-		fromBlock = toBlock
+		rl.Logger.Debug("No new confirmed blocks to process", "fromBlock", fromBlock, "toBlock", toBlock)
+		return
 	}
 
-	// set last block to storage
-	if err := rl.storageClient.Put([]byte(lastRootBlockKey), []byte(toBlock.String()), nil); err != nil {
-		rl.Logger.Error("rl.storageClient.Put", "Error", err)
+	// query events in chunks, saving progress after each chunk
+	rl.queryAndBroadcastEventsInChunks(rootchainContext, fromBlock.Uint64(), toBlock.Uint64())
+}
+
+// queryAndBroadcastEventsInChunks splits [fromBlock, toBlock] into chunks of at most logChunkSize blocks.
+// Chunk size is halved when provider rejects the range and grows back after successful queries.
+func (rl *RootChainListener) queryAndBroadcastEventsInChunks(rootchainContext *RootChainListenerContext, fromBlock uint64, toBlock uint64) {
+	maxChunkSize := helper.GetConfig().MainchainLogChunkSize
+	if maxChunkSize == 0 {
+		maxChunkSize = helper.DefaultMainchainLogChunkSize
+	}
+	if rl.logChunkSize == 0 || rl.logChunkSize > maxChunkSize {
+		rl.logChunkSize = maxChunkSize
 	}
 
-	// query events
-	rl.queryAndBroadcastEvents(rootchainContext, fromBlock, toBlock)
-}
+	for start := fromBlock; start <= toBlock; {
+		end := start + rl.logChunkSize - 1
+		if end > toBlock || end < start {
+			end = toBlock
+		}
 
-func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChainListenerContext, fromBlock *big.Int, toBlock *big.Int) {
-	rl.Logger.Info("Query rootchain event logs", "fromBlock", fromBlock, "toBlock", toBlock)
+		err := rl.queryAndBroadcastEvents(rootchainContext, big.NewInt(0).SetUint64(start), big.NewInt(0).SetUint64(end))
+		if err != nil {
+			if isRangeTooLargeError(err) && rl.logChunkSize > 1 {
+				rl.logChunkSize = rl.logChunkSize / 2
+				rl.Logger.Info("Block range rejected by rootchain provider, shrinking chunk size", "fromBlock", start, "toBlock", end, "chunkSize", rl.logChunkSize)
+				continue
+			}
+			// retry from here on next header
+			return
+		}
 
-	// current public key
-	pubkey := helper.GetPubKey()
-	pubkeyBytes := pubkey[1:]
+		// set last block to storage
+		if err := rl.storageClient.Put([]byte(lastRootBlockKey), []byte(strconv.FormatUint(end, 10)), nil); err != nil {
+			rl.Logger.Error("rl.storageClient.Put", "Error", err)
+			return
+		}
+		metrics.SetListenerLastProcessed(rl.name, end)
 
-	// get chain params
-	chainParams := rootchainContext.ChainmanagerParams.ChainParams
+		// grow chunk size back after success
+		if rl.logChunkSize < maxChunkSize {
+			rl.logChunkSize = rl.logChunkSize * 2
+			if rl.logChunkSize > maxChunkSize {
+				rl.logChunkSize = maxChunkSize
+			}
+		}
 
-	// draft a query
-	query := ethereum.FilterQuery{FromBlock: fromBlock, ToBlock: toBlock, Addresses: []ethCommon.Address{
-		chainParams.RootChainAddress.EthAddress(),
-		chainParams.StakingInfoAddress.EthAddress(),
-		chainParams.StateSenderAddress.EthAddress(),
-	}}
-	// get logs from rootchain by filter
+		start = end + 1
+	}
+}
+
+func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChainListenerContext, fromBlock *big.Int, toBlock *big.Int) error {
+	rl.Logger.Info("Query rootchain event logs", "fromBlock", fromBlock, "toBlock", toBlock)
 
-	ethClientTimeout := rl.contractConnector.MainChainTimeout
-	ctx, cancel := context.WithTimeout(context.Background(), ethClientTimeout)
-	defer cancel()
-	logs, err := rl.contractConnector.MainChainClient.FilterLogs(ctx, query)
+	logs, err := rl.queryEvents(rootchainContext, fromBlock, toBlock)
 	if err != nil {
 		rl.Logger.Error("Error while filtering logs", "error", err)
-		return
+		return err
 	} else if len(logs) > 0 {
 		rl.Logger.Debug("New logs found", "numberOfLogs", len(logs))
 	}
 
 	// process filtered log
+	rl.broadcastEvents(logs)
+
+	// track processed blocks to detect reorgs later
+	rl.trackProcessedBlocks(fromBlock.Uint64(), toBlock.Uint64(), logs)
+	return nil
+}
+
+// queryEvents fetches logs of tracked rootchain contracts between fromBlock and toBlock
+func (rl *RootChainListener) queryEvents(rootchainContext *RootChainListenerContext, fromBlock *big.Int, toBlock *big.Int) ([]types.Log, error) {
+	// get chain params
+	chainParams := rootchainContext.ChainmanagerParams.ChainParams
+
+	// track every accepted address, so events from a rotated contract are seen until it is deprecated
+	height := uint64(rootchainContext.Height)
+	var addresses []ethCommon.Address
+	for _, contract := range []string{
+		chainmanagerTypes.ContractRootChain,
+		chainmanagerTypes.ContractStakingInfo,
+		chainmanagerTypes.ContractStateSender,
+	} {
+		addresses = append(addresses, chainParams.AcceptedAddresses(contract, height)...)
+	}
+
+	// checkpoints of additional bor chains are submitted to their own root chain contract
+	for _, bc := range chainParams.BorChains {
+		addresses = append(addresses, bc.RootChainAddress.EthAddress())
+	}
+
+	// draft a query
+	query := ethereum.FilterQuery{FromBlock: fromBlock, ToBlock: toBlock, Addresses: addresses}
+	// get logs from rootchain by filter
+	return rl.rootChain().FilterMainChainLogs(query)
+}
+
+// broadcastEvents sends tasks for given logs
+func (rl *RootChainListener) broadcastEvents(logs []types.Log) {
+	// current public key
+	pubkey := helper.GetPubKey()
+	pubkeyBytes := pubkey[1:]
+
 	for _, vLog := range logs {
 		topic := vLog.Topics[0].Bytes()
 		for _, abiObject := range rl.abis {
@@ -212,6 +301,32 @@ func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChain
 						rl.sendTaskWithDelay("sendStakeUpdateToHeimdall", selectedEvent.Name, logBytes, delay)
 					}
 
+				case "ShareMinted":
+					if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
+						rl.sendTaskWithDelay("sendShareMintedToHeimdall", selectedEvent.Name, logBytes, delay)
+					}
+
+				case "ShareBurned":
+					if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
+						rl.sendTaskWithDelay("sendShareBurnedToHeimdall", selectedEvent.Name, logBytes, delay)
+					}
+
+				case "DelClaimRewards":
+					if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
+						rl.sendTaskWithDelay("sendDelegatorClaimedRewardsToHeimdall", selectedEvent.Name, logBytes, delay)
+					}
+
+				case "UpdateCommissionRate":
+					event := new(stakinginfo.StakinginfoUpdateCommissionRate)
+					if err := helper.UnpackLog(rl.stakingInfoAbi, event, selectedEvent.Name, &vLog); err != nil {
+						rl.Logger.Error("Error while parsing event", "name", selectedEvent.Name, "error", err)
+					}
+					if util.IsEventSender(rl.cliCtx, event.ValidatorId.Uint64()) {
+						rl.sendTaskWithDelay("sendUpdateCommissionRateToHeimdall", selectedEvent.Name, logBytes, 0)
+					} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
+						rl.sendTaskWithDelay("sendUpdateCommissionRateToHeimdall", selectedEvent.Name, logBytes, delay)
+					}
+
 				case "SignerChange":
 					event := new(stakinginfo.StakinginfoSignerChange)
 					if err := helper.UnpackLog(rl.stakingInfoAbi, event, selectedEvent.Name, &vLog); err != nil {
@@ -285,7 +400,7 @@ func (rl *RootChainListener) sendTaskWithDelay(taskName string, eventName string
 			},
 		},
 	}
-	signature.RetryCount = 3
+	queue.ApplyRetryPolicy(signature)
 
 	// add delay for task so that multiple validators won't send same transaction at same time
 	eta := time.Now().Add(delay)
@@ -301,6 +416,38 @@ func (rl *RootChainListener) sendTaskWithDelay(taskName string, eventName string
 // utils
 //
 
+// rangeTooLargeErrors are messages of rootchain providers rejecting a log query
+// because of its block range or result size
+var rangeTooLargeErrors = []string{
+	"query returned more than",                     // infura (-32005)
+	"log response size exceeded",                   // alchemy
+	"block range is too large",                     // alchemy, erigon
+	"block range is too wide",                      // ankr
+	"exceed maximum block range",                   // nodereal
+	"block range limit exceeded",                   // chainstack
+	"eth_getlogs is limited to",                    // quicknode
+	"eth_getlogs and eth_newfilter are limited to", // quicknode
+}
+
+// isRangeTooLargeError checks if provider rejected log query because of range or result size
+func isRangeTooLargeError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	for _, pattern := range rangeTooLargeErrors {
+		if strings.Contains(msg, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// rootChain returns source of rootchain headers and logs
+func (rl *RootChainListener) rootChain() rootChainSource {
+	if rl.source != nil {
+		return rl.source
+	}
+	return &rl.contractConnector
+}
+
 func (rl *RootChainListener) getRootChainContext() (*RootChainListenerContext, error) {
 	chainmanagerParams, err := util.GetChainmanagerParams(rl.cliCtx)
 	if err != nil {
@@ -310,5 +457,6 @@ func (rl *RootChainListener) getRootChainContext() (*RootChainListenerContext, e
 
 	return &RootChainListenerContext{
 		ChainmanagerParams: chainmanagerParams,
+		Height:             util.GetBlockHeight(rl.cliCtx),
 	}, nil
 }
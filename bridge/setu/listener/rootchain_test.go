@@ -0,0 +1,137 @@
+package listener
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	ethereum "github.com/maticnetwork/bor"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// blockRange is a queried [from, to] range
+type blockRange struct {
+	from uint64
+	to   uint64
+}
+
+func queriedRanges(queries []ethereum.FilterQuery) (ranges []blockRange) {
+	for _, query := range queries {
+		ranges = append(ranges, blockRange{query.FromBlock.Uint64(), query.ToBlock.Uint64()})
+	}
+	return ranges
+}
+
+func setTestLogChunkSize(t *testing.T, chunkSize uint64) {
+	conf := helper.GetConfig()
+	t.Cleanup(func() { helper.SetTestConfig(conf) })
+
+	testConf := conf
+	testConf.MainchainLogChunkSize = chunkSize
+	helper.SetTestConfig(testConf)
+}
+
+func lastRootBlock(t *testing.T, db *leveldb.DB) uint64 {
+	value, err := db.Get([]byte(lastRootBlockKey), nil)
+	require.NoError(t, err)
+	lastBlock, err := strconv.ParseUint(string(value), 10, 64)
+	require.NoError(t, err)
+	return lastBlock
+}
+
+func TestQueryEventsInChunks(t *testing.T) {
+	setTestLogChunkSize(t, 10)
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	chain := newMockRootChain()
+	rl := newTestRootChainListener(db, chain)
+	rl.queryAndBroadcastEventsInChunks(newTestRootChainContext(), 1, 25)
+
+	require.Equal(t, []blockRange{{1, 10}, {11, 20}, {21, 25}}, queriedRanges(chain.queries))
+	require.Equal(t, uint64(25), lastRootBlock(t, db))
+}
+
+func TestQueryEventsShrinkAndRegrow(t *testing.T) {
+	setTestLogChunkSize(t, 8)
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	// provider accepts at most 4 blocks per query
+	chain := newMockRootChain()
+	chain.filterErr = func(query ethereum.FilterQuery) error {
+		if query.ToBlock.Uint64()-query.FromBlock.Uint64()+1 > 4 {
+			return errors.New("query returned more than 10000 results")
+		}
+		return nil
+	}
+
+	rl := newTestRootChainListener(db, chain)
+	rl.queryAndBroadcastEventsInChunks(newTestRootChainContext(), 1, 20)
+
+	// rejected chunk is halved, and chunk size grows back after every success
+	require.Equal(t, []blockRange{
+		{1, 8}, {1, 4},
+		{5, 12}, {5, 8},
+		{9, 16}, {9, 12},
+		{13, 20}, {13, 16},
+		{17, 20},
+	}, queriedRanges(chain.queries))
+	require.Equal(t, uint64(20), lastRootBlock(t, db))
+	require.Equal(t, uint64(8), rl.logChunkSize)
+}
+
+func TestQueryEventsOtherError(t *testing.T) {
+	setTestLogChunkSize(t, 10)
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	// provider fails after first chunk
+	chain := newMockRootChain()
+	chain.filterErr = func(query ethereum.FilterQuery) error {
+		if query.FromBlock.Uint64() > 10 {
+			return errors.New("limit exceeded: request rate limited")
+		}
+		return nil
+	}
+
+	rl := newTestRootChainListener(db, chain)
+	rl.queryAndBroadcastEventsInChunks(newTestRootChainContext(), 1, 25)
+
+	// progress of successful chunk is kept, rest is retried on next header with same chunk size
+	require.Equal(t, []blockRange{{1, 10}, {11, 20}}, queriedRanges(chain.queries))
+	require.Equal(t, uint64(10), lastRootBlock(t, db))
+	require.Equal(t, uint64(10), rl.logChunkSize)
+}
+
+func TestIsRangeTooLargeError(t *testing.T) {
+	testCases := []struct {
+		err  string
+		want bool
+	}{
+		{"query returned more than 10000 results", true},
+		{"Log response size exceeded. You can make eth_getLogs requests with up to a 2K block range", true},
+		{"block range is too wide", true},
+		{"exceed maximum block range: 5000", true},
+		{"eth_getLogs is limited to a 10,000 range", true},
+		{"limit exceeded: request rate limited", false},
+		{"daily request count exceeded, request rate limited", false},
+		{"invalid block range params", false},
+		{"context deadline exceeded", false},
+		{"connection refused", false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.want, isRangeTooLargeError(errors.New(tc.err)), tc.err)
+	}
+}
@@ -1,7 +1,10 @@
 package listener
 
 import (
+	"sync"
+
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/maticnetwork/heimdall/bridge/setu/dedup"
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
@@ -24,6 +27,10 @@ type ListenerService struct {
 	// Base service
 	common.BaseService
 	listeners []Listener
+
+	// state of each listener
+	statuses    map[string]*util.ServiceStatus
+	statusMutex sync.RWMutex
 }
 
 // NewListenerService returns new service object for listneing to events
@@ -32,7 +39,9 @@ func NewListenerService(cdc *codec.Codec, queueConnector *queue.QueueConnector,
 	var logger = util.Logger().With("service", ListenerServiceStr)
 
 	// creating listener object
-	listenerService := &ListenerService{}
+	listenerService := &ListenerService{
+		statuses: make(map[string]*util.ServiceStatus),
+	}
 
 	listenerService.BaseService = *common.NewBaseService(logger, ListenerServiceStr, listenerService)
 
@@ -44,8 +53,16 @@ func NewListenerService(cdc *codec.Codec, queueConnector *queue.QueueConnector,
 	maticchainListener.BaseListener = *NewBaseListener(cdc, queueConnector, httpClient, helper.GetMaticClient(), MaticChainListenerStr, maticchainListener)
 	listenerService.listeners = append(listenerService.listeners, maticchainListener)
 
+	// additional bor chains with configured rpc endpoints
+	for _, borChainID := range helper.GetBorChainIDs() {
+		borChainListener := &MaticChainListener{borChainID: borChainID}
+		borChainListener.BaseListener = *NewBaseListener(cdc, queueConnector, httpClient, helper.GetBorChainPool(borChainID).Primary().Client, MaticChainListenerStr+"-"+borChainID, borChainListener)
+		listenerService.listeners = append(listenerService.listeners, borChainListener)
+	}
+
 	heimdallListener := &HeimdallListener{}
 	heimdallListener.BaseListener = *NewBaseListener(cdc, queueConnector, httpClient, nil, HeimdallListenerStr, heimdallListener)
+	heimdallListener.dedupIndex = dedup.NewIndex(heimdallListener.storageClient, dedup.InFlightTTL())
 	listenerService.listeners = append(listenerService.listeners, heimdallListener)
 
 	return listenerService
@@ -59,9 +76,11 @@ func (listenerService *ListenerService) OnStart() error {
 
 	// start chain listeners
 	for _, listener := range listenerService.listeners {
-		if err := listener.Start(); err != nil {
+		err := listener.Start()
+		if err != nil {
 			listenerService.Logger.Error("OnStart | Start", "Error", err)
 		}
+		listenerService.setStatus(listener.String(), err == nil, err)
 	}
 
 	listenerService.Logger.Info("all listeners Started")
@@ -75,8 +94,34 @@ func (listenerService *ListenerService) OnStop() {
 	// start chain listeners
 	for _, listener := range listenerService.listeners {
 		listener.Stop()
+		listenerService.setStatus(listener.String(), false, nil)
 	}
 
 	listenerService.Logger.Info("all listeners stopped")
 
 }
+
+// ServiceStatuses returns state of started listeners
+func (listenerService *ListenerService) ServiceStatuses() []util.ServiceStatus {
+	listenerService.statusMutex.RLock()
+	defer listenerService.statusMutex.RUnlock()
+
+	statuses := make([]util.ServiceStatus, 0, len(listenerService.statuses))
+	for _, listener := range listenerService.listeners {
+		if status, ok := listenerService.statuses[listener.String()]; ok {
+			statuses = append(statuses, status.WithHeartbeat())
+		}
+	}
+	return statuses
+}
+
+func (listenerService *ListenerService) setStatus(name string, running bool, err error) {
+	listenerService.statusMutex.Lock()
+	defer listenerService.statusMutex.Unlock()
+
+	status := &util.ServiceStatus{Name: name, Service: ListenerServiceStr, Running: running}
+	if err != nil {
+		status.Error = err.Error()
+	}
+	listenerService.statuses[name] = status
+}
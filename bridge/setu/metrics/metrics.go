@@ -0,0 +1,265 @@
+package metrics
+
+import (
+	"net/http"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	// Namespace of all bridge metrics
+	Namespace = "heimdall_bridge"
+
+	// rootchain transaction types for gas metrics
+	CheckpointTx = "checkpoint"
+	TickTx       = "tick"
+)
+
+var (
+	// ListenerHeadBlock is latest block seen by listener
+	ListenerHeadBlock = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "listener",
+		Name:      "head_block",
+		Help:      "Latest block seen by listener.",
+	}, []string{"listener"})
+
+	// ListenerLastProcessedBlock is last block processed by listener
+	ListenerLastProcessedBlock = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "listener",
+		Name:      "last_processed_block",
+		Help:      "Last block processed by listener.",
+	}, []string{"listener"})
+
+	// ListenerLag is number of blocks listener is behind the head
+	ListenerLag = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "listener",
+		Name:      "lag_blocks",
+		Help:      "Number of blocks listener is behind the head.",
+	}, []string{"listener"})
+
+	// TasksEnqueued counts tasks sent to queue (excluding retries)
+	TasksEnqueued = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "task",
+		Name:      "enqueued_total",
+		Help:      "Number of tasks sent to queue.",
+	}, []string{"task"})
+
+	// TasksRetried counts tasks sent back to queue for retry
+	TasksRetried = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "task",
+		Name:      "retried_total",
+		Help:      "Number of task retries sent to queue.",
+	}, []string{"task"})
+
+	// TasksSucceeded counts successful task executions
+	TasksSucceeded = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "task",
+		Name:      "succeeded_total",
+		Help:      "Number of successful task executions.",
+	}, []string{"task"})
+
+	// TasksFailed counts failed task executions
+	TasksFailed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "task",
+		Name:      "failed_total",
+		Help:      "Number of failed task executions.",
+	}, []string{"task"})
+
+	// TasksDeadLettered counts tasks moved to dead-letter store after all retries
+	TasksDeadLettered = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "task",
+		Name:      "dead_lettered_total",
+		Help:      "Number of tasks moved to dead-letter store after all retries failed.",
+	}, []string{"task"})
+
+	// TaskDuration is execution latency of tasks
+	TaskDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "task",
+		Name:      "duration_seconds",
+		Help:      "Execution time of tasks.",
+		Buckets:   []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"task"})
+
+	// BroadcasterSequenceMismatches counts heimdall broadcasts after which account sequence had to be resynced
+	BroadcasterSequenceMismatches = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "broadcaster",
+		Name:      "sequence_mismatches_total",
+		Help:      "Number of times local account sequence differed from heimdall and was resynced.",
+	})
+
+	// BroadcasterInFlightTxs is number of heimdall txs sent but not confirmed in a block yet
+	BroadcasterInFlightTxs = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "broadcaster",
+		Name:      "in_flight_txs",
+		Help:      "Number of heimdall txs sent but not confirmed in a block yet.",
+	})
+
+	// BroadcasterResignedTxs counts heimdall txs re-signed and re-sent after they failed to get included
+	BroadcasterResignedTxs = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "broadcaster",
+		Name:      "resigned_txs_total",
+		Help:      "Number of heimdall txs re-signed and re-sent after they failed to get included.",
+	})
+
+	// ClerkPendingStateSyncs is number of state syncs waiting to be included in heimdall
+	ClerkPendingStateSyncs = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "clerk",
+		Name:      "pending_state_syncs",
+		Help:      "Number of state syncs waiting to be included in heimdall.",
+	})
+
+	// ClerkQuarantinedStateSyncs counts state syncs quarantined because heimdall will never accept them
+	ClerkQuarantinedStateSyncs = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "clerk",
+		Name:      "quarantined_state_syncs_total",
+		Help:      "Number of state syncs quarantined because heimdall will never accept them.",
+	})
+
+	// ClerkGapWaitSeconds is how long pending state syncs have been waiting for a missing predecessor
+	ClerkGapWaitSeconds = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "clerk",
+		Name:      "gap_wait_seconds",
+		Help:      "Seconds pending state syncs have been waiting for a missing predecessor, 0 if there is no gap.",
+	})
+
+	// ClerkSkippedGaps counts polls sending state syncs without their missing predecessor after clerk gap timeout
+	ClerkSkippedGaps = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "clerk",
+		Name:      "skipped_gaps_total",
+		Help:      "Number of polls sending state syncs without their missing predecessor after clerk gap timeout.",
+	})
+
+	// DedupLookups counts lookups of rootchain events in dedup index, misses are asked to heimdall server
+	DedupLookups = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "dedup",
+		Name:      "lookups_total",
+		Help:      "Lookups of rootchain events in dedup index by result (hit or miss).",
+	}, []string{"event", "result"})
+
+	// RootchainGasUsed counts gas used by rootchain transactions sent by this validator
+	RootchainGasUsed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "rootchain",
+		Name:      "gas_used_total",
+		Help:      "Gas used by rootchain transactions sent by this validator.",
+	}, []string{"tx"})
+
+	// RootchainFeesPaid counts fees paid (in wei) for rootchain transactions sent by this validator
+	RootchainFeesPaid = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "rootchain",
+		Name:      "fees_paid_wei_total",
+		Help:      "Fees paid in wei for rootchain transactions sent by this validator.",
+	}, []string{"tx"})
+)
+
+var (
+	// head and last processed blocks per listener, to calculate lag
+	listenerHeads     = make(map[string]uint64)
+	listenerProcessed = make(map[string]uint64)
+	listenerMutex     sync.Mutex
+
+	// rootchain txs whose gas is already counted
+	countedTxs, _ = lru.New(1000)
+)
+
+func init() {
+	prometheus.MustRegister(
+		ListenerHeadBlock,
+		ListenerLastProcessedBlock,
+		ListenerLag,
+		TasksEnqueued,
+		TasksRetried,
+		TasksSucceeded,
+		TasksFailed,
+		TasksDeadLettered,
+		TaskDuration,
+		BroadcasterSequenceMismatches,
+		BroadcasterInFlightTxs,
+		BroadcasterResignedTxs,
+		ClerkPendingStateSyncs,
+		ClerkQuarantinedStateSyncs,
+		ClerkGapWaitSeconds,
+		ClerkSkippedGaps,
+		DedupLookups,
+		RootchainGasUsed,
+		RootchainFeesPaid,
+	)
+}
+
+// SetListenerHead records latest block seen by listener
+func SetListenerHead(listener string, head uint64) {
+	listenerMutex.Lock()
+	defer listenerMutex.Unlock()
+
+	listenerHeads[listener] = head
+	ListenerHeadBlock.WithLabelValues(listener).Set(float64(head))
+	updateLag(listener)
+}
+
+// SetListenerLastProcessed records last block processed by listener
+func SetListenerLastProcessed(listener string, processed uint64) {
+	listenerMutex.Lock()
+	defer listenerMutex.Unlock()
+
+	listenerProcessed[listener] = processed
+	ListenerLastProcessedBlock.WithLabelValues(listener).Set(float64(processed))
+	updateLag(listener)
+}
+
+// RecordRootchainTx adds gas and fees of rootchain tx, counting each tx only once
+func RecordRootchainTx(txType string, txHash string, gasUsed uint64, feesPaid float64) {
+	if ok, _ := countedTxs.ContainsOrAdd(txHash, true); ok {
+		return
+	}
+
+	RootchainGasUsed.WithLabelValues(txType).Add(float64(gasUsed))
+	RootchainFeesPaid.WithLabelValues(txType).Add(feesPaid)
+}
+
+// StartServer serves metrics on given address at /metrics
+func StartServer(addr string, logger log.Logger) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	server := &http.Server{Addr: addr, Handler: mux}
+	go func() {
+		logger.Info("Starting metrics server", "address", addr)
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Error("Metrics server stopped", "error", err)
+		}
+	}()
+
+	return server
+}
+
+// updateLag sets lag of listener, caller must hold listenerMutex
+func updateLag(listener string) {
+	head, processed := listenerHeads[listener], listenerProcessed[listener]
+	if head > processed {
+		ListenerLag.WithLabelValues(listener).Set(float64(head - processed))
+	} else {
+		ListenerLag.WithLabelValues(listener).Set(0)
+	}
+}
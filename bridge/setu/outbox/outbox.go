@@ -0,0 +1,449 @@
+// Package outbox persists rootchain submissions of bridge in bridge db.
+//
+// Every checkpoint and tick transaction is stored before it is sent, with its call data
+// (signed payload and signatures). Every signed version is stored with its nonce and hash before
+// it is broadcast, so a transaction which reached the node is never lost by a restart. Reconciler
+// follows stored submissions through their states, across restarts, until they are confirmed or superseded:
+//
+//	built -> sent -> mined -> confirmed
+//	           \-> superseded
+package outbox
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/maticnetwork/bor/rlp"
+	"github.com/syndtr/goleveldb/leveldb"
+	levelUtil "github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+// submission states
+const (
+	StateBuilt      = "built"      // stored, not sent yet
+	StateSent       = "sent"       // signed and sent, waiting to be mined
+	StateMined      = "mined"      // mined, waiting for confirmations
+	StateConfirmed  = "confirmed"  // mined with enough confirmations
+	StateSuperseded = "superseded" // nonce used by another tx, or rootchain rejected the call
+)
+
+const (
+	submissionPrefix = "outbox-" // storage key prefix
+
+	// submission which failed to be sent this many times is given up
+	maxSendAttempts = 10
+)
+
+// ErrSubmissionNotFound is returned when submission doesn't exist
+var ErrSubmissionNotFound = errors.New("submission not found")
+
+// Submission is a rootchain contract call sent by bridge
+type Submission struct {
+	ID    string         `json:"id"`
+	Kind  string         `json:"kind"`
+	State string         `json:"state"`
+	To    common.Address `json:"to"`
+	Data  hexutil.Bytes  `json:"data"` // call data with signed payload and signatures
+
+	// latest sent version
+	Nonce    uint64        `json:"nonce"`
+	GasLimit uint64        `json:"gasLimit"`
+	GasPrice *hexutil.Big  `json:"gasPrice,omitempty"`
+	TxHash   common.Hash   `json:"txHash"`
+	TxHashes []common.Hash `json:"txHashes"`        // all sent versions
+	RawTx    hexutil.Bytes `json:"rawTx,omitempty"` // latest signed version, rlp encoded
+
+	MinedBlock uint64 `json:"minedBlock,omitempty"`
+	Attempts   int    `json:"attempts"` // failed sign or broadcast attempts
+	LastError  string `json:"lastError,omitempty"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	SentAt    time.Time `json:"sentAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// IsFinal returns if submission reached its last state
+func (s *Submission) IsFinal() bool {
+	return s.State == StateConfirmed || s.State == StateSuperseded
+}
+
+// signedTx returns latest signed version of submission
+func (s *Submission) signedTx() (*ethTypes.Transaction, error) {
+	tx := new(ethTypes.Transaction)
+	if err := rlp.DecodeBytes(s.RawTx, tx); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// SubmissionID returns id of contract call, same call always gets same id
+func SubmissionID(kind string, data []byte) string {
+	return kind + "-" + hex.EncodeToString(crypto.Keccak256(data)[:8])
+}
+
+// Chain signs, sends and watches mainchain transactions
+type Chain interface {
+	// Sign signs contract call with next nonce
+	Sign(to common.Address, data []byte) (*ethTypes.Transaction, error)
+	// SignReplacement signs tx again with higher gas price, nil if it is already at max gas price
+	SignReplacement(tx *ethTypes.Transaction) (*ethTypes.Transaction, error)
+	// Broadcast sends signed tx
+	Broadcast(tx *ethTypes.Transaction) error
+	// Receipt returns receipt of mined tx, nil if it is not mined
+	Receipt(hash common.Hash) (*ethTypes.Receipt, error)
+	// MinedNonce returns number of mined txs of bridge signer
+	MinedNonce() (uint64, error)
+	// BlockNumber returns latest block number
+	BlockNumber() (uint64, error)
+}
+
+// Outbox stores rootchain submissions in bridge db, it implements helper.TxOutbox
+type Outbox struct {
+	logger log.Logger
+	db     *leveldb.DB
+	chain  Chain
+
+	// serializes sending and state changes
+	mutex sync.Mutex
+}
+
+// NewOutbox creates outbox on given db, sending through chain
+func NewOutbox(db *leveldb.DB, chain Chain) *Outbox {
+	return &Outbox{
+		logger: util.Logger().With("module", "outbox"),
+		db:     db,
+		chain:  chain,
+	}
+}
+
+// Submit stores contract call and sends it. If the same call was already submitted, it is only
+// sent again if it was never sent or was superseded, so retried tasks don't send duplicates.
+func (o *Outbox) Submit(kind string, to common.Address, data []byte) error {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	id := SubmissionID(kind, data)
+	submission, err := o.get(id)
+	if err == ErrSubmissionNotFound {
+		now := time.Now().UTC()
+		submission = &Submission{
+			ID:        id,
+			Kind:      kind,
+			State:     StateBuilt,
+			To:        to,
+			Data:      data,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		if err := o.put(submission); err != nil {
+			return err
+		}
+		o.logger.Info("Stored rootchain submission", "id", id, "kind", kind, "to", to.Hex())
+	} else if err != nil {
+		return err
+	}
+
+	switch submission.State {
+	case StateBuilt, StateSuperseded:
+		return o.send(submission)
+	default:
+		o.logger.Info("Rootchain submission already sent, skipping", "id", id, "state", submission.State, "txHash", submission.TxHash.Hex())
+		return nil
+	}
+}
+
+// Get returns submission by id
+func (o *Outbox) Get(id string) (*Submission, error) {
+	return o.get(id)
+}
+
+// List returns all submissions, oldest first
+func (o *Outbox) List() ([]*Submission, error) {
+	result := make([]*Submission, 0)
+
+	iter := o.db.NewIterator(levelUtil.BytesPrefix([]byte(submissionPrefix)), nil)
+	defer iter.Release()
+	for iter.Next() {
+		var submission Submission
+		if err := json.Unmarshal(iter.Value(), &submission); err != nil {
+			return nil, err
+		}
+		result = append(result, &submission)
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
+	return result, nil
+}
+
+// Reconcile moves every unfinished submission forward by one step and prunes final submissions
+// last updated before retention.
+func (o *Outbox) Reconcile(confirmations uint64, resubmitInterval time.Duration, retention time.Duration) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	submissions, err := o.List()
+	if err != nil {
+		o.logger.Error("Error while listing rootchain submissions", "error", err)
+		return
+	}
+
+	for _, submission := range submissions {
+		var err error
+		switch submission.State {
+		case StateBuilt:
+			err = o.send(submission)
+		case StateSent:
+			err = o.checkSent(submission, resubmitInterval)
+		case StateMined:
+			err = o.checkMined(submission, confirmations)
+		default:
+			if time.Since(submission.UpdatedAt) >= retention {
+				err = o.db.Delete(submissionKey(submission.ID), nil)
+			}
+		}
+
+		if err != nil {
+			o.logger.Error("Error while reconciling rootchain submission", "id", submission.ID, "state", submission.State, "error", err)
+		}
+	}
+}
+
+// send signs built or superseded submission, stores signed tx and broadcasts it
+func (o *Outbox) send(submission *Submission) error {
+	tx, err := o.chain.Sign(submission.To, submission.Data)
+	if err != nil {
+		return o.setSendError(submission, err)
+	}
+
+	submission.State = StateSent
+	submission.Attempts = 0
+	submission.LastError = ""
+	submission.MinedBlock = 0
+	submission.TxHashes = nil // versions of earlier nonce, if superseded
+	if err := o.setTx(submission, tx); err != nil {
+		return err
+	}
+
+	// nonce and hash are stored before broadcast, so tx isn't lost if bridge stops right after it
+	if err := o.put(submission); err != nil {
+		return err
+	}
+
+	return o.broadcast(submission, tx)
+}
+
+// broadcast sends stored signed tx of submission, failed broadcast is retried with same tx by reconciler
+func (o *Outbox) broadcast(submission *Submission, tx *ethTypes.Transaction) error {
+	submission.SentAt = time.Now().UTC()
+	if err := o.chain.Broadcast(tx); err != nil && !isKnownTxError(err) {
+		return o.setSendError(submission, err)
+	}
+
+	submission.Attempts = 0
+	submission.LastError = ""
+
+	o.logger.Info("Sent rootchain submission", "id", submission.ID, "kind", submission.Kind, "nonce", tx.Nonce(), "txHash", tx.Hash().Hex(), "gasPrice", tx.GasPrice())
+	return o.put(submission)
+}
+
+// setSendError records failed sign or broadcast attempt, submission is given up after too many of them
+func (o *Outbox) setSendError(submission *Submission, err error) error {
+	submission.Attempts++
+	submission.LastError = err.Error()
+	if submission.Attempts >= maxSendAttempts {
+		submission.State = StateSuperseded
+		o.logger.Error("Giving up rootchain submission", "id", submission.ID, "attempts", submission.Attempts, "error", err)
+	}
+	if putErr := o.put(submission); putErr != nil {
+		return putErr
+	}
+	return err
+}
+
+// checkSent marks sent submission mined or superseded, or replaces it if it is pending for too long
+func (o *Outbox) checkSent(submission *Submission, resubmitInterval time.Duration) error {
+	if mined, err := o.checkReceipts(submission); err != nil || mined {
+		return err
+	}
+
+	minedNonce, err := o.chain.MinedNonce()
+	if err != nil {
+		return err
+	}
+
+	// nonce is used, by another tx unless one of sent versions got mined after receipts were checked
+	if submission.Nonce < minedNonce {
+		if mined, err := o.checkReceipts(submission); err != nil || mined {
+			return err
+		}
+
+		submission.State = StateSuperseded
+		o.logger.Info("Rootchain submission is superseded", "id", submission.ID, "nonce", submission.Nonce)
+		return o.put(submission)
+	}
+
+	// broadcast of stored tx failed, it is sent again as it is
+	if submission.LastError != "" {
+		tx, err := submission.signedTx()
+		if err != nil {
+			return err
+		}
+		return o.broadcast(submission, tx)
+	}
+
+	if time.Since(submission.SentAt) < resubmitInterval {
+		return nil
+	}
+
+	replacement, err := o.chain.SignReplacement(ethTypes.NewTransaction(submission.Nonce, submission.To, big.NewInt(0), submission.GasLimit, submission.GasPrice.ToInt(), submission.Data))
+	if err != nil {
+		return err
+	}
+
+	if replacement == nil {
+		submission.SentAt = time.Now().UTC()
+		o.logger.Info("Rootchain submission is already at max gas price, waiting", "id", submission.ID, "txHash", submission.TxHash.Hex())
+		return o.put(submission)
+	}
+
+	if err := o.setTx(submission, replacement); err != nil {
+		return err
+	}
+	if err := o.put(submission); err != nil {
+		return err
+	}
+
+	o.logger.Info("Replacing pending rootchain submission", "id", submission.ID, "nonce", replacement.Nonce(), "txHash", replacement.Hash().Hex(), "gasPrice", replacement.GasPrice())
+	return o.broadcast(submission, replacement)
+}
+
+// checkReceipts marks submission mined if any of its sent versions is mined
+func (o *Outbox) checkReceipts(submission *Submission) (bool, error) {
+	for _, hash := range submission.TxHashes {
+		receipt, err := o.chain.Receipt(hash)
+		if err != nil {
+			return false, err
+		}
+
+		if receipt != nil {
+			return true, o.setMined(submission, hash, receipt)
+		}
+	}
+
+	return false, nil
+}
+
+// checkMined marks mined submission confirmed, or sent again if its block was reorged out
+func (o *Outbox) checkMined(submission *Submission, confirmations uint64) error {
+	receipt, err := o.chain.Receipt(submission.TxHash)
+	if err != nil {
+		return err
+	}
+
+	if receipt == nil {
+		submission.State = StateSent
+		submission.MinedBlock = 0
+		o.logger.Info("Rootchain submission is not mined anymore", "id", submission.ID, "txHash", submission.TxHash.Hex())
+		return o.put(submission)
+	}
+
+	latest, err := o.chain.BlockNumber()
+	if err != nil {
+		return err
+	}
+
+	submission.MinedBlock = receipt.BlockNumber.Uint64()
+	if latest+1 < submission.MinedBlock+confirmations {
+		return nil
+	}
+
+	submission.State = StateConfirmed
+	o.logger.Info("Rootchain submission is confirmed", "id", submission.ID, "txHash", submission.TxHash.Hex(), "block", submission.MinedBlock)
+	return o.put(submission)
+}
+
+// setMined marks submission mined by tx with hash, reverted txs are superseded
+func (o *Outbox) setMined(submission *Submission, hash common.Hash, receipt *ethTypes.Receipt) error {
+	submission.TxHash = hash
+	submission.MinedBlock = receipt.BlockNumber.Uint64()
+
+	if receipt.Status == ethTypes.ReceiptStatusFailed {
+		submission.State = StateSuperseded
+		submission.LastError = "transaction reverted"
+		o.logger.Info("Rootchain submission reverted", "id", submission.ID, "txHash", hash.Hex(), "block", submission.MinedBlock)
+		return o.put(submission)
+	}
+
+	submission.State = StateMined
+	o.logger.Info("Rootchain submission is mined", "id", submission.ID, "txHash", hash.Hex(), "block", submission.MinedBlock)
+	return o.put(submission)
+}
+
+// setTx records signed tx as latest version of submission
+func (o *Outbox) setTx(submission *Submission, tx *ethTypes.Transaction) error {
+	rawTx, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return err
+	}
+
+	submission.Nonce = tx.Nonce()
+	submission.GasLimit = tx.Gas()
+	submission.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	submission.TxHash = tx.Hash()
+	submission.TxHashes = append(submission.TxHashes, tx.Hash())
+	submission.RawTx = rawTx
+	return nil
+}
+
+// isKnownTxError returns if node rejected tx because it already has it
+func isKnownTxError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "already known") || strings.Contains(msg, "known transaction")
+}
+
+func (o *Outbox) get(id string) (*Submission, error) {
+	value, err := o.db.Get(submissionKey(id), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, ErrSubmissionNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	var submission Submission
+	if err := json.Unmarshal(value, &submission); err != nil {
+		return nil, err
+	}
+	return &submission, nil
+}
+
+func (o *Outbox) put(submission *Submission) error {
+	submission.UpdatedAt = time.Now().UTC()
+	value, err := json.Marshal(submission)
+	if err != nil {
+		return err
+	}
+
+	return o.db.Put(submissionKey(submission.ID), value, nil)
+}
+
+func submissionKey(id string) []byte {
+	return []byte(submissionPrefix + id)
+}
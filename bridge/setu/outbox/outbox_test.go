@@ -0,0 +1,277 @@
+package outbox
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+// fakeChain records broadcast txs and serves receipts set by test
+type fakeChain struct {
+	nonce        uint64
+	minedNonce   uint64
+	block        uint64
+	signErr      error
+	broadcastErr error
+
+	// called on broadcast and on reading mined nonce, if set
+	onBroadcast  func(tx *ethTypes.Transaction)
+	onMinedNonce func()
+
+	sent     []*ethTypes.Transaction
+	receipts map[common.Hash]*ethTypes.Receipt
+}
+
+func newFakeChain() *fakeChain {
+	return &fakeChain{receipts: make(map[common.Hash]*ethTypes.Receipt)}
+}
+
+func (c *fakeChain) Sign(to common.Address, data []byte) (*ethTypes.Transaction, error) {
+	if c.signErr != nil {
+		return nil, c.signErr
+	}
+
+	tx := ethTypes.NewTransaction(c.nonce, to, big.NewInt(0), 100000, big.NewInt(10), data)
+	c.nonce++
+	return tx, nil
+}
+
+func (c *fakeChain) SignReplacement(tx *ethTypes.Transaction) (*ethTypes.Transaction, error) {
+	return ethTypes.NewTransaction(tx.Nonce(), *tx.To(), tx.Value(), tx.Gas(), new(big.Int).Add(tx.GasPrice(), big.NewInt(1)), tx.Data()), nil
+}
+
+func (c *fakeChain) Broadcast(tx *ethTypes.Transaction) error {
+	if c.onBroadcast != nil {
+		c.onBroadcast(tx)
+	}
+	if c.broadcastErr != nil {
+		return c.broadcastErr
+	}
+
+	c.sent = append(c.sent, tx)
+	return nil
+}
+
+func (c *fakeChain) Receipt(hash common.Hash) (*ethTypes.Receipt, error) {
+	return c.receipts[hash], nil
+}
+
+func (c *fakeChain) MinedNonce() (uint64, error) {
+	if c.onMinedNonce != nil {
+		c.onMinedNonce()
+	}
+	return c.minedNonce, nil
+}
+
+func (c *fakeChain) BlockNumber() (uint64, error) {
+	return c.block, nil
+}
+
+func (c *fakeChain) mine(tx *ethTypes.Transaction, block uint64, status uint64) {
+	c.receipts[tx.Hash()] = &ethTypes.Receipt{Status: status, BlockNumber: new(big.Int).SetUint64(block)}
+	c.minedNonce = tx.Nonce() + 1
+	c.block = block
+}
+
+func newTestOutbox(t *testing.T) (*Outbox, *fakeChain, *leveldb.DB) {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+
+	chain := newFakeChain()
+	return NewOutbox(db, chain), chain, db
+}
+
+func TestSubmissionLifecycle(t *testing.T) {
+	outbox, chain, db := newTestOutbox(t)
+	defer db.Close()
+
+	to := common.HexToAddress("0x01")
+	data := []byte("checkpoint with sigs")
+	id := SubmissionID("checkpoint", data)
+
+	require.NoError(t, outbox.Submit("checkpoint", to, data))
+	require.Len(t, chain.sent, 1)
+
+	submission, err := outbox.Get(id)
+	require.NoError(t, err)
+	require.Equal(t, StateSent, submission.State)
+	require.Equal(t, chain.sent[0].Hash(), submission.TxHash)
+
+	// retried task doesn't send again
+	require.NoError(t, outbox.Submit("checkpoint", to, data))
+	require.Len(t, chain.sent, 1)
+
+	// pending too long, replaced with same nonce
+	outbox.Reconcile(2, 0, time.Hour)
+	require.Len(t, chain.sent, 2)
+	submission, err = outbox.Get(id)
+	require.NoError(t, err)
+	require.Equal(t, StateSent, submission.State)
+	require.Equal(t, uint64(0), submission.Nonce)
+	require.Equal(t, []common.Hash{chain.sent[0].Hash(), chain.sent[1].Hash()}, submission.TxHashes)
+
+	// first version gets mined
+	chain.mine(chain.sent[0], 10, ethTypes.ReceiptStatusSuccessful)
+	outbox.Reconcile(2, time.Hour, time.Hour)
+	submission, err = outbox.Get(id)
+	require.NoError(t, err)
+	require.Equal(t, StateMined, submission.State)
+	require.Equal(t, chain.sent[0].Hash(), submission.TxHash)
+
+	// confirmed after enough blocks
+	outbox.Reconcile(2, time.Hour, time.Hour)
+	submission, err = outbox.Get(id)
+	require.NoError(t, err)
+	require.Equal(t, StateMined, submission.State)
+
+	chain.block = 11
+	outbox.Reconcile(2, time.Hour, time.Hour)
+	submission, err = outbox.Get(id)
+	require.NoError(t, err)
+	require.Equal(t, StateConfirmed, submission.State)
+	require.True(t, submission.IsFinal())
+
+	// final submissions are pruned after retention
+	outbox.Reconcile(2, time.Hour, 0)
+	_, err = outbox.Get(id)
+	require.Equal(t, ErrSubmissionNotFound, err)
+}
+
+func TestSubmissionResumedAfterRestart(t *testing.T) {
+	outbox, chain, db := newTestOutbox(t)
+	defer db.Close()
+
+	to := common.HexToAddress("0x02")
+	data := []byte("tick with sigs")
+	id := SubmissionID("tick", data)
+
+	// stored but sending failed
+	chain.signErr = errors.New("connection refused")
+	require.Error(t, outbox.Submit("tick", to, data))
+	submission, err := outbox.Get(id)
+	require.NoError(t, err)
+	require.Equal(t, StateBuilt, submission.State)
+	require.Equal(t, 1, submission.Attempts)
+	require.Equal(t, "connection refused", submission.LastError)
+
+	// new process on same db sends it
+	chain.signErr = nil
+	restarted := NewOutbox(db, chain)
+	restarted.Reconcile(2, time.Hour, time.Hour)
+	require.Len(t, chain.sent, 1)
+	submission, err = restarted.Get(id)
+	require.NoError(t, err)
+	require.Equal(t, StateSent, submission.State)
+	require.Empty(t, submission.LastError)
+
+	// nonce taken by another tx
+	chain.minedNonce = 1
+	restarted.Reconcile(2, time.Hour, time.Hour)
+	submission, err = restarted.Get(id)
+	require.NoError(t, err)
+	require.Equal(t, StateSuperseded, submission.State)
+
+	// superseded submission is sent again when submitted again
+	require.NoError(t, restarted.Submit("tick", to, data))
+	require.Len(t, chain.sent, 2)
+	submission, err = restarted.Get(id)
+	require.NoError(t, err)
+	require.Equal(t, StateSent, submission.State)
+	require.Equal(t, uint64(1), submission.Nonce)
+	require.Equal(t, []common.Hash{chain.sent[1].Hash()}, submission.TxHashes)
+
+	// reverted tx supersedes submission
+	chain.mine(chain.sent[1], 5, ethTypes.ReceiptStatusFailed)
+	restarted.Reconcile(2, time.Hour, time.Hour)
+	submission, err = restarted.Get(id)
+	require.NoError(t, err)
+	require.Equal(t, StateSuperseded, submission.State)
+	require.Equal(t, "transaction reverted", submission.LastError)
+
+	submissions, err := restarted.List()
+	require.NoError(t, err)
+	require.Len(t, submissions, 1)
+}
+
+func TestSubmissionStoredBeforeBroadcast(t *testing.T) {
+	outbox, chain, db := newTestOutbox(t)
+	defer db.Close()
+
+	to := common.HexToAddress("0x03")
+	data := []byte("checkpoint with sigs")
+	id := SubmissionID("checkpoint", data)
+
+	// signed tx is stored when it reaches the node
+	chain.onBroadcast = func(tx *ethTypes.Transaction) {
+		submission, err := outbox.Get(id)
+		require.NoError(t, err)
+		require.Equal(t, StateSent, submission.State)
+		require.Equal(t, tx.Nonce(), submission.Nonce)
+		require.Equal(t, tx.Hash(), submission.TxHash)
+
+		stored, err := submission.signedTx()
+		require.NoError(t, err)
+		require.Equal(t, tx.Hash(), stored.Hash())
+	}
+
+	// broadcast fails, nonce is kept for the stored tx
+	chain.broadcastErr = errors.New("connection refused")
+	require.Error(t, outbox.Submit("checkpoint", to, data))
+	require.Empty(t, chain.sent)
+	submission, err := outbox.Get(id)
+	require.NoError(t, err)
+	require.Equal(t, StateSent, submission.State)
+	require.Equal(t, "connection refused", submission.LastError)
+	signedHash := submission.TxHash
+
+	// retried task doesn't sign again
+	require.NoError(t, outbox.Submit("checkpoint", to, data))
+	require.Equal(t, uint64(1), chain.nonce)
+
+	// reconciler sends same stored tx
+	chain.broadcastErr = nil
+	outbox.Reconcile(2, time.Hour, time.Hour)
+	require.Len(t, chain.sent, 1)
+	require.Equal(t, signedHash, chain.sent[0].Hash())
+	require.Equal(t, uint64(1), chain.nonce)
+	submission, err = outbox.Get(id)
+	require.NoError(t, err)
+	require.Equal(t, StateSent, submission.State)
+	require.Empty(t, submission.LastError)
+	require.Equal(t, []common.Hash{signedHash}, submission.TxHashes)
+
+	// node which already has tx accepts it
+	chain.broadcastErr = errors.New("already known")
+	require.NoError(t, outbox.broadcast(submission, chain.sent[0]))
+}
+
+func TestSubmissionMinedWhileCheckingNonce(t *testing.T) {
+	outbox, chain, db := newTestOutbox(t)
+	defer db.Close()
+
+	to := common.HexToAddress("0x04")
+	data := []byte("tick with sigs")
+	id := SubmissionID("tick", data)
+
+	require.NoError(t, outbox.Submit("tick", to, data))
+	require.Len(t, chain.sent, 1)
+
+	// tx gets mined after its receipt was checked, before mined nonce is read
+	chain.onMinedNonce = func() {
+		chain.mine(chain.sent[0], 7, ethTypes.ReceiptStatusSuccessful)
+	}
+	outbox.Reconcile(2, time.Hour, time.Hour)
+
+	submission, err := outbox.Get(id)
+	require.NoError(t, err)
+	require.Equal(t, StateMined, submission.State)
+	require.Equal(t, chain.sent[0].Hash(), submission.TxHash)
+	require.Equal(t, uint64(7), submission.MinedBlock)
+}
@@ -0,0 +1,131 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	ethereum "github.com/maticnetwork/bor"
+	"github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const (
+	// ReconcileInterval is how often reconciler moves submissions forward
+	ReconcileInterval = 15 * time.Second
+
+	// Retention is how long confirmed and superseded submissions are kept
+	Retention = 7 * 24 * time.Hour
+)
+
+// mainChain sends and watches transactions through helper main client
+type mainChain struct{}
+
+// NewMainChain returns chain sending bridge transactions to mainchain
+func NewMainChain() Chain {
+	return mainChain{}
+}
+
+func (mainChain) Sign(to common.Address, data []byte) (*ethTypes.Transaction, error) {
+	return helper.SignContractTx(to, data)
+}
+
+func (mainChain) SignReplacement(tx *ethTypes.Transaction) (*ethTypes.Transaction, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), helper.GetConfig().EthRPCTimeout)
+	defer cancel()
+
+	return helper.SignReplacementTx(ctx, helper.GetMainClient(), tx)
+}
+
+func (mainChain) Broadcast(tx *ethTypes.Transaction) error {
+	ctx, cancel := context.WithTimeout(context.Background(), helper.GetConfig().EthRPCTimeout)
+	defer cancel()
+
+	return helper.GetMainClient().SendTransaction(ctx, tx)
+}
+
+func (mainChain) Receipt(hash common.Hash) (*ethTypes.Receipt, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), helper.GetConfig().EthRPCTimeout)
+	defer cancel()
+
+	receipt, err := helper.GetMainClient().TransactionReceipt(ctx, hash)
+	if err == ethereum.NotFound {
+		return nil, nil
+	}
+	return receipt, err
+}
+
+func (mainChain) MinedNonce() (uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), helper.GetConfig().EthRPCTimeout)
+	defer cancel()
+
+	return helper.GetMainClient().NonceAt(ctx, helper.GetSigner().Address(), nil)
+}
+
+func (mainChain) BlockNumber() (uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), helper.GetConfig().EthRPCTimeout)
+	defer cancel()
+
+	header, err := helper.GetMainClient().HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	return header.Number.Uint64(), nil
+}
+
+// Reconciler periodically drives outbox submissions to completion
+type Reconciler struct {
+	outbox *Outbox
+	cliCtx cliContext.CLIContext
+
+	cancel context.CancelFunc
+}
+
+// NewReconciler creates reconciler of outbox, confirmations are read from heimdall chain params
+func NewReconciler(outbox *Outbox, cliCtx cliContext.CLIContext) *Reconciler {
+	return &Reconciler{
+		outbox: outbox,
+		cliCtx: cliCtx,
+	}
+}
+
+// Start starts reconciling in background, right away to resume submissions left by previous run
+func (r *Reconciler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	go func() {
+		ticker := time.NewTicker(ReconcileInterval)
+		defer ticker.Stop()
+
+		for {
+			r.reconcile()
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops reconciling
+func (r *Reconciler) Stop() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+}
+
+func (r *Reconciler) reconcile() {
+	chainmanagerParams, err := util.GetChainmanagerParams(r.cliCtx)
+	if err != nil {
+		r.outbox.logger.Error("Error while fetching chain manager params", "error", err)
+		return
+	}
+
+	r.outbox.Reconcile(chainmanagerParams.MainchainTxConfirmations, helper.GetConfig().MainchainTxResubmitInterval, Retention)
+}
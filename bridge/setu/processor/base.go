@@ -1,20 +1,27 @@
 package processor
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"io/ioutil"
+	"math/big"
 	"net/http"
 
+	"github.com/RichardKnop/machinery/v1/tasks"
 	"github.com/cosmos/cosmos-sdk/client"
 	cliContext "github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/types"
+	ethCommon "github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
 	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
 	"github.com/spf13/viper"
 	"github.com/syndtr/goleveldb/leveldb"
 
 	"github.com/maticnetwork/heimdall/bridge/setu/broadcaster"
+	"github.com/maticnetwork/heimdall/bridge/setu/dedup"
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
@@ -58,6 +65,9 @@ type BaseProcessor struct {
 
 	// storage client
 	storageClient *leveldb.DB
+
+	// rootchain events processed by heimdall or in flight
+	dedupIndex *dedup.Index
 }
 
 // NewBaseProcessor creates a new BaseProcessor.
@@ -78,6 +88,8 @@ func NewBaseProcessor(cdc *codec.Codec, queueConnector *queue.QueueConnector, ht
 		logger = log.NewNopLogger()
 	}
 
+	storageClient := util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag))
+
 	// creating syncer object
 	return &BaseProcessor{
 		Logger: logger,
@@ -90,7 +102,8 @@ func NewBaseProcessor(cdc *codec.Codec, queueConnector *queue.QueueConnector, ht
 		contractConnector: contractCaller,
 		txBroadcaster:     txBroadcaster,
 		httpClient:        httpClient,
-		storageClient:     util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag)),
+		storageClient:     storageClient,
+		dedupIndex:        dedup.NewIndex(storageClient, dedup.InFlightTTL()),
 	}
 }
 
@@ -105,8 +118,21 @@ func (bp *BaseProcessor) Stop() {
 }
 
 // isOldTx checks if the transaction already exists in the chain or not
-// It is a generic function, which is consumed in all processors
+// It is a generic function, which is consumed in all processors.
+// Events processed by heimdall are found in dedup index, heimdall server is asked only for the others.
+// Events still in flight return tasks.ErrRetryTaskLater, so the task is checked again once the in-flight entry expires.
 func (bp *BaseProcessor) isOldTx(cliCtx cliContext.CLIContext, txHash string, logIndex uint64, eventType util.BridgeEvent) (bool, error) {
+	ref := dedup.NewRef(eventType, txHash, logIndex)
+	if state, ok := bp.dedupIndex.Lookup(ref); ok {
+		bp.Logger.Debug("Found tx in dedup index", "eventType", eventType, "txHash", txHash, "logIndex", logIndex, "state", state)
+		metrics.DedupLookups.WithLabelValues(string(eventType), "hit").Inc()
+		if state != dedup.StateProcessed {
+			return false, tasks.NewErrRetryTaskLater("event in flight", bp.dedupIndex.RetryIn(ref))
+		}
+		return true, nil
+	}
+	metrics.DedupLookups.WithLabelValues(string(eventType), "miss").Inc()
+
 	queryParam := map[string]interface{}{
 		"txhash":   txHash,
 		"logindex": logIndex,
@@ -142,22 +168,100 @@ func (bp *BaseProcessor) isOldTx(cliCtx cliContext.CLIContext, txHash string, lo
 		return false, err
 	}
 
+	if status {
+		if err := bp.dedupIndex.MarkProcessed(ref); err != nil {
+			bp.Logger.Error("Error while storing processed tx in dedup index", "txHash", txHash, "logIndex", logIndex, "error", err)
+		}
+	}
+
 	return status, nil
 }
 
+// markInFlight stores rootchain event of msg sent to heimdall in dedup index, so duplicate tasks
+// of the event are skipped until heimdall decides on it
+func (bp *BaseProcessor) markInFlight(msg types.Msg) {
+	ref, ok := dedup.MsgRef(msg)
+	if !ok {
+		return
+	}
+
+	if err := bp.dedupIndex.MarkInFlight(ref); err != nil {
+		bp.Logger.Error("Error while storing sent tx in dedup index", "txHash", ref.TxHash, "logIndex", ref.LogIndex, "error", err)
+	}
+}
+
+// recordRootchainTxGas records gas and fees of the rootchain tx which emitted given log,
+// if the tx was sent by this validator
+func (bp *BaseProcessor) recordRootchainTxGas(txType string, vLog ethTypes.Log) {
+	receipt, err := bp.contractConnector.GetMainTxReceipt(vLog.TxHash)
+	if err != nil {
+		bp.Logger.Error("Error while fetching rootchain receipt for metrics", "txHash", vLog.TxHash.Hex(), "error", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), bp.contractConnector.MainChainTimeout)
+	defer cancel()
+
+	tx, _, err := bp.contractConnector.MainChainClient.TransactionByHash(ctx, vLog.TxHash)
+	if err != nil {
+		bp.Logger.Error("Error while fetching rootchain tx for metrics", "txHash", vLog.TxHash.Hex(), "error", err)
+		return
+	}
+
+	sender, err := bp.contractConnector.MainChainClient.TransactionSender(ctx, tx, vLog.BlockHash, vLog.TxIndex)
+	if err != nil || sender != ethCommon.BytesToAddress(helper.GetAddress()) {
+		return
+	}
+
+	fees, _ := new(big.Float).SetInt(new(big.Int).Mul(tx.GasPrice(), new(big.Int).SetUint64(receipt.GasUsed))).Float64()
+	metrics.RecordRootchainTx(txType, vLog.TxHash.Hex(), receipt.GasUsed, fees)
+}
+
 // checkTxAgainstMempool checks if the transaction is already in the mempool or not
-// It is consumed only for `clerk` processor
+// It is consumed only for `clerk` processor, mempool isn't scanned for txs processed according to dedup index.
+// Txs still in flight return tasks.ErrRetryTaskLater.
 func (bp *BaseProcessor) checkTxAgainstMempool(msg types.Msg) (bool, error) {
+	if ref, ok := dedup.MsgRef(msg); ok {
+		if state, ok := bp.dedupIndex.Lookup(ref); ok {
+			if state != dedup.StateProcessed {
+				return false, tasks.NewErrRetryTaskLater("tx in flight", bp.dedupIndex.RetryIn(ref))
+			}
+			return true, nil
+		}
+	}
+
+	mempoolMsgs, err := bp.getMempoolMsgs()
+	if err != nil {
+		return false, err
+	}
+
+	// We can verify if the message we're about to send is present by
+	// checking the type of transaction, the transaction hash and log index
+	// present in the data of transaction
+	for _, txMsg := range mempoolMsgs {
+		if isSameEventRecord(txMsg, msg) {
+			// If we reach here, there's already a same transaction in the mempool
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// getMempoolMsgs returns messages of all transactions present in the mempool
+func (bp *BaseProcessor) getMempoolMsgs() ([]types.Msg, error) {
 	endpoint := helper.GetConfig().TendermintRPCUrl + util.TendermintUnconfirmedTxsURL
 	resp, err := http.Get(endpoint)
 	if err != nil || resp.StatusCode != http.StatusOK {
 		bp.Logger.Error("Error fetching mempool tx", "url", endpoint, "error", err)
-		return false, err
+		return nil, err
 	}
+	defer resp.Body.Close()
+
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		bp.Logger.Error("Error fetching mempool tx", "error", err)
-		return false, err
+		return nil, err
 	}
 
 	// a minimal response of the unconfirmed txs
@@ -166,16 +270,10 @@ func (bp *BaseProcessor) checkTxAgainstMempool(msg types.Msg) (bool, error) {
 	err = json.Unmarshal(body, &response)
 	if err != nil {
 		bp.Logger.Error("Error unmarshalling response received from Heimdall Server", "error", err)
-		return false, err
+		return nil, err
 	}
 
-	// Iterate over txs present in the mempool
-	// We can verify if the message we're about to send is present by
-	// checking the type of transaction, the transaction hash and log index
-	// present in the data of transaction
-
-	status := false
-Loop:
+	var msgs []types.Msg
 	for _, txn := range response.Result.Txs {
 		// Tendermint encodes the transactions with base64 encoding. Decode it first.
 		txBytes, err := base64.StdEncoding.DecodeString(txn)
@@ -190,44 +288,33 @@ Loop:
 			bp.Logger.Error("Error decoding tx (tx decoder) while checking against mempool", "error", err)
 			continue
 		}
-		txMsg := decodedTx.GetMsgs()[0]
-
-		// We only need to check for `event-record` type transactions.
-		// If required, add case for others here.
-		switch txMsg.Type() {
-		case "event-record":
-
-			// typecast the txs for clerk type message
-			mempoolTxMsg, ok := txMsg.(clerkTypes.MsgEventRecord)
-			if !ok {
-				bp.Logger.Error("Unable to typecast message to clerk event record while checking against mempool")
-				continue Loop
-			}
 
-			// typecast the msg for clerk type message
-			clerkMsg, ok := msg.(clerkTypes.MsgEventRecord)
-			if !ok {
-				bp.Logger.Error("Unable to typecast message to clerk event record while checking against mempool")
-				continue Loop
-			}
+		msgs = append(msgs, decodedTx.GetMsgs()...)
+	}
 
-			// check the transaction hash in message
-			if clerkMsg.GetTxHash() != mempoolTxMsg.GetTxHash() {
-				continue Loop
-			}
+	return msgs, nil
+}
 
-			// check the log index in the message
-			if clerkMsg.GetLogIndex() != mempoolTxMsg.GetLogIndex() {
-				continue Loop
-			}
+// isSameEventRecord checks if both messages are clerk event records of the same rootchain log
+func isSameEventRecord(txMsg types.Msg, msg types.Msg) bool {
+	// We only need to check for `event-record` type transactions.
+	// If required, add case for others here.
+	if txMsg.Type() != "event-record" {
+		return false
+	}
 
-			// If we reach here, there's already a same transaction in the mempool
-			status = true
-			break Loop
-		default:
-			// ignore
-		}
+	// typecast the txs for clerk type message
+	mempoolTxMsg, ok := txMsg.(clerkTypes.MsgEventRecord)
+	if !ok {
+		return false
 	}
 
-	return status, nil
+	// typecast the msg for clerk type message
+	clerkMsg, ok := msg.(clerkTypes.MsgEventRecord)
+	if !ok {
+		return false
+	}
+
+	// check the transaction hash and log index in message
+	return clerkMsg.GetTxHash() == mempoolTxMsg.GetTxHash() && clerkMsg.GetLogIndex() == mempoolTxMsg.GetLogIndex()
 }
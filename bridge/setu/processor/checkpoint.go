@@ -5,17 +5,22 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"math"
 	"math/big"
 	"strconv"
+	"sync"
 	"time"
 
+	"github.com/RichardKnop/machinery/v1/tasks"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/maticnetwork/bor/accounts/abi"
 	"github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/bor/core/types"
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
@@ -35,6 +40,22 @@ type CheckpointProcessor struct {
 
 	// Rootchain abi
 	rootchainAbi *abi.ABI
+
+	// checkpoint standby saw due but not proposed, and since when
+	standbyMutex    sync.Mutex
+	standbyDueStart uint64
+	standbyDueSince time.Time
+
+	// rootchain checkpoint state, contract connector if nil
+	rootChainReader checkpointReader
+}
+
+// checkpointReader reads checkpoints submitted to rootchain
+type checkpointReader interface {
+	GetRootChainInstance(rootchainAddress common.Address) (*rootchain.Rootchain, error)
+	CurrentHeaderBlock(rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (uint64, error)
+	GetHeaderInfo(number uint64, rootChainAddress common.Address, childBlockInterval uint64) (common.Hash, uint64, uint64, uint64, hmTypes.HeimdallAddress, error)
+	GetLastChildBlock(rootChainInstance *rootchain.Rootchain) (uint64, error)
 }
 
 // Result represents single req result
@@ -46,6 +67,19 @@ type Result struct {
 type CheckpointContext struct {
 	ChainmanagerParams *chainmanagerTypes.Params
 	CheckpointParams   *checkpointTypes.Params
+
+	// additional bor chain checkpointed, empty for the default chain
+	BorChainID string
+}
+
+// RootChainAddress returns root chain contract which takes checkpoints of the bor chain
+func (c *CheckpointContext) RootChainAddress() common.Address {
+	chainParams := c.ChainmanagerParams.ChainParams
+	if borChain, ok := chainParams.GetBorChain(c.BorChainID); ok {
+		return borChain.RootChainAddress.EthAddress()
+	}
+
+	return chainParams.RootChainAddress.EthAddress()
 }
 
 // NewCheckpointProcessor - add rootchain abi to checkpoint processor
@@ -70,27 +104,36 @@ func (cp *CheckpointProcessor) Start() error {
 // RegisterTasks - Registers checkpoint related tasks with machinery
 func (cp *CheckpointProcessor) RegisterTasks() {
 	cp.Logger.Info("Registering checkpoint tasks")
-	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointToHeimdall", cp.sendCheckpointToHeimdall); err != nil {
+	if err := cp.queueConnector.RegisterTask("sendCheckpointToHeimdall", cp.sendCheckpointToHeimdall); err != nil {
 		cp.Logger.Error("RegisterTasks | sendCheckpointToHeimdall", "error", err)
 	}
-	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointToRootchain", cp.sendCheckpointToRootchain); err != nil {
+	if err := cp.queueConnector.RegisterTask("sendBorChainCheckpointToHeimdall", cp.sendBorChainCheckpointToHeimdall); err != nil {
+		cp.Logger.Error("RegisterTasks | sendBorChainCheckpointToHeimdall", "error", err)
+	}
+	if err := cp.queueConnector.RegisterTask("sendCheckpointToRootchain", cp.sendCheckpointToRootchain); err != nil {
 		cp.Logger.Error("RegisterTasks | sendCheckpointToRootchain", "error", err)
 	}
-	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointAckToHeimdall", cp.sendCheckpointAckToHeimdall); err != nil {
+	if err := cp.queueConnector.RegisterTask("sendCheckpointAckToHeimdall", cp.sendCheckpointAckToHeimdall); err != nil {
 		cp.Logger.Error("RegisterTasks | sendCheckpointAckToHeimdall", "error", err)
 	}
+	if err := cp.queueConnector.RegisterTask("sendCheckpointToRootchainAsStandby", cp.sendCheckpointToRootchainAsStandby); err != nil {
+		cp.Logger.Error("RegisterTasks | sendCheckpointToRootchainAsStandby", "error", err)
+	}
 }
 
 func (cp *CheckpointProcessor) startPollingForNoAck(ctx context.Context, interval time.Duration) {
 	ticker := time.NewTicker(interval)
 	// stop ticker when everything done
 	defer ticker.Stop()
+	util.StartHeartbeat(cp.name, "noack-polling", util.HeartbeatTimeout(interval))
 	for {
 		select {
 		case <-ticker.C:
+			util.Heartbeat(cp.name, "noack-polling")
 			go cp.handleCheckpointNoAck()
 		case <-ctx.Done():
 			cp.Logger.Info("No-ack Polling stopped")
+			util.StopHeartbeat(cp.name, "noack-polling")
 			ticker.Stop()
 			return
 		}
@@ -102,13 +145,19 @@ func (cp *CheckpointProcessor) startPollingForNoAck(ctx context.Context, interva
 // 2. check if checkpoint has to be proposed for given headerblock
 // 3. if so, propose checkpoint to heimdall.
 func (cp *CheckpointProcessor) sendCheckpointToHeimdall(headerBlockStr string) (err error) {
+	return cp.sendBorChainCheckpointToHeimdall(headerBlockStr, "")
+}
+
+// sendBorChainCheckpointToHeimdall - handles headerblock from an additional bor chain,
+// same as sendCheckpointToHeimdall with checkpoint, buffer and root chain contract of the chain.
+func (cp *CheckpointProcessor) sendBorChainCheckpointToHeimdall(headerBlockStr string, borChainID string) (err error) {
 	var header = types.Header{}
 	if err := header.UnmarshalJSON([]byte(headerBlockStr)); err != nil {
 		cp.Logger.Error("Error while unmarshalling the header block", "error", err)
 		return err
 	}
 
-	cp.Logger.Info("Processing new header", "headerNumber", header.Number)
+	cp.Logger.Info("Processing new header", "headerNumber", header.Number, "borChainID", borChainID)
 	var isProposer bool
 	if isProposer, err = util.IsProposer(cp.cliCtx); err != nil {
 		cp.Logger.Error("Error checking isProposer in HeaderBlock handler", "error", err)
@@ -117,7 +166,7 @@ func (cp *CheckpointProcessor) sendCheckpointToHeimdall(headerBlockStr string) (
 
 	if isProposer {
 		// fetch checkpoint context
-		checkpointContext, err := cp.getCheckpointContext()
+		checkpointContext, err := cp.getChainCheckpointContext(borChainID)
 		if err != nil {
 			return err
 		}
@@ -145,7 +194,7 @@ func (cp *CheckpointProcessor) sendCheckpointToHeimdall(headerBlockStr string) (
 		timeStamp := uint64(time.Now().Unix())
 		checkpointBufferTime := uint64(checkpointContext.CheckpointParams.CheckpointBufferTime.Seconds())
 
-		bufferedCheckpoint, err := util.GetBufferedCheckpoint(cp.cliCtx)
+		bufferedCheckpoint, err := util.GetChainBufferedCheckpoint(cp.cliCtx, checkpointContext.BorChainID)
 		if err != nil {
 			cp.Logger.Debug("No buffered checkpoint", "bufferedCheckpoint", bufferedCheckpoint)
 		}
@@ -159,9 +208,11 @@ func (cp *CheckpointProcessor) sendCheckpointToHeimdall(headerBlockStr string) (
 			cp.Logger.Error("Error sending checkpoint to heimdall", "error", err)
 			return err
 		}
-	} else {
+	} else if borChainID == "" {
 		cp.Logger.Info("I am not the proposer. skipping newheader", "headerNumber", header.Number)
-		return
+		return cp.checkCheckpointProposedAsStandby(header.Number.Uint64())
+	} else {
+		cp.Logger.Info("I am not the proposer. skipping newheader", "headerNumber", header.Number, "borChainID", borChainID)
 	}
 
 	return nil
@@ -193,23 +244,9 @@ func (cp *CheckpointProcessor) sendCheckpointToRootchain(eventBytes string, bloc
 		return err
 	}
 
-	var startBlock uint64
-	var endBlock uint64
-	var txHash string
-
-	for _, attr := range event.Attributes {
-		if attr.Key == checkpointTypes.AttributeKeyStartBlock {
-			startBlock, _ = strconv.ParseUint(attr.Value, 10, 64)
-		}
-		if attr.Key == checkpointTypes.AttributeKeyEndBlock {
-			endBlock, _ = strconv.ParseUint(attr.Value, 10, 64)
-		}
-		if attr.Key == hmTypes.AttributeKeyTxHash {
-			txHash = attr.Value
-		}
-	}
+	startBlock, endBlock, txHash, borChainID := parseCheckpointEvent(event)
 
-	checkpointContext, err := cp.getCheckpointContext()
+	checkpointContext, err := cp.getChainCheckpointContext(borChainID)
 	if err != nil {
 		return err
 	}
@@ -225,6 +262,9 @@ func (cp *CheckpointProcessor) sendCheckpointToRootchain(eventBytes string, bloc
 			cp.Logger.Error("Error sending checkpoint to rootchain", "error", err)
 			return err
 		}
+	} else if shouldSend {
+		// stand by in case proposer doesn't submit it
+		return cp.queueCheckpointForStandby(eventBytes, blockHeight)
 	} else {
 		cp.Logger.Info("I am not the current proposer or checkpoint already sent. Ignoring", "eventType", event.Type)
 		return nil
@@ -266,8 +306,20 @@ func (cp *CheckpointProcessor) sendCheckpointAckToHeimdall(eventName string, che
 			"logIndex", uint64(log.Index),
 		)
 
+		// count gas spent if this validator submitted the checkpoint
+		cp.recordRootchainTxGas(metrics.CheckpointTx, log)
+
+		// checkpoints of additional bor chains are acked on their own root chain contract
+		borChainID := ""
+		for _, bc := range checkpointContext.ChainmanagerParams.ChainParams.BorChains {
+			if bc.RootChainAddress.EthAddress() == log.Address {
+				borChainID = bc.BorChainID
+				break
+			}
+		}
+
 		// fetch latest checkpoint
-		latestCheckpoint, err := util.GetlastestCheckpoint(cp.cliCtx)
+		latestCheckpoint, err := util.GetChainLatestCheckpoint(cp.cliCtx, borChainID)
 		// event checkpoint is older than or equal to latest checkpoint
 		if err == nil && latestCheckpoint != nil && latestCheckpoint.EndBlock >= event.End.Uint64() {
 			cp.Logger.Debug("Checkpoint ack is already submitted", "start", event.Start, "end", event.End)
@@ -284,6 +336,7 @@ func (cp *CheckpointProcessor) sendCheckpointAckToHeimdall(eventName string, che
 			event.Root,
 			hmTypes.BytesToHeimdallHash(log.TxHash.Bytes()),
 			uint64(log.Index),
+			borChainID,
 		)
 
 		// return broadcast to heimdall
@@ -334,16 +387,15 @@ func (cp *CheckpointProcessor) handleCheckpointNoAck() {
 
 // nextExpectedCheckpoint - fetched contract checkpoint state and returns the next probable checkpoint that needs to be sent
 func (cp *CheckpointProcessor) nextExpectedCheckpoint(checkpointContext *CheckpointContext, latestChildBlock uint64) (*ContractCheckpoint, error) {
-	chainmanagerParams := checkpointContext.ChainmanagerParams
 	checkpointParams := checkpointContext.CheckpointParams
 
-	rootChainInstance, err := cp.contractConnector.GetRootChainInstance(chainmanagerParams.ChainParams.RootChainAddress.EthAddress())
+	rootChainInstance, err := cp.rootChain().GetRootChainInstance(checkpointContext.RootChainAddress())
 	if err != nil {
 		return nil, err
 	}
 
 	// fetch current header block from mainchain contract
-	_currentHeaderBlock, err := cp.contractConnector.CurrentHeaderBlock(rootChainInstance, checkpointParams.ChildBlockInterval)
+	_currentHeaderBlock, err := cp.rootChain().CurrentHeaderBlock(rootChainInstance, checkpointParams.ChildBlockInterval)
 	if err != nil {
 		cp.Logger.Error("Error while fetching current header block number from rootchain", "error", err)
 		return nil, err
@@ -353,7 +405,7 @@ func (cp *CheckpointProcessor) nextExpectedCheckpoint(checkpointContext *Checkpo
 	currentHeaderBlockNumber := big.NewInt(0).SetUint64(_currentHeaderBlock)
 
 	// get header info
-	_, currentStart, currentEnd, lastCheckpointTime, _, err := cp.contractConnector.GetHeaderInfo(currentHeaderBlockNumber.Uint64(), rootChainInstance, checkpointParams.ChildBlockInterval)
+	_, currentStart, currentEnd, lastCheckpointTime, _, err := cp.rootChain().GetHeaderInfo(currentHeaderBlockNumber.Uint64(), checkpointContext.RootChainAddress(), checkpointParams.ChildBlockInterval)
 	if err != nil {
 		cp.Logger.Error("Error while fetching current header block object from rootchain", "error", err)
 		return nil, err
@@ -429,8 +481,14 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToHeimdall(checkpointConte
 	// get checkpoint params
 	checkpointParams := checkpointContext.CheckpointParams
 
+	// bor chain caller
+	borChainCaller, err := cp.borChainCaller(checkpointContext)
+	if err != nil {
+		return err
+	}
+
 	// Get root hash
-	root, err := cp.contractConnector.GetRootHash(start, end, checkpointParams.MaxCheckpointLength)
+	root, err := borChainCaller.GetRootHash(start, end, checkpointParams.MaxCheckpointLength)
 	if err != nil {
 		return err
 	}
@@ -449,7 +507,10 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToHeimdall(checkpointConte
 		"accountRoot", accountRootHash,
 	)
 
-	chainParams := checkpointContext.ChainmanagerParams.ChainParams
+	borChainID := checkpointContext.BorChainID
+	if borChainID == "" {
+		borChainID = checkpointContext.ChainmanagerParams.ChainParams.BorChainID
+	}
 
 	// create and send checkpoint message
 	msg := checkpointTypes.NewMsgCheckpointBlock(
@@ -458,7 +519,7 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToHeimdall(checkpointConte
 		end,
 		hmTypes.BytesToHeimdallHash(root),
 		accountRootHash,
-		chainParams.BorChainID,
+		borChainID,
 	)
 
 	// return broadcast to heimdall
@@ -512,10 +573,8 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToRootchain(checkpointCont
 	}
 
 	if shouldSend {
-		// chain manager params
-		chainParams := checkpointContext.ChainmanagerParams.ChainParams
 		// root chain address
-		rootChainAddress := chainParams.RootChainAddress.EthAddress()
+		rootChainAddress := checkpointContext.RootChainAddress()
 		// root chain instance
 		rootChainInstance, err := cp.contractConnector.GetRootChainInstance(rootChainAddress)
 		if err != nil {
@@ -550,24 +609,22 @@ func (cp *CheckpointProcessor) fetchDividendAccountRoot() (accountroothash hmTyp
 
 // fetchLatestCheckpointTime - get latest checkpoint time from rootchain
 func (cp *CheckpointProcessor) getLatestCheckpointTime(checkpointContext *CheckpointContext) (int64, error) {
-	// get chain params
-	chainParams := checkpointContext.ChainmanagerParams.ChainParams
 	checkpointParams := checkpointContext.CheckpointParams
 
-	rootChainInstance, err := cp.contractConnector.GetRootChainInstance(chainParams.RootChainAddress.EthAddress())
+	rootChainInstance, err := cp.rootChain().GetRootChainInstance(checkpointContext.RootChainAddress())
 	if err != nil {
 		return 0, err
 	}
 
 	// fetch last header number
-	lastHeaderNumber, err := cp.contractConnector.CurrentHeaderBlock(rootChainInstance, checkpointParams.ChildBlockInterval)
+	lastHeaderNumber, err := cp.rootChain().CurrentHeaderBlock(rootChainInstance, checkpointParams.ChildBlockInterval)
 	if err != nil {
 		cp.Logger.Error("Error while fetching current header block number", "error", err)
 		return 0, err
 	}
 
 	// header block
-	_, _, _, createdAt, _, err := cp.contractConnector.GetHeaderInfo(lastHeaderNumber, rootChainInstance, checkpointParams.ChildBlockInterval)
+	_, _, _, createdAt, _, err := cp.rootChain().GetHeaderInfo(lastHeaderNumber, checkpointContext.RootChainAddress(), checkpointParams.ChildBlockInterval)
 	if err != nil {
 		cp.Logger.Error("Error while fetching header block object", "error", err)
 		return 0, err
@@ -645,16 +702,14 @@ func (cp *CheckpointProcessor) proposeCheckpointNoAck() (err error) {
 
 // shouldSendCheckpoint checks if checkpoint with given start,end should be sent to rootchain or not.
 func (cp *CheckpointProcessor) shouldSendCheckpoint(checkpointContext *CheckpointContext, start uint64, end uint64) (bool, error) {
-	chainmanagerParams := checkpointContext.ChainmanagerParams
-
-	rootChainInstance, err := cp.contractConnector.GetRootChainInstance(chainmanagerParams.ChainParams.RootChainAddress.EthAddress())
+	rootChainInstance, err := cp.rootChain().GetRootChainInstance(checkpointContext.RootChainAddress())
 	if err != nil {
 		cp.Logger.Error("Error while creating rootchain instance", "error", err)
 		return false, err
 	}
 
 	// current child block from contract
-	currentChildBlock, err := cp.contractConnector.GetLastChildBlock(rootChainInstance)
+	currentChildBlock, err := cp.rootChain().GetLastChildBlock(rootChainInstance)
 	if err != nil {
 		cp.Logger.Error("Error fetching current child block", "currentChildBlock", currentChildBlock, "error", err)
 		return false, err
@@ -680,6 +735,199 @@ func (cp *CheckpointProcessor) shouldSendCheckpoint(checkpointContext *Checkpoin
 	return shouldSend, nil
 }
 
+//
+// Standby
+//
+// Heimdall only accepts checkpoint from the current proposer, but rootchain accepts a confirmed
+// checkpoint from anyone holding its signatures. Next proposers in line stand by, in order, so
+// an offline proposer delays checkpoints by a grace period instead of the no-ack wait time.
+//
+
+// standbyDelay returns time this validator gives the proposer before acting in its place,
+// false if it is the proposer or not among the standbys
+func (cp *CheckpointProcessor) standbyDelay() (time.Duration, bool, error) {
+	count := helper.GetConfig().CheckpointStandbyCount
+	if count <= 0 {
+		return 0, false, nil
+	}
+
+	// first is current proposer
+	position, err := util.GetProposerPosition(cp.cliCtx, uint64(count)+1)
+	if err != nil {
+		cp.Logger.Error("Error fetching position in proposer list", "error", err)
+		return 0, false, err
+	}
+
+	if position <= 0 {
+		return 0, false, nil
+	}
+
+	return time.Duration(position) * helper.GetConfig().CheckpointStandbyGracePeriod, true, nil
+}
+
+// checkCheckpointProposedAsStandby - handles headerblock from maticchain on standby.
+// If a checkpoint has been due for longer than standby delay and proposer hasn't proposed it,
+// no-ack is proposed right away to rotate the proposer. Heimdall allows it once checkpoint buffer time passed.
+func (cp *CheckpointProcessor) checkCheckpointProposedAsStandby(headerNumber uint64) error {
+	delay, isStandby, err := cp.standbyDelay()
+	if err != nil || !isStandby {
+		cp.resetStandbyDue()
+		return err
+	}
+
+	checkpointContext, err := cp.getCheckpointContext()
+	if err != nil {
+		return err
+	}
+
+	confirmations := checkpointContext.ChainmanagerParams.MaticchainTxConfirmations
+	if headerNumber <= confirmations {
+		return nil
+	}
+
+	expectedCheckpointState, err := cp.nextExpectedCheckpoint(checkpointContext, headerNumber-confirmations)
+	if err != nil {
+		cp.Logger.Error("Error while calculate next expected checkpoint", "error", err)
+		return err
+	}
+	start := expectedCheckpointState.newStart
+	end := expectedCheckpointState.newEnd
+
+	// nothing due yet
+	if end == 0 || start >= end {
+		cp.resetStandbyDue()
+		return nil
+	}
+
+	// proposer has proposed, its rootchain submission is watched through checkpoint event
+	if bufferedCheckpoint, err := util.GetBufferedCheckpoint(cp.cliCtx); err == nil && bufferedCheckpoint != nil {
+		cp.resetStandbyDue()
+		return nil
+	}
+
+	dueSince := cp.markStandbyDue(start)
+	if time.Since(dueSince) < delay {
+		cp.Logger.Debug("Waiting for proposer to propose checkpoint", "start", start, "end", end, "dueSince", dueSince, "standbyDelay", delay)
+		return nil
+	}
+
+	lastNoAckTime := time.Unix(int64(cp.getLastNoAckTime()), 0)
+	if time.Since(lastNoAckTime) < checkpointContext.CheckpointParams.CheckpointBufferTime {
+		cp.Logger.Debug("Cannot send multiple no-ack in short time", "lastNoAckTime", lastNoAckTime)
+		return nil
+	}
+
+	cp.Logger.Info("Proposer didn't propose checkpoint within grace period, proposing no-ack as standby",
+		"start", start,
+		"end", end,
+		"dueSince", dueSince,
+		"standbyDelay", delay,
+	)
+
+	if err := cp.proposeCheckpointNoAck(); err != nil {
+		cp.Logger.Error("Error proposing Checkpoint No-Ack as standby", "error", err)
+		return err
+	}
+
+	cp.resetStandbyDue()
+	return nil
+}
+
+// markStandbyDue records checkpoint starting at start as due, it returns since when it is due
+func (cp *CheckpointProcessor) markStandbyDue(start uint64) time.Time {
+	cp.standbyMutex.Lock()
+	defer cp.standbyMutex.Unlock()
+
+	if cp.standbyDueSince.IsZero() || cp.standbyDueStart != start {
+		cp.standbyDueStart = start
+		cp.standbyDueSince = time.Now()
+	}
+
+	return cp.standbyDueSince
+}
+
+// resetStandbyDue clears due checkpoint
+func (cp *CheckpointProcessor) resetStandbyDue() {
+	cp.standbyMutex.Lock()
+	defer cp.standbyMutex.Unlock()
+
+	cp.standbyDueSince = time.Time{}
+}
+
+// queueCheckpointForStandby queues standby submission of confirmed checkpoint if this validator is a standby
+func (cp *CheckpointProcessor) queueCheckpointForStandby(eventBytes string, blockHeight int64) error {
+	delay, isStandby, err := cp.standbyDelay()
+	if err != nil {
+		return err
+	}
+
+	if !isStandby {
+		cp.Logger.Info("I am not the current proposer or a standby. Ignoring checkpoint confirmation")
+		return nil
+	}
+
+	signature := &tasks.Signature{
+		Name: "sendCheckpointToRootchainAsStandby",
+		Args: []tasks.Arg{
+			{
+				Type:  "string",
+				Value: eventBytes,
+			},
+			{
+				Type:  "int64",
+				Value: blockHeight,
+			},
+		},
+	}
+	queue.ApplyRetryPolicy(signature)
+
+	eta := time.Now().Add(delay)
+	signature.ETA = &eta
+
+	cp.Logger.Info("Standing by for checkpoint submission to rootchain", "blockHeight", blockHeight, "standbyDelay", delay)
+	if _, err := cp.queueConnector.Server.SendTask(signature); err != nil {
+		cp.Logger.Error("Error sending standby checkpoint task", "blockHeight", blockHeight, "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// sendCheckpointToRootchainAsStandby - submits confirmed checkpoint to rootchain once standby delay passed,
+// unless proposer or an earlier standby already did.
+func (cp *CheckpointProcessor) sendCheckpointToRootchainAsStandby(eventBytes string, blockHeight int64) error {
+	var event = sdk.StringEvent{}
+	if err := json.Unmarshal([]byte(eventBytes), &event); err != nil {
+		cp.Logger.Error("Error unmarshalling event from heimdall", "error", err)
+		return err
+	}
+
+	startBlock, endBlock, txHash, borChainID := parseCheckpointEvent(event)
+
+	checkpointContext, err := cp.getChainCheckpointContext(borChainID)
+	if err != nil {
+		return err
+	}
+
+	shouldSend, err := cp.shouldSendCheckpoint(checkpointContext, startBlock, endBlock)
+	if err != nil {
+		return err
+	}
+
+	if !shouldSend {
+		cp.Logger.Info("Checkpoint already submitted, standby not needed", "start", startBlock, "end", endBlock)
+		return nil
+	}
+
+	cp.Logger.Info("Proposer didn't submit checkpoint within grace period, submitting as standby", "start", startBlock, "end", endBlock)
+	if err := cp.createAndSendCheckpointToRootchain(checkpointContext, startBlock, endBlock, blockHeight, common.FromHex(txHash)); err != nil {
+		cp.Logger.Error("Error sending checkpoint to rootchain as standby", "error", err)
+		return err
+	}
+
+	return nil
+}
+
 // Stop stops all necessary go routines
 func (cp *CheckpointProcessor) Stop() {
 	// cancel No-Ack polling
@@ -690,7 +938,49 @@ func (cp *CheckpointProcessor) Stop() {
 // utils
 //
 
+// parseCheckpointEvent returns start, end, tx hash and bor chain id of checkpoint event
+func parseCheckpointEvent(event sdk.StringEvent) (startBlock uint64, endBlock uint64, txHash string, borChainID string) {
+	for _, attr := range event.Attributes {
+		if attr.Key == checkpointTypes.AttributeKeyStartBlock {
+			startBlock, _ = strconv.ParseUint(attr.Value, 10, 64)
+		}
+		if attr.Key == checkpointTypes.AttributeKeyEndBlock {
+			endBlock, _ = strconv.ParseUint(attr.Value, 10, 64)
+		}
+		if attr.Key == hmTypes.AttributeKeyTxHash {
+			txHash = attr.Value
+		}
+		if attr.Key == checkpointTypes.AttributeKeyBorChainID {
+			borChainID = attr.Value
+		}
+	}
+
+	return startBlock, endBlock, txHash, borChainID
+}
+
+// borChainCaller returns contract caller reading blocks of the checkpointed bor chain
+func (cp *CheckpointProcessor) borChainCaller(checkpointContext *CheckpointContext) (helper.IContractCaller, error) {
+	if checkpointContext.BorChainID == "" {
+		return &cp.contractConnector, nil
+	}
+
+	return cp.contractConnector.GetBorChainCaller(checkpointContext.BorChainID)
+}
+
+// rootChain returns reader of rootchain checkpoint state
+func (cp *CheckpointProcessor) rootChain() checkpointReader {
+	if cp.rootChainReader != nil {
+		return cp.rootChainReader
+	}
+	return &cp.contractConnector
+}
+
 func (cp *CheckpointProcessor) getCheckpointContext() (*CheckpointContext, error) {
+	return cp.getChainCheckpointContext("")
+}
+
+// getChainCheckpointContext returns checkpoint context of the bor chain, default chain id is kept empty
+func (cp *CheckpointProcessor) getChainCheckpointContext(borChainID string) (*CheckpointContext, error) {
 	chainmanagerParams, err := util.GetChainmanagerParams(cp.cliCtx)
 	if err != nil {
 		cp.Logger.Error("Error while fetching chain manager params", "error", err)
@@ -703,8 +993,17 @@ func (cp *CheckpointProcessor) getCheckpointContext() (*CheckpointContext, error
 		return nil, err
 	}
 
+	chainParams := chainmanagerParams.ChainParams
+	if chainParams.IsDefaultBorChain(borChainID) {
+		borChainID = ""
+	} else if !chainParams.IsAdditionalBorChain(borChainID) {
+		cp.Logger.Error("Unknown bor chain", "borChainID", borChainID)
+		return nil, fmt.Errorf("unknown bor chain %v", borChainID)
+	}
+
 	return &CheckpointContext{
 		ChainmanagerParams: chainmanagerParams,
 		CheckpointParams:   checkpointParams,
+		BorChainID:         borChainID,
 	}, nil
 }
@@ -0,0 +1,344 @@
+package processor
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/maticnetwork/bor/common"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/bridge/setu/broadcaster"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/contracts/rootchain"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const testStandbyGracePeriod = 200 * time.Millisecond
+
+// mockHeimdall serves heimdall rest endpoints read by checkpoint standby
+type mockHeimdall struct {
+	cdc       *codec.Codec
+	mu        sync.Mutex
+	proposers []hmTypes.Validator
+	buffered  *hmTypes.Checkpoint
+	lastNoAck uint64
+}
+
+func (m *mockHeimdall) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var result interface{}
+	switch {
+	case r.URL.Path == util.ChainManagerParamsURL:
+		result = chainmanagerTypes.DefaultParams()
+	case r.URL.Path == util.CheckpointParamsURL:
+		result = checkpointTypes.DefaultParams()
+	case r.URL.Path == util.BufferedCheckpointURL && m.buffered != nil:
+		result = m.buffered
+	case r.URL.Path == util.LastNoAckURL:
+		result = Result{Result: m.lastNoAck}
+	case r.URL.Path == fmt.Sprintf(util.ProposersURL, helper.GetConfig().CheckpointStandbyCount+1):
+		result = m.proposers
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	response, err := m.cdc.MarshalJSON(rest.ResponseWithHeight{Height: 1, Result: data})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	_, _ = w.Write(response)
+}
+
+// setProposers puts validator with our signer at position, -1 leaves it out
+func (m *mockHeimdall) setProposers(position int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.proposers = nil
+	for i := 0; i < 3; i++ {
+		signer := hmTypes.BytesToHeimdallAddress(secp256k1.GenPrivKey().PubKey().Address().Bytes())
+		if i == position {
+			signer = hmTypes.BytesToHeimdallAddress(helper.GetAddress())
+		}
+		m.proposers = append(m.proposers, hmTypes.Validator{ID: hmTypes.NewValidatorID(uint64(i + 1)), Signer: signer})
+	}
+}
+
+// mockCheckpointReader serves checkpoint state of rootchain
+type mockCheckpointReader struct {
+	currentEnd     uint64
+	lastCheckpoint time.Time
+	lastChildBlock uint64
+}
+
+func (m *mockCheckpointReader) GetRootChainInstance(rootchainAddress common.Address) (*rootchain.Rootchain, error) {
+	return nil, nil
+}
+
+func (m *mockCheckpointReader) CurrentHeaderBlock(rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (uint64, error) {
+	return 1, nil
+}
+
+func (m *mockCheckpointReader) GetHeaderInfo(number uint64, rootChainAddress common.Address, childBlockInterval uint64) (common.Hash, uint64, uint64, uint64, hmTypes.HeimdallAddress, error) {
+	return common.Hash{}, 0, m.currentEnd, uint64(m.lastCheckpoint.Unix()), hmTypes.HeimdallAddress{}, nil
+}
+
+func (m *mockCheckpointReader) GetLastChildBlock(rootChainInstance *rootchain.Rootchain) (uint64, error) {
+	return m.lastChildBlock, nil
+}
+
+type standbyTest struct {
+	cp       *CheckpointProcessor
+	heimdall *mockHeimdall
+	chain    *mockCheckpointReader
+	recorder *broadcaster.TxRecorder
+}
+
+func newStandbyTest(t *testing.T, standbyCount int) *standbyTest {
+	signer := helper.GetSigner()
+	localSigner, err := helper.NewLocalSigner(secp256k1.GenPrivKey())
+	require.NoError(t, err)
+	helper.SetSigner(localSigner)
+
+	cdc := app.MakeCodec()
+	heimdall := &mockHeimdall{cdc: cdc}
+	server := httptest.NewServer(heimdall)
+
+	conf := helper.GetConfig()
+	t.Cleanup(func() {
+		server.Close()
+		helper.SetTestConfig(conf)
+		if signer != nil {
+			helper.SetSigner(signer)
+		}
+	})
+
+	testConf := conf
+	testConf.HeimdallServerURL = server.URL
+	testConf.CheckpointStandbyCount = standbyCount
+	testConf.CheckpointStandbyGracePeriod = testStandbyGracePeriod
+	helper.SetTestConfig(testConf)
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	recorder := broadcaster.NewTxRecorder(db)
+	txBroadcaster := &broadcaster.TxBroadcaster{}
+	txBroadcaster.SetRecorder(recorder)
+
+	// checkpoint of blocks 256-767 is due
+	chain := &mockCheckpointReader{currentEnd: 255, lastCheckpoint: time.Now(), lastChildBlock: 255}
+
+	cp := NewCheckpointProcessor(nil)
+	cp.BaseProcessor = BaseProcessor{
+		Logger:         log.NewNopLogger(),
+		cliCtx:         cliContext.CLIContext{Codec: cdc},
+		queueConnector: queue.NewEmbeddedQueueConnector(db),
+		txBroadcaster:  txBroadcaster,
+	}
+	cp.rootChainReader = chain
+
+	return &standbyTest{cp: cp, heimdall: heimdall, chain: chain, recorder: recorder}
+}
+
+// noAcks returns number of no-acks proposed
+func (st *standbyTest) noAcks(t *testing.T) int {
+	recorded, err := st.recorder.List()
+	require.NoError(t, err)
+
+	count := 0
+	for _, tx := range recorded {
+		if tx.Kind == (checkpointTypes.MsgCheckpointNoAck{}).Type() {
+			count++
+		}
+	}
+	return count
+}
+
+func checkpointEventBytes(t *testing.T, start uint64, end uint64) string {
+	event := sdk.StringEvent{
+		Type: checkpointTypes.EventTypeCheckpoint,
+		Attributes: []sdk.Attribute{
+			{Key: checkpointTypes.AttributeKeyStartBlock, Value: strconv.FormatUint(start, 10)},
+			{Key: checkpointTypes.AttributeKeyEndBlock, Value: strconv.FormatUint(end, 10)},
+		},
+	}
+
+	eventBytes, err := json.Marshal(event)
+	require.NoError(t, err)
+	return string(eventBytes)
+}
+
+func TestStandbyDelay(t *testing.T) {
+	testCases := []struct {
+		name         string
+		standbyCount int
+		position     int
+		wantStandby  bool
+		wantDelay    time.Duration
+	}{
+		{name: "proposer", standbyCount: 2, position: 0},
+		{name: "first standby", standbyCount: 2, position: 1, wantStandby: true, wantDelay: testStandbyGracePeriod},
+		{name: "second standby", standbyCount: 2, position: 2, wantStandby: true, wantDelay: 2 * testStandbyGracePeriod},
+		{name: "not in proposer list", standbyCount: 2, position: -1},
+		{name: "standby disabled", standbyCount: 0, position: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			st := newStandbyTest(t, tc.standbyCount)
+			st.heimdall.setProposers(tc.position)
+
+			delay, isStandby, err := st.cp.standbyDelay()
+			require.NoError(t, err)
+			require.Equal(t, tc.wantStandby, isStandby)
+			require.Equal(t, tc.wantDelay, delay)
+		})
+	}
+}
+
+func TestGetProposerPosition(t *testing.T) {
+	st := newStandbyTest(t, 2)
+
+	for _, position := range []int{0, 1, 2, -1} {
+		st.heimdall.setProposers(position)
+
+		got, err := util.GetProposerPosition(st.cp.cliCtx, 3)
+		require.NoError(t, err)
+		require.Equal(t, position, got)
+	}
+
+	// heimdall rejects request
+	_, err := util.GetProposerPosition(st.cp.cliCtx, 4)
+	require.Error(t, err)
+}
+
+func TestQueueCheckpointForStandby(t *testing.T) {
+	st := newStandbyTest(t, 2)
+	eventBytes := checkpointEventBytes(t, 256, 767)
+
+	// proposer submits checkpoint itself
+	st.heimdall.setProposers(0)
+	require.NoError(t, st.cp.queueCheckpointForStandby(eventBytes, 10))
+
+	// standbys queue submission, later in proposer list waits longer
+	st.heimdall.setProposers(2)
+	require.NoError(t, st.cp.queueCheckpointForStandby(eventBytes, 11))
+	st.heimdall.setProposers(1)
+	require.NoError(t, st.cp.queueCheckpointForStandby(eventBytes, 12))
+
+	delayed, err := st.cp.queueConnector.Server.GetBroker().(*queue.EmbeddedBroker).GetDelayedTasks()
+	require.NoError(t, err)
+	require.Len(t, delayed, 2)
+
+	etas := make(map[string]time.Time)
+	for _, signature := range delayed {
+		require.Equal(t, "sendCheckpointToRootchainAsStandby", signature.Name)
+		require.Equal(t, eventBytes, signature.Args[0].Value)
+		etas[fmt.Sprint(signature.Args[1].Value)] = *signature.ETA
+	}
+	require.True(t, etas["12"].Before(etas["11"]))
+	require.InDelta(t, float64(testStandbyGracePeriod), float64(etas["11"].Sub(etas["12"])), float64(testStandbyGracePeriod/2))
+}
+
+func TestCheckCheckpointProposedAsStandby(t *testing.T) {
+	st := newStandbyTest(t, 2)
+	st.heimdall.setProposers(1)
+
+	// next checkpoint ends at 767, seen after maticchain confirmations
+	headerNumber := 767 + chainmanagerTypes.DefaultMaticchainTxConfirmations
+
+	// proposer is given grace period
+	require.NoError(t, st.cp.checkCheckpointProposedAsStandby(headerNumber))
+	require.Equal(t, 0, st.noAcks(t))
+
+	// proposer proposed in time
+	st.heimdall.buffered = &hmTypes.Checkpoint{StartBlock: 256, EndBlock: 767}
+	time.Sleep(testStandbyGracePeriod)
+	require.NoError(t, st.cp.checkCheckpointProposedAsStandby(headerNumber))
+	require.Equal(t, 0, st.noAcks(t))
+
+	// proposer didn't propose, due time restarts once buffer clears
+	st.heimdall.buffered = nil
+	require.NoError(t, st.cp.checkCheckpointProposedAsStandby(headerNumber))
+	require.Equal(t, 0, st.noAcks(t))
+
+	time.Sleep(testStandbyGracePeriod)
+	require.NoError(t, st.cp.checkCheckpointProposedAsStandby(headerNumber))
+	require.Equal(t, 1, st.noAcks(t))
+
+	// no-ack of another validator within buffer time
+	st.heimdall.lastNoAck = uint64(time.Now().Unix())
+	require.NoError(t, st.cp.checkCheckpointProposedAsStandby(headerNumber))
+	time.Sleep(testStandbyGracePeriod)
+	require.NoError(t, st.cp.checkCheckpointProposedAsStandby(headerNumber))
+	require.Equal(t, 1, st.noAcks(t))
+}
+
+func TestCheckCheckpointProposedAsProposer(t *testing.T) {
+	st := newStandbyTest(t, 2)
+	st.heimdall.setProposers(0)
+
+	headerNumber := 767 + chainmanagerTypes.DefaultMaticchainTxConfirmations
+	require.NoError(t, st.cp.checkCheckpointProposedAsStandby(headerNumber))
+	time.Sleep(testStandbyGracePeriod)
+	require.NoError(t, st.cp.checkCheckpointProposedAsStandby(headerNumber))
+	require.Equal(t, 0, st.noAcks(t))
+}
+
+func TestSendCheckpointToRootchainAsStandby(t *testing.T) {
+	testCases := []struct {
+		name           string
+		lastChildBlock uint64
+		wantSubmit     bool
+	}{
+		{name: "not submitted", lastChildBlock: 255, wantSubmit: true},
+		{name: "submitted by proposer", lastChildBlock: 767},
+		{name: "later checkpoint submitted", lastChildBlock: 1023},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			st := newStandbyTest(t, 2)
+			st.heimdall.setProposers(1)
+			st.chain.lastChildBlock = tc.lastChildBlock
+
+			// submission starts with querying checkpoint tx proof, which fails without tendermint node
+			err := st.cp.sendCheckpointToRootchainAsStandby(checkpointEventBytes(t, 256, 767), 10)
+			if tc.wantSubmit {
+				require.EqualError(t, err, "no RPC client defined")
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1,12 +1,22 @@
 package processor
 
 import (
+	"context"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/RichardKnop/machinery/v1/tasks"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/bor/accounts/abi"
 	"github.com/maticnetwork/bor/core/types"
+	levelUtil "github.com/syndtr/goleveldb/leveldb/util"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/dedup"
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
@@ -15,42 +25,81 @@ import (
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
+// prefix of state syncs waiting to be included in heimdall, stored in bridge db by state id
+const pendingStateSyncPrefix = "clerk-pending-"
+
 // ClerkContext for bridge
 type ClerkContext struct {
 	ChainmanagerParams *chainmanagerTypes.Params
 }
 
+// pendingStateSync is a StateSynced log waiting to be included in heimdall
+type pendingStateSync struct {
+	ID        uint64 `json:"id"`
+	EventName string `json:"eventName"`
+	LogBytes  string `json:"logBytes"`
+
+	msg sdk.Msg // event record built from log
+}
+
+// stateSyncTx is a heimdall tx of a state sync sent but not included yet
+type stateSyncTx struct {
+	id     uint64
+	sentAt time.Time
+}
+
 // ClerkProcessor - sync state/deposit events
 type ClerkProcessor struct {
 	BaseProcessor
 	stateSenderAbi *abi.ABI
+
+	// signals new pending state syncs to submit loop
+	wakeup chan struct{}
+
+	// state of submit loop
+	inFlight    []*stateSyncTx
+	budgetBlock int64     // heimdall height of current tx budget
+	budgetUsed  int       // txs sent at budgetBlock
+	gapID       uint64    // missing predecessor pending state syncs wait for
+	gapSince    time.Time // when waiting for gapID started
+
+	cancelClerkService context.CancelFunc
 }
 
 // NewClerkProcessor - add statesender abi to clerk processor
 func NewClerkProcessor(stateSenderAbi *abi.ABI) *ClerkProcessor {
 	clerkProcessor := &ClerkProcessor{
 		stateSenderAbi: stateSenderAbi,
+		wakeup:         make(chan struct{}, 1),
 	}
 	return clerkProcessor
 }
 
-// Start starts new block subscription
+// Start starts submitting pending state syncs, including the ones left by previous run
 func (cp *ClerkProcessor) Start() error {
 	cp.Logger.Info("Starting")
+
+	// create cancellable context
+	clerkCtx, cancelClerkService := context.WithCancel(context.Background())
+	cp.cancelClerkService = cancelClerkService
+
+	cp.Logger.Info("Start submitting state syncs", "pollInterval", helper.GetConfig().ClerkPollInterval)
+	go cp.startSubmitting(clerkCtx, helper.GetConfig().ClerkPollInterval)
 	return nil
 }
 
 // RegisterTasks - Registers clerk related tasks with machinery
 func (cp *ClerkProcessor) RegisterTasks() {
 	cp.Logger.Info("Registering clerk tasks")
-	if err := cp.queueConnector.Server.RegisterTask("sendStateSyncedToHeimdall", cp.sendStateSyncedToHeimdall); err != nil {
+	if err := cp.queueConnector.RegisterTask("sendStateSyncedToHeimdall", cp.sendStateSyncedToHeimdall); err != nil {
 		cp.Logger.Error("RegisterTasks | sendStateSyncedToHeimdall", "error", err)
 	}
 }
 
 // HandleStateSyncEvent - handle state sync event from rootchain
-// 1. check if this deposit event has to be broadcasted to heimdall
-// 2. create and broadcast  record transaction to heimdall
+// 1. validate the event, quarantining the ones heimdall will never accept
+// 2. store the event as pending state sync
+// 3. submit loop sends pending state syncs to heimdall in state id order
 func (cp *ClerkProcessor) sendStateSyncedToHeimdall(eventName string, logBytes string) error {
 	var vLog = types.Log{}
 	if err := json.Unmarshal([]byte(logBytes), &vLog); err != nil {
@@ -58,77 +107,341 @@ func (cp *ClerkProcessor) sendStateSyncedToHeimdall(eventName string, logBytes s
 		return err
 	}
 
+	event := new(statesender.StatesenderStateSynced)
+	if err := helper.UnpackLog(cp.stateSenderAbi, event, eventName, &vLog); err != nil {
+		cp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
+		return nil
+	}
+
 	clerkContext, err := cp.getClerkContext()
 	if err != nil {
 		return err
 	}
 
+	cp.Logger.Debug(
+		"⬜ New event found",
+		"event", eventName,
+		"id", event.Id,
+		"contract", event.ContractAddress,
+		"data", hex.EncodeToString(event.Data),
+		"borChainId", clerkContext.ChainmanagerParams.ChainParams.BorChainID,
+		"txHash", hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+		"logIndex", uint64(vLog.Index),
+		"blockNumber", vLog.BlockNumber,
+	)
+
+	// pre-flight validation, so events heimdall will never accept are not retried
+	switch class, reason := classifyStateSync(vLog, event, clerkContext.ChainmanagerParams.ChainParams, util.GetBlockHeight(cp.cliCtx)); class {
+	case stateSyncTransient:
+		cp.Logger.Info("Unable to validate state sync now, retrying in sometime", "id", event.Id, "reason", reason, "retry delay", util.RetryStateSyncTaskDelay)
+		return tasks.NewErrRetryTaskLater(reason, util.RetryStateSyncTaskDelay)
+	case stateSyncInvalid:
+		return cp.quarantineStateSync(eventName, logBytes, vLog, event, reason)
+	}
+
+	if state, _ := cp.dedupIndex.Lookup(dedup.NewRef(util.ClerkEvent, vLog.TxHash.String(), uint64(vLog.Index))); state == dedup.StateProcessed {
+		cp.Logger.Info("Ignoring task to send state sync to heimdall as already processed", "id", event.Id)
+		return nil
+	}
+
+	pending := pendingStateSync{
+		ID:        event.Id.Uint64(),
+		EventName: eventName,
+		LogBytes:  logBytes,
+	}
+	value, err := json.Marshal(pending)
+	if err != nil {
+		return err
+	}
+
+	if err := cp.storageClient.Put(pendingStateSyncKey(pending.ID), value, nil); err != nil {
+		cp.Logger.Error("Error while storing pending state sync", "id", pending.ID, "error", err)
+		return err
+	}
+
+	// wake up submit loop
+	select {
+	case cp.wakeup <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// startSubmitting submits pending state syncs on every poll interval and whenever new ones arrive
+func (cp *ClerkProcessor) startSubmitting(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	// stop ticker when everything done
+	defer ticker.Stop()
+	util.StartHeartbeat(cp.name, "submitting", util.HeartbeatTimeout(interval))
+
+	for {
+		cp.submitPendingStateSyncs()
+		util.Heartbeat(cp.name, "submitting")
+
+		select {
+		case <-ticker.C:
+		case <-cp.wakeup:
+		case <-ctx.Done():
+			cp.Logger.Info("Stopped submitting state syncs")
+			util.StopHeartbeat(cp.name, "submitting")
+			return
+		}
+	}
+}
+
+// submitPendingStateSyncs sends a window of pending state syncs to heimdall, one event record per tx.
+// State syncs are sent in state id order and only after their predecessor is in heimdall or sent, or `ClerkGapTimeout` passed,
+// with at most `ClerkConcurrency` txs waiting for inclusion and `ClerkTxsPerBlock` txs sent per heimdall block.
+func (cp *ClerkProcessor) submitPendingStateSyncs() {
+	config := helper.GetConfig()
+
+	pending, err := cp.loadPendingStateSyncs(config.ClerkConcurrency)
+	if err != nil {
+		cp.Logger.Error("Error while loading pending state syncs", "error", err)
+		return
+	}
+
+	if len(pending) == 0 {
+		cp.inFlight = nil
+		return
+	}
+
+	// quarantined state ids are never sent, successors don't wait for them
+	skipped := cp.quarantinedPredecessors(pending)
+
+	// fetch status of whole window and of predecessors at once
+	included, height, err := cp.getRecordStatus(statusRecordIDs(pending, skipped))
+	if err != nil {
+		cp.Logger.Error("Error while fetching state sync status", "error", err)
+		return
+	}
+
+	// forget state syncs already in heimdall
+	var remaining []*pendingStateSync
+	for _, p := range pending {
+		if !included[p.ID] {
+			remaining = append(remaining, p)
+			continue
+		}
+
+		cp.Logger.Debug("State sync included in heimdall", "id", p.ID)
+		cp.deletePendingStateSync(p.ID)
+	}
+
+	sent := cp.updateInFlight(included, config.HeimdallTxConfirmTimeout)
+	if len(remaining) == 0 {
+		return
+	}
+
+	// tx budget is per heimdall block
+	if height != cp.budgetBlock {
+		cp.budgetBlock = height
+		cp.budgetUsed = 0
+	}
+
+	slots := config.ClerkTxsPerBlock - cp.budgetUsed
+	if free := config.ClerkConcurrency - len(cp.inFlight); free < slots {
+		slots = free
+	}
+
+	if slots <= 0 {
+		cp.Logger.Debug("State sync tx budget used, waiting", "height", height, "inFlight", len(cp.inFlight))
+		return
+	}
+
+	clerkContext, err := cp.getClerkContext()
+	if err != nil {
+		return
+	}
+
+	// build event records, dropping logs which can't be parsed. State syncs were validated when received,
+	// they aren't quarantined again as chain params drop deprecated contract addresses later.
 	chainParams := clerkContext.ChainmanagerParams.ChainParams
 
-	event := new(statesender.StatesenderStateSynced)
-	if err := helper.UnpackLog(cp.stateSenderAbi, event, eventName, &vLog); err != nil {
-		cp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
-	} else {
-		if isOld, _ := cp.isOldTx(cp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index), util.ClerkEvent); isOld {
-			cp.Logger.Info("Ignoring task to send deposit to heimdall as already processed",
-				"event", eventName,
-				"id", event.Id,
-				"contract", event.ContractAddress,
-				"data", hex.EncodeToString(event.Data),
-				"borChainId", chainParams.BorChainID,
-				"txHash", hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
-				"logIndex", uint64(vLog.Index),
-				"blockNumber", vLog.BlockNumber,
-			)
-			return nil
-		}
-
-		cp.Logger.Debug(
-			"⬜ New event found",
-			"event", eventName,
-			"id", event.Id,
-			"contract", event.ContractAddress,
-			"data", hex.EncodeToString(event.Data),
-			"borChainId", chainParams.BorChainID,
-			"txHash", hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
-			"logIndex", uint64(vLog.Index),
-			"blockNumber", vLog.BlockNumber,
-		)
-
-		if util.GetBlockHeight(cp.cliCtx) > helper.SpanOverrideBlockHeight && len(event.Data) > helper.MaxStateSyncSize {
-			cp.Logger.Info(`Data is too large to process, Resetting to ""`, "data", hex.EncodeToString(event.Data))
-			event.Data = hmTypes.HexToHexBytes("")
-		} else if len(event.Data) > helper.LegacyMaxStateSyncSize {
-			cp.Logger.Info(`Data is too large to process, Resetting to ""`, "data", hex.EncodeToString(event.Data))
+	var unsent []*pendingStateSync
+	for _, p := range remaining {
+		if sent[p.ID] {
+			continue
+		}
+
+		vLog, event, err := cp.decodeStateSync(p)
+		if err != nil {
+			cp.Logger.Error("Error while decoding state sync, dropping it", "id", p.ID, "error", err)
+			cp.deletePendingStateSync(p.ID)
+			continue
+		}
+
+		// heimdall accepts oversized state syncs with empty data
+		if limit := maxStateSyncSize(height); len(event.Data) > limit {
+			cp.Logger.Info(`Data is too large to process, Resetting to ""`, "id", p.ID, "dataSize", len(event.Data), "limit", limit)
 			event.Data = hmTypes.HexToHexBytes("")
 		}
 
-		msg := clerkTypes.NewMsgEventRecord(
-			hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
-			hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
-			uint64(vLog.Index),
-			vLog.BlockNumber,
-			event.Id.Uint64(),
-			hmTypes.BytesToHeimdallAddress(event.ContractAddress.Bytes()),
-			event.Data,
-			chainParams.BorChainID,
-		)
+		p.msg = newEventRecord(vLog, event, chainParams.BorChainID)
+		unsent = append(unsent, p)
+	}
 
-		// Check if we have the same transaction in mempool or not
-		// Don't drop the transaction. Keep retrying after `util.RetryStateSyncTaskDelay = 24 seconds`,
-		// until the transaction in mempool is processed or cancelled.
-		if inMempool, _ := cp.checkTxAgainstMempool(msg); inMempool {
-			cp.Logger.Info("Similar transaction already in mempool, retrying in sometime", "event", eventName, "retry delay", util.RetryStateSyncTaskDelay)
-			return tasks.NewErrRetryTaskLater("transaction already in mempool", util.RetryStateSyncTaskDelay)
+	// state syncs in dedup index are processed or sent already, mempool is scanned only for the others
+	var unknown []*pendingStateSync
+	for _, p := range unsent {
+		if ref, ok := dedup.MsgRef(p.msg); ok {
+			if state, found := cp.dedupIndex.Lookup(ref); found {
+				cp.Logger.Debug("State sync found in dedup index", "id", p.ID, "state", state)
+				sent[p.ID] = true
+				continue
+			}
 		}
 
-		// return broadcast to heimdall
-		if err := cp.txBroadcaster.BroadcastToHeimdall(msg); err != nil {
-			cp.Logger.Error("Error while broadcasting clerk Record to heimdall", "error", err)
-			return err
+		unknown = append(unknown, p)
+	}
+
+	// state syncs in mempool are being sent already, by another validator or before restart
+	var mempoolMsgs []sdk.Msg
+	if len(unknown) > 0 {
+		if mempoolMsgs, err = cp.getMempoolMsgs(); err != nil {
+			return
 		}
 	}
-	return nil
+
+	var toSend []*pendingStateSync
+	for _, p := range unknown {
+		inMempool := false
+		for _, txMsg := range mempoolMsgs {
+			if isSameEventRecord(txMsg, p.msg) {
+				inMempool = true
+				break
+			}
+		}
+
+		if !inMempool {
+			toSend = append(toSend, p)
+			continue
+		}
+
+		cp.Logger.Info("Similar transaction already in mempool", "id", p.ID)
+		sent[p.ID] = true
+	}
+
+	// successors wait for a missing state id up to gap timeout, quarantined ones are skipped
+	ready := cp.selectStateSyncsToSend(toSend, included, sent, skipped, config.ClerkGapTimeout)
+
+	// send ready state syncs in order, one tx each
+	for ; slots > 0 && len(ready) > 0; slots-- {
+		p := ready[0]
+		ready = ready[1:]
+
+		if err := cp.txBroadcaster.BroadcastToHeimdall(p.msg); err != nil {
+			cp.Logger.Error("Error while broadcasting clerk record to heimdall", "id", p.ID, "error", err)
+			return
+		}
+
+		cp.markInFlight(p.msg)
+
+		cp.Logger.Info("Sent state sync to heimdall", "id", p.ID)
+		cp.inFlight = append(cp.inFlight, &stateSyncTx{id: p.ID, sentAt: time.Now()})
+		cp.budgetUsed++
+	}
+}
+
+// updateInFlight forgets sent txs whose state sync is included, or which are not included within twice
+// the broadcaster confirm timeout, so their state syncs are sent again. It returns ids of state syncs still in flight.
+func (cp *ClerkProcessor) updateInFlight(included map[uint64]bool, confirmTimeout time.Duration) map[uint64]bool {
+	sent := make(map[uint64]bool)
+
+	var inFlight []*stateSyncTx
+	for _, tx := range cp.inFlight {
+		if included[tx.id] {
+			continue
+		}
+
+		if time.Since(tx.sentAt) >= 2*confirmTimeout {
+			cp.Logger.Info("State sync not included in time, sending again", "id", tx.id)
+			continue
+		}
+
+		sent[tx.id] = true
+		inFlight = append(inFlight, tx)
+	}
+
+	cp.inFlight = inFlight
+	return sent
+}
+
+// selectStateSyncsToSend returns ready state syncs, treating missing predecessor they waited for longer than gap timeout as included
+func (cp *ClerkProcessor) selectStateSyncsToSend(toSend []*pendingStateSync, included map[uint64]bool, sent map[uint64]bool, skipped map[uint64]bool, gapTimeout time.Duration) []*pendingStateSync {
+	for {
+		ready := selectReadyStateSyncs(toSend, included, sent, skipped)
+		if len(ready) == len(toSend) {
+			cp.gapID = 0
+			metrics.ClerkGapWaitSeconds.Set(0)
+			return ready
+		}
+
+		missing := predecessorID(toSend[len(ready)].ID, skipped)
+		if cp.gapID != missing {
+			cp.gapID = missing
+			cp.gapSince = time.Now()
+		}
+
+		waited := time.Since(cp.gapSince)
+		metrics.ClerkGapWaitSeconds.Set(waited.Seconds())
+		if waited < gapTimeout {
+			cp.Logger.Info("Holding state syncs until their predecessor is in heimdall", "missing", missing, "waited", waited, "ready", len(ready), "waiting", len(toSend)-len(ready))
+			return ready
+		}
+
+		cp.Logger.Error("Predecessor not in heimdall within gap timeout, sending state syncs without it", "missing", missing, "waited", waited, "gapTimeout", gapTimeout)
+		metrics.ClerkSkippedGaps.Inc()
+		included[missing] = true // successors go on as if it was in heimdall
+	}
+}
+
+// selectReadyStateSyncs returns state syncs not sent yet which can be sent in order, pending is sorted by state id.
+// State sync is ready when its predecessor is included, sent or ready, so no gap is created in heimdall.
+// Skipped state ids are never sent, the state sync before them is the predecessor.
+func selectReadyStateSyncs(pending []*pendingStateSync, included map[uint64]bool, sent map[uint64]bool, skipped map[uint64]bool) []*pendingStateSync {
+	var ready []*pendingStateSync
+	for _, p := range pending {
+		if sent[p.ID] {
+			continue
+		}
+
+		prev := predecessorID(p.ID, skipped)
+		if prev > 0 && !included[prev] && !sent[prev] && (len(ready) == 0 || ready[len(ready)-1].ID != prev) {
+			break
+		}
+
+		ready = append(ready, p)
+	}
+
+	return ready
+}
+
+// predecessorID returns closest state id before given one which is not skipped, 0 if there is none
+func predecessorID(id uint64, skipped map[uint64]bool) uint64 {
+	prev := id - 1
+	for prev > 0 && skipped[prev] {
+		prev--
+	}
+	return prev
+}
+
+// statusRecordIDs returns ids of pending state syncs and of their predecessors
+func statusRecordIDs(pending []*pendingStateSync, skipped map[uint64]bool) []uint64 {
+	seen := make(map[uint64]bool)
+
+	var ids []uint64
+	for _, p := range pending {
+		for _, id := range []uint64{predecessorID(p.ID, skipped), p.ID} {
+			if id > 0 && !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	return ids
 }
 
 //
@@ -146,3 +459,166 @@ func (cp *ClerkProcessor) getClerkContext() (*ClerkContext, error) {
 		ChainmanagerParams: chainmanagerParams,
 	}, nil
 }
+
+// decodeStateSync parses log of pending state sync
+func (cp *ClerkProcessor) decodeStateSync(p *pendingStateSync) (types.Log, *statesender.StatesenderStateSynced, error) {
+	var vLog = types.Log{}
+	if err := json.Unmarshal([]byte(p.LogBytes), &vLog); err != nil {
+		return vLog, nil, err
+	}
+
+	event := new(statesender.StatesenderStateSynced)
+	if err := helper.UnpackLog(cp.stateSenderAbi, event, p.EventName, &vLog); err != nil {
+		return vLog, nil, err
+	}
+
+	return vLog, event, nil
+}
+
+// newEventRecord creates event record msg of validated state sync
+func newEventRecord(vLog types.Log, event *statesender.StatesenderStateSynced, borChainID string) sdk.Msg {
+	return clerkTypes.NewMsgEventRecord(
+		hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+		hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+		uint64(vLog.Index),
+		vLog.BlockNumber,
+		event.Id.Uint64(),
+		hmTypes.BytesToHeimdallAddress(event.ContractAddress.Bytes()),
+		event.Data,
+		borChainID,
+	)
+}
+
+// quarantineStateSync stores state sync heimdall will never accept in quarantine instead of retrying it
+func (cp *ClerkProcessor) quarantineStateSync(eventName string, logBytes string, vLog types.Log, event *statesender.StatesenderStateSynced, reason string) error {
+	cp.Logger.Error("Quarantining invalid state sync",
+		"id", event.Id,
+		"reason", reason,
+		"txHash", hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+		"logIndex", uint64(vLog.Index),
+		"blockNumber", vLog.BlockNumber,
+	)
+
+	err := cp.quarantineStore().Put(&QuarantinedStateSync{
+		ID:            event.Id.Uint64(),
+		TxHash:        vLog.TxHash.Hex(),
+		LogIndex:      uint64(vLog.Index),
+		BlockNumber:   vLog.BlockNumber,
+		Contract:      vLog.Address.Hex(),
+		DataSize:      len(event.Data),
+		Reason:        reason,
+		EventName:     eventName,
+		LogBytes:      logBytes,
+		QuarantinedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		cp.Logger.Error("Error while storing quarantined state sync", "id", event.Id, "error", err)
+		return err
+	}
+
+	metrics.ClerkQuarantinedStateSyncs.Inc()
+	return nil
+}
+
+func (cp *ClerkProcessor) quarantineStore() *QuarantineStore {
+	return NewQuarantineStore(cp.storageClient)
+}
+
+// quarantinedPredecessors returns quarantined state ids right before pending state syncs
+func (cp *ClerkProcessor) quarantinedPredecessors(pending []*pendingStateSync) map[uint64]bool {
+	store := cp.quarantineStore()
+
+	quarantined := make(map[uint64]bool)
+	for _, p := range pending {
+		for id := p.ID - 1; id > 0 && !quarantined[id]; id-- {
+			if has, err := store.Has(id); err != nil || !has {
+				break
+			}
+
+			cp.Logger.Debug("Skipping quarantined predecessor", "id", id, "successor", p.ID)
+			quarantined[id] = true
+		}
+	}
+
+	return quarantined
+}
+
+// loadPendingStateSyncs returns up to limit pending state syncs with lowest state ids
+func (cp *ClerkProcessor) loadPendingStateSyncs(limit int) ([]*pendingStateSync, error) {
+	iter := cp.storageClient.NewIterator(levelUtil.BytesPrefix([]byte(pendingStateSyncPrefix)), nil)
+	defer iter.Release()
+
+	var result []*pendingStateSync
+	count := 0
+	for iter.Next() {
+		count++
+		if len(result) >= limit {
+			continue
+		}
+
+		var pending pendingStateSync
+		if err := json.Unmarshal(iter.Value(), &pending); err != nil {
+			return nil, err
+		}
+		result = append(result, &pending)
+	}
+
+	metrics.ClerkPendingStateSyncs.Set(float64(count))
+	return result, iter.Error()
+}
+
+// getRecordStatus returns which of the records are in heimdall, along with heimdall height
+func (cp *ClerkProcessor) getRecordStatus(recordIDs []uint64) (map[uint64]bool, int64, error) {
+	ids := make([]string, len(recordIDs))
+	for i, id := range recordIDs {
+		ids[i] = strconv.FormatUint(id, 10)
+	}
+
+	url, err := util.CreateURLWithQuery(helper.GetHeimdallServerEndpoint(util.ClerkRecordStatusURL), map[string]interface{}{
+		"record-ids": strings.Join(ids, ","),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	res, err := helper.FetchFromAPI(cp.cliCtx, url)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var status []bool
+	if err := json.Unmarshal(res.Result, &status); err != nil {
+		return nil, 0, err
+	}
+
+	if len(status) != len(recordIDs) {
+		return nil, 0, fmt.Errorf("invalid record status length %v, expected %v", len(status), len(recordIDs))
+	}
+
+	included := make(map[uint64]bool, len(recordIDs))
+	for i, id := range recordIDs {
+		included[id] = status[i]
+	}
+
+	return included, res.Height, nil
+}
+
+// deletePendingStateSync forgets pending state sync
+func (cp *ClerkProcessor) deletePendingStateSync(id uint64) {
+	if err := cp.storageClient.Delete(pendingStateSyncKey(id), nil); err != nil {
+		cp.Logger.Error("Error while deleting pending state sync", "id", id, "error", err)
+	}
+}
+
+// pendingStateSyncKey returns db key of pending state sync, ordered by state id
+func pendingStateSyncKey(id uint64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", pendingStateSyncPrefix, id))
+}
+
+// Stop stops all necessary go routines
+func (cp *ClerkProcessor) Stop() {
+	// cancel submitting state syncs
+	if cp.cancelClerkService != nil {
+		cp.cancelClerkService()
+	}
+}
@@ -0,0 +1,187 @@
+package processor
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/tendermint/tendermint/libs/log"
+
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	"github.com/maticnetwork/heimdall/contracts/statesender"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func pendingStateSyncs(ids ...uint64) []*pendingStateSync {
+	result := make([]*pendingStateSync, len(ids))
+	for i, id := range ids {
+		result[i] = &pendingStateSync{ID: id}
+	}
+	return result
+}
+
+func stateSyncIDs(pending []*pendingStateSync) []uint64 {
+	var ids []uint64
+	for _, p := range pending {
+		ids = append(ids, p.ID)
+	}
+	return ids
+}
+
+func TestSelectReadyStateSyncs(t *testing.T) {
+	// first state sync ever
+	ready := selectReadyStateSyncs(pendingStateSyncs(1, 2, 3), map[uint64]bool{}, map[uint64]bool{}, map[uint64]bool{})
+	require.Equal(t, []uint64{1, 2, 3}, stateSyncIDs(ready))
+
+	// stops at gap
+	ready = selectReadyStateSyncs(pendingStateSyncs(5, 6, 8, 9), map[uint64]bool{4: true}, map[uint64]bool{}, map[uint64]bool{})
+	require.Equal(t, []uint64{5, 6}, stateSyncIDs(ready))
+
+	// predecessor not in heimdall yet
+	ready = selectReadyStateSyncs(pendingStateSyncs(5, 6), map[uint64]bool{}, map[uint64]bool{}, map[uint64]bool{})
+	require.Empty(t, ready)
+
+	// gap filled by another validator, sent ones are skipped
+	ready = selectReadyStateSyncs(pendingStateSyncs(5, 6, 8, 9), map[uint64]bool{7: true}, map[uint64]bool{5: true, 6: true}, map[uint64]bool{})
+	require.Equal(t, []uint64{8, 9}, stateSyncIDs(ready))
+
+	// skipped state ids don't hold successors
+	ready = selectReadyStateSyncs(pendingStateSyncs(8, 9), map[uint64]bool{5: true}, map[uint64]bool{}, map[uint64]bool{6: true, 7: true})
+	require.Equal(t, []uint64{8, 9}, stateSyncIDs(ready))
+	ready = selectReadyStateSyncs(pendingStateSyncs(8, 9), map[uint64]bool{}, map[uint64]bool{}, map[uint64]bool{6: true, 7: true})
+	require.Empty(t, ready)
+}
+
+func TestUpdateInFlight(t *testing.T) {
+	cp := &ClerkProcessor{BaseProcessor: BaseProcessor{Logger: log.NewNopLogger()}}
+	cp.inFlight = []*stateSyncTx{
+		{id: 1, sentAt: time.Now()},
+		{id: 2, sentAt: time.Now()},
+		{id: 3, sentAt: time.Now().Add(-time.Hour)},
+	}
+
+	// included and timed out txs are forgotten, each tx counts once against concurrency
+	sent := cp.updateInFlight(map[uint64]bool{1: true}, time.Minute)
+	require.Equal(t, map[uint64]bool{2: true}, sent)
+	require.Len(t, cp.inFlight, 1)
+}
+
+func TestStatusRecordIDs(t *testing.T) {
+	require.Equal(t, []uint64{1, 2, 3}, statusRecordIDs(pendingStateSyncs(1, 2, 3), map[uint64]bool{}))
+	require.Equal(t, []uint64{4, 5, 6, 7, 8}, statusRecordIDs(pendingStateSyncs(5, 6, 8), map[uint64]bool{}))
+	require.Equal(t, []uint64{4, 5, 8}, statusRecordIDs(pendingStateSyncs(5, 8), map[uint64]bool{6: true, 7: true}))
+}
+
+func TestClassifyStateSync(t *testing.T) {
+	stateSender := common.HexToAddress("0x01")
+	chainParams := chainmanagerTypes.ChainParams{StateSenderAddress: hmTypes.BytesToHeimdallAddress(stateSender.Bytes())}
+	vLog := types.Log{Address: stateSender}
+	event := &statesender.StatesenderStateSynced{Id: big.NewInt(1), Data: make([]byte, helper.MaxStateSyncSize)}
+
+	class, _ := classifyStateSync(vLog, event, chainParams, helper.SpanOverrideBlockHeight+1)
+	require.Equal(t, stateSyncValid, class)
+
+	// heimdall height unknown
+	class, _ = classifyStateSync(vLog, event, chainParams, 0)
+	require.Equal(t, stateSyncTransient, class)
+
+	// contract not registered
+	class, reason := classifyStateSync(types.Log{Address: common.HexToAddress("0x02")}, event, chainParams, helper.SpanOverrideBlockHeight+1)
+	require.Equal(t, stateSyncInvalid, class)
+	require.Contains(t, reason, "not the registered state sender")
+
+	// rotated state sender is accepted once active
+	chainParams.ContractAddresses = []chainmanagerTypes.ContractAddress{{
+		Contract:         chainmanagerTypes.ContractStateSender,
+		Address:          hmTypes.BytesToHeimdallAddress(common.HexToAddress("0x02").Bytes()),
+		ActivationHeight: uint64(helper.SpanOverrideBlockHeight),
+	}}
+	class, _ = classifyStateSync(types.Log{Address: common.HexToAddress("0x02")}, event, chainParams, helper.SpanOverrideBlockHeight+1)
+	require.Equal(t, stateSyncValid, class)
+
+	// invalid state id
+	class, reason = classifyStateSync(vLog, &statesender.StatesenderStateSynced{Id: big.NewInt(0)}, chainParams, helper.SpanOverrideBlockHeight+1)
+	require.Equal(t, stateSyncInvalid, class)
+	require.Contains(t, reason, "invalid state id")
+
+	// oversized is sent with empty data, not quarantined
+	event.Data = make([]byte, helper.LegacyMaxStateSyncSize+1)
+	class, _ = classifyStateSync(vLog, event, chainParams, helper.SpanOverrideBlockHeight+1)
+	require.Equal(t, stateSyncValid, class)
+}
+
+func TestMaxStateSyncSize(t *testing.T) {
+	require.Equal(t, helper.LegacyMaxStateSyncSize, maxStateSyncSize(helper.SpanOverrideBlockHeight))
+	require.Equal(t, helper.MaxStateSyncSize, maxStateSyncSize(helper.SpanOverrideBlockHeight+1))
+}
+
+func TestQuarantineStore(t *testing.T) {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := NewQuarantineStore(db)
+	require.NoError(t, store.Put(&QuarantinedStateSync{ID: 10, Reason: "contract 0x02 is not the registered state sender 0x01"}))
+	require.NoError(t, store.Put(&QuarantinedStateSync{ID: 9, Reason: "invalid state id"}))
+
+	quarantined, err := store.Get(10)
+	require.NoError(t, err)
+	require.Equal(t, "contract 0x02 is not the registered state sender 0x01", quarantined.Reason)
+
+	list, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	require.Equal(t, uint64(9), list[0].ID)
+
+	require.NoError(t, store.Delete(9))
+	require.Equal(t, ErrQuarantinedStateSyncNotFound, store.Delete(9))
+	_, err = store.Get(9)
+	require.Equal(t, ErrQuarantinedStateSyncNotFound, err)
+}
+
+func TestQuarantinedPredecessorDoesNotHoldSuccessors(t *testing.T) {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	cp := &ClerkProcessor{BaseProcessor: BaseProcessor{Logger: log.NewNopLogger(), storageClient: db}}
+	require.NoError(t, cp.quarantineStore().Put(&QuarantinedStateSync{ID: 5, Reason: "invalid state id"}))
+	require.NoError(t, cp.quarantineStore().Put(&QuarantinedStateSync{ID: 6, Reason: "invalid state id"}))
+
+	pending := pendingStateSyncs(7, 8)
+	skipped := cp.quarantinedPredecessors(pending)
+	require.Equal(t, map[uint64]bool{5: true, 6: true}, skipped)
+	require.Equal(t, []uint64{4, 7, 8}, statusRecordIDs(pending, skipped))
+
+	// predecessor of quarantined ones is in heimdall
+	ready := selectReadyStateSyncs(pending, map[uint64]bool{4: true}, map[uint64]bool{}, skipped)
+	require.Equal(t, []uint64{7, 8}, stateSyncIDs(ready))
+}
+
+func TestSelectStateSyncsToSendGapTimeout(t *testing.T) {
+	cp := &ClerkProcessor{BaseProcessor: BaseProcessor{Logger: log.NewNopLogger()}}
+	pending := pendingStateSyncs(8, 9)
+
+	// missing predecessor holds successors within gap timeout
+	ready := cp.selectStateSyncsToSend(pending, map[uint64]bool{}, map[uint64]bool{}, map[uint64]bool{}, time.Hour)
+	require.Empty(t, ready)
+	require.Equal(t, uint64(7), cp.gapID)
+
+	// and is skipped after it
+	cp.gapSince = time.Now().Add(-time.Hour)
+	included := map[uint64]bool{}
+	ready = cp.selectStateSyncsToSend(pending, included, map[uint64]bool{}, map[uint64]bool{}, time.Hour)
+	require.Equal(t, []uint64{8, 9}, stateSyncIDs(ready))
+	require.Equal(t, map[uint64]bool{7: true}, included)
+
+	// no gap
+	ready = cp.selectStateSyncsToSend(pending, map[uint64]bool{7: true}, map[uint64]bool{}, map[uint64]bool{}, time.Hour)
+	require.Equal(t, []uint64{8, 9}, stateSyncIDs(ready))
+	require.Zero(t, cp.gapID)
+}
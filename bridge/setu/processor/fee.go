@@ -3,6 +3,8 @@ package processor
 import (
 	"encoding/json"
 
+	"github.com/RichardKnop/machinery/v1/tasks"
+
 	"github.com/maticnetwork/bor/accounts/abi"
 	"github.com/maticnetwork/bor/core/types"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
@@ -37,7 +39,7 @@ func (fp *FeeProcessor) Start() error {
 // RegisterTasks - Registers clerk related tasks with machinery
 func (fp *FeeProcessor) RegisterTasks() {
 	fp.Logger.Info("Registering fee related tasks")
-	if err := fp.queueConnector.Server.RegisterTask("sendTopUpFeeToHeimdall", fp.sendTopUpFeeToHeimdall); err != nil {
+	if err := fp.queueConnector.RegisterTask("sendTopUpFeeToHeimdall", fp.sendTopUpFeeToHeimdall); err != nil {
 		fp.Logger.Error("RegisterTasks | sendTopUpFeeToHeimdall", "error", err)
 	}
 }
@@ -54,7 +56,8 @@ func (fp *FeeProcessor) sendTopUpFeeToHeimdall(eventName string, logBytes string
 	if err := helper.UnpackLog(fp.stakingInfoAbi, event, eventName, &vLog); err != nil {
 		fp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
 	} else {
-		if isOld, _ := fp.isOldTx(fp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index), util.TopupEvent); isOld {
+		isOld, err := fp.isOldTx(fp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index), util.TopupEvent)
+		if isOld {
 			fp.Logger.Info("Ignoring task to send topup to heimdall as already processed",
 				"event", eventName,
 				"user", event.User,
@@ -65,6 +68,9 @@ func (fp *FeeProcessor) sendTopUpFeeToHeimdall(eventName string, logBytes string
 			)
 			return nil
 		}
+		if inFlight, ok := err.(tasks.ErrRetryTaskLater); ok {
+			return inFlight
+		}
 
 		fp.Logger.Info("✅ sending topup to heimdall",
 			"event", eventName,
@@ -83,6 +89,8 @@ func (fp *FeeProcessor) sendTopUpFeeToHeimdall(eventName string, logBytes string
 			fp.Logger.Error("Error while broadcasting TopupFee msg to heimdall", "error", err)
 			return err
 		}
+
+		fp.markInFlight(msg)
 	}
 	return nil
 }
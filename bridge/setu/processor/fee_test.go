@@ -0,0 +1,60 @@
+package processor
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/maticnetwork/bor/accounts/abi"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/dedup"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
+)
+
+func TestSendTopUpFeeInFlight(t *testing.T) {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	stakingInfoAbi, err := abi.JSON(strings.NewReader(stakinginfo.StakinginfoABI))
+	require.NoError(t, err)
+
+	index := dedup.NewIndex(db, time.Minute)
+	fp := NewFeeProcessor(&stakingInfoAbi)
+	fp.BaseProcessor = BaseProcessor{Logger: log.NewNopLogger(), dedupIndex: index}
+
+	vLog := types.Log{
+		Topics: []common.Hash{
+			stakingInfoAbi.Events["TopUpFee"].Id(),
+			common.BytesToHash(common.HexToAddress("0x01").Bytes()),
+			common.BigToHash(big.NewInt(100)),
+		},
+		TxHash: common.HexToHash("0xab"),
+		Index:  1,
+	}
+	logBytes, err := json.Marshal(vLog)
+	require.NoError(t, err)
+
+	ref := dedup.NewRef(util.TopupEvent, vLog.TxHash.String(), 1)
+
+	// backup task of an event sent by another validator is retried after in-flight entry expires
+	require.NoError(t, index.MarkInFlight(ref))
+	err = fp.sendTopUpFeeToHeimdall("TopUpFee", string(logBytes))
+	retry, ok := err.(tasks.ErrRetryTaskLater)
+	require.True(t, ok, "expected retry, got %v", err)
+	require.True(t, retry.RetryIn() > 0 && retry.RetryIn() <= time.Minute)
+
+	// processed event is dropped
+	require.NoError(t, index.MarkProcessed(ref))
+	require.NoError(t, fp.sendTopUpFeeToHeimdall("TopUpFee", string(logBytes)))
+}
@@ -0,0 +1,147 @@
+package processor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/syndtr/goleveldb/leveldb"
+	levelUtil "github.com/syndtr/goleveldb/leveldb/util"
+
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	"github.com/maticnetwork/heimdall/contracts/statesender"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// storage key prefix of quarantined state syncs
+const quarantinePrefix = "quarantine-"
+
+// ErrQuarantinedStateSyncNotFound is returned when quarantined state sync doesn't exist
+var ErrQuarantinedStateSyncNotFound = errors.New("quarantined state sync not found")
+
+// stateSyncClass is result of state sync pre-flight validation
+type stateSyncClass int
+
+const (
+	stateSyncValid     stateSyncClass = iota // can be sent to heimdall
+	stateSyncInvalid                         // heimdall will never accept it, quarantined
+	stateSyncTransient                       // can't be validated now, retried later
+)
+
+// classifyStateSync validates decoded state sync against the checks heimdall does, when it is received.
+// Contract is checked against addresses accepted at given height, the one the event was found at.
+// Oversized data is not a reason to quarantine, heimdall accepts such state syncs with empty data.
+func classifyStateSync(vLog types.Log, event *statesender.StatesenderStateSynced, chainParams chainmanagerTypes.ChainParams, height int64) (stateSyncClass, string) {
+	if height <= 0 {
+		return stateSyncTransient, "heimdall height is not available"
+	}
+
+	if !chainParams.IsAcceptedAddress(chainmanagerTypes.ContractStateSender, vLog.Address, uint64(height)) {
+		return stateSyncInvalid, fmt.Sprintf("contract %v is not the registered state sender %v", vLog.Address.Hex(), chainParams.StateSenderAddress.EthAddress().Hex())
+	}
+
+	if event.Id == nil || event.Id.Sign() <= 0 || !event.Id.IsUint64() {
+		return stateSyncInvalid, fmt.Sprintf("invalid state id %v", event.Id)
+	}
+
+	return stateSyncValid, ""
+}
+
+// maxStateSyncSize returns data size limit of state syncs at heimdall height,
+// data of larger state syncs is sent empty
+func maxStateSyncSize(height int64) int {
+	if height > helper.SpanOverrideBlockHeight {
+		return helper.MaxStateSyncSize
+	}
+	return helper.LegacyMaxStateSyncSize
+}
+
+// QuarantinedStateSync is a state sync heimdall will never accept, kept for operators instead of being retried
+type QuarantinedStateSync struct {
+	ID            uint64    `json:"id"`
+	TxHash        string    `json:"txHash"`
+	LogIndex      uint64    `json:"logIndex"`
+	BlockNumber   uint64    `json:"blockNumber"`
+	Contract      string    `json:"contract"`
+	DataSize      int       `json:"dataSize"`
+	Reason        string    `json:"reason"`
+	EventName     string    `json:"eventName"`
+	LogBytes      string    `json:"logBytes"`
+	QuarantinedAt time.Time `json:"quarantinedAt"`
+}
+
+// QuarantineStore keeps quarantined state syncs in bridge db
+type QuarantineStore struct {
+	db *leveldb.DB
+}
+
+// NewQuarantineStore creates quarantine store on given db
+func NewQuarantineStore(db *leveldb.DB) *QuarantineStore {
+	return &QuarantineStore{db: db}
+}
+
+// Put stores quarantined state sync
+func (s *QuarantineStore) Put(quarantined *QuarantinedStateSync) error {
+	value, err := json.Marshal(quarantined)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Put(quarantineKey(quarantined.ID), value, nil)
+}
+
+// Has returns whether state sync is quarantined
+func (s *QuarantineStore) Has(id uint64) (bool, error) {
+	return s.db.Has(quarantineKey(id), nil)
+}
+
+// Get returns quarantined state sync by state id
+func (s *QuarantineStore) Get(id uint64) (*QuarantinedStateSync, error) {
+	value, err := s.db.Get(quarantineKey(id), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, ErrQuarantinedStateSyncNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	var quarantined QuarantinedStateSync
+	if err := json.Unmarshal(value, &quarantined); err != nil {
+		return nil, err
+	}
+	return &quarantined, nil
+}
+
+// List returns all quarantined state syncs, ordered by state id
+func (s *QuarantineStore) List() ([]*QuarantinedStateSync, error) {
+	result := make([]*QuarantinedStateSync, 0)
+
+	iter := s.db.NewIterator(levelUtil.BytesPrefix([]byte(quarantinePrefix)), nil)
+	defer iter.Release()
+	for iter.Next() {
+		var quarantined QuarantinedStateSync
+		if err := json.Unmarshal(iter.Value(), &quarantined); err != nil {
+			return nil, err
+		}
+		result = append(result, &quarantined)
+	}
+
+	return result, iter.Error()
+}
+
+// Delete removes quarantined state sync
+func (s *QuarantineStore) Delete(id uint64) error {
+	if has, err := s.Has(id); err != nil {
+		return err
+	} else if !has {
+		return ErrQuarantinedStateSyncNotFound
+	}
+
+	return s.db.Delete(quarantineKey(id), nil)
+}
+
+// quarantineKey returns db key of quarantined state sync, ordered by state id
+func quarantineKey(id uint64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", quarantinePrefix, id))
+}
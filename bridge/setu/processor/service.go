@@ -1,6 +1,8 @@
 package processor
 
 import (
+	"sync"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/libs/common"
@@ -25,6 +27,13 @@ type ProcessorService struct {
 	queueConnector *queue.QueueConnector
 
 	processors []Processor
+
+	// span processor, used to check span proposer
+	spanProcessor *SpanProcessor
+
+	// state of each processor
+	statuses    map[string]*util.ServiceStatus
+	statusMutex sync.RWMutex
 }
 
 // NewProcessorService returns new service object for processing queue msg
@@ -38,6 +47,7 @@ func NewProcessorService(
 	// creating processor object
 	processorService := &ProcessorService{
 		queueConnector: queueConnector,
+		statuses:       make(map[string]*util.ServiceStatus),
 	}
 
 	contractCaller, err := helper.NewContractCaller()
@@ -70,6 +80,7 @@ func NewProcessorService(
 	// initialize span processor
 	spanProcessor := &SpanProcessor{}
 	spanProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "span", spanProcessor)
+	processorService.spanProcessor = spanProcessor
 
 	// initialize slashing processor
 	slashingProcessor := NewSlashingProcessor(&contractCaller.StakingInfoABI)
@@ -127,7 +138,13 @@ func (processorService *ProcessorService) OnStart() error {
 	// start processors
 	for _, processor := range processorService.processors {
 		processor.RegisterTasks()
-		go processor.Start()
+		go func(processor Processor) {
+			err := processor.Start()
+			if err != nil {
+				processorService.Logger.Error("OnStart | Start", "processor", processor.String(), "Error", err)
+			}
+			processorService.setStatus(processor.String(), err == nil, err)
+		}(processor)
 	}
 
 	processorService.Logger.Info("all processors Started")
@@ -140,7 +157,38 @@ func (processorService *ProcessorService) OnStop() {
 	// start chain listeners
 	for _, processor := range processorService.processors {
 		processor.Stop()
+		processorService.setStatus(processor.String(), false, nil)
 	}
 
 	processorService.Logger.Info("all processors stopped")
 }
+
+// ServiceStatuses returns state of started processors
+func (processorService *ProcessorService) ServiceStatuses() []util.ServiceStatus {
+	processorService.statusMutex.RLock()
+	defer processorService.statusMutex.RUnlock()
+
+	statuses := make([]util.ServiceStatus, 0, len(processorService.statuses))
+	for _, processor := range processorService.processors {
+		if status, ok := processorService.statuses[processor.String()]; ok {
+			statuses = append(statuses, status.WithHeartbeat())
+		}
+	}
+	return statuses
+}
+
+// IsSpanProposer checks if this node can propose next span
+func (processorService *ProcessorService) IsSpanProposer() (bool, error) {
+	return processorService.spanProcessor.IsSpanProposer()
+}
+
+func (processorService *ProcessorService) setStatus(name string, running bool, err error) {
+	processorService.statusMutex.Lock()
+	defer processorService.statusMutex.Unlock()
+
+	status := &util.ServiceStatus{Name: name, Service: processorServiceStr, Running: running}
+	if err != nil {
+		status.Error = err.Error()
+	}
+	processorService.statuses[name] = status
+}
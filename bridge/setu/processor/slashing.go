@@ -5,12 +5,15 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"math/big"
 
+	"github.com/RichardKnop/machinery/v1/tasks"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/bor/accounts/abi"
 	"github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/bor/core/types"
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
@@ -48,10 +51,10 @@ func (sp *SlashingProcessor) Start() error {
 // RegisterTasks - Registers slashing related tasks with machinery
 func (sp *SlashingProcessor) RegisterTasks() {
 	sp.Logger.Info("Registering slashing related tasks")
-	sp.queueConnector.Server.RegisterTask("sendTickToHeimdall", sp.sendTickToHeimdall)
-	sp.queueConnector.Server.RegisterTask("sendTickToRootchain", sp.sendTickToRootchain)
-	sp.queueConnector.Server.RegisterTask("sendTickAckToHeimdall", sp.sendTickAckToHeimdall)
-	sp.queueConnector.Server.RegisterTask("sendUnjailToHeimdall", sp.sendUnjailToHeimdall)
+	sp.queueConnector.RegisterTask("sendTickToHeimdall", sp.sendTickToHeimdall)
+	sp.queueConnector.RegisterTask("sendTickToRootchain", sp.sendTickToRootchain)
+	sp.queueConnector.RegisterTask("sendTickAckToHeimdall", sp.sendTickAckToHeimdall)
+	sp.queueConnector.RegisterTask("sendUnjailToHeimdall", sp.sendUnjailToHeimdall)
 
 }
 
@@ -78,7 +81,7 @@ func (sp *SlashingProcessor) sendTickToHeimdall(eventBytes string, blockHeight i
 		return err
 	}
 
-	sp.Logger.Info("processing slash-limit event", "eventtype", event.Type)
+	sp.Logger.Info("processing tick event", "eventtype", event.Type)
 
 	sp.Logger.Info("✅ Creating and broadcasting Tick tx",
 		"id", tickCount+1,
@@ -183,23 +186,46 @@ func (sp *SlashingProcessor) sendTickAckToHeimdall(eventName string, logBytes st
 	if err := helper.UnpackLog(sp.stakingInfoAbi, event, eventName, &vLog); err != nil {
 		sp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
 	} else {
+		// count gas spent if this validator submitted the tick
+		sp.recordRootchainTxGas(metrics.TickTx, vLog)
 
-		if isOld, _ := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index), util.SlashingEvent); isOld {
+		// slashed amount on rootchain is in wei, tick-acks carry it in power once ticks are activated
+		slashingParams, height, err := util.GetSlashingParams(sp.cliCtx)
+		if err != nil {
+			sp.Logger.Error("Error while fetching slashing params", "error", err)
+			return err
+		}
+
+		slashedAmount := event.Amount.Uint64()
+		if slashingParams.IsTickActivated(height) {
+			slashedPower, err := helper.GetPowerFromAmount(new(big.Int).Set(event.Amount))
+			if err != nil {
+				sp.Logger.Error("Error while converting slashed amount to power", "amount", event.Amount, "error", err)
+				return err
+			}
+			slashedAmount = slashedPower.Uint64()
+		}
+
+		isOld, err := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index), util.SlashingEvent)
+		if isOld {
 			sp.Logger.Info("Ignoring task to send tick ack to heimdall as already processed",
 				"event", eventName,
 				"tickID", event.Nonce,
-				"totalSlashedAmount", event.Amount.Uint64(),
+				"totalSlashedAmount", slashedAmount,
 				"txHash", hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
 				"logIndex", uint64(vLog.Index),
 				"blockNumber", vLog.BlockNumber,
 			)
 			return nil
 		}
+		if inFlight, ok := err.(tasks.ErrRetryTaskLater); ok {
+			return inFlight
+		}
 		sp.Logger.Info(
 			"✅ Received task to send tick-ack to heimdall",
 			"event", eventName,
 			"tickID", event.Nonce,
-			"totalSlashedAmount", event.Amount.Uint64(),
+			"totalSlashedAmount", slashedAmount,
 			"txHash", hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
 			"logIndex", uint64(vLog.Index),
 			"blockNumber", vLog.BlockNumber,
@@ -208,13 +234,15 @@ func (sp *SlashingProcessor) sendTickAckToHeimdall(eventName string, logBytes st
 		// TODO - check if i am the proposer of this tick ack or not.
 
 		// create msg checkpoint ack message
-		msg := slashingTypes.NewMsgTickAck(helper.GetFromAddress(sp.cliCtx), event.Nonce.Uint64(), event.Amount.Uint64(), hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()), uint64(vLog.Index), vLog.BlockNumber)
+		msg := slashingTypes.NewMsgTickAck(helper.GetFromAddress(sp.cliCtx), event.Nonce.Uint64(), slashedAmount, hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()), uint64(vLog.Index), vLog.BlockNumber)
 
 		// return broadcast to heimdall
 		if err := sp.txBroadcaster.BroadcastToHeimdall(msg); err != nil {
 			sp.Logger.Error("Error while broadcasting tick-ack to heimdall", "error", err)
 			return err
 		}
+
+		sp.markInFlight(msg)
 	}
 	return nil
 }
@@ -234,7 +262,8 @@ func (sp *SlashingProcessor) sendUnjailToHeimdall(eventName string, logBytes str
 		sp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
 	} else {
 
-		if isOld, _ := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index), util.SlashingEvent); isOld {
+		isOld, err := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index), util.SlashingEvent)
+		if isOld {
 			sp.Logger.Info("Ignoring sending unjail to heimdall as already processed",
 				"event", eventName,
 				"ValidatorID", event.ValidatorId,
@@ -244,6 +273,9 @@ func (sp *SlashingProcessor) sendUnjailToHeimdall(eventName string, logBytes str
 			)
 			return nil
 		}
+		if inFlight, ok := err.(tasks.ErrRetryTaskLater); ok {
+			return inFlight
+		}
 		sp.Logger.Info(
 			"✅ Received task to send unjail to heimdall",
 			"event", eventName,
@@ -269,6 +301,8 @@ func (sp *SlashingProcessor) sendUnjailToHeimdall(eventName string, logBytes str
 			sp.Logger.Error("Error while broadcasting unjail to heimdall", "error", err)
 			return err
 		}
+
+		sp.markInFlight(msg)
 	}
 	return nil
 }
@@ -50,52 +50,70 @@ func (sp *SpanProcessor) startPolling(ctx context.Context, interval time.Duratio
 	ticker := time.NewTicker(interval)
 	// stop ticker when everything done
 	defer ticker.Stop()
+	util.StartHeartbeat(sp.name, "polling", util.HeartbeatTimeout(interval))
 
 	for {
 		select {
 		case <-ticker.C:
 			sp.checkAndPropose()
+			util.Heartbeat(sp.name, "polling")
 		case <-ctx.Done():
 			sp.Logger.Info("Polling stopped")
+			util.StopHeartbeat(sp.name, "polling")
 			ticker.Stop()
 			return
 		}
 	}
 }
 
-// checkAndPropose - will check if current user is span proposer and proposes the span
+// checkAndPropose - will check if current user is span proposer and proposes the span,
+// for the default bor chain and every additional bor chain with configured rpc endpoints
 func (sp *SpanProcessor) checkAndPropose() {
-	lastSpan, err := sp.getLastSpan()
+	sp.checkAndProposeChain("")
+	for _, borChainID := range helper.GetBorChainIDs() {
+		sp.checkAndProposeChain(borChainID)
+	}
+}
+
+// checkAndProposeChain - will check if current user is span proposer of the bor chain and proposes the span
+func (sp *SpanProcessor) checkAndProposeChain(borChainID string) {
+	lastSpan, err := sp.getChainLastSpan(borChainID)
 	if err == nil && lastSpan != nil {
-		sp.Logger.Debug("Found last span", "lastSpan", lastSpan.ID, "startBlock", lastSpan.StartBlock, "endBlock", lastSpan.EndBlock)
-		nextSpanMsg, err := sp.fetchNextSpanDetails(lastSpan.ID+1, lastSpan.EndBlock+1)
+		sp.Logger.Debug("Found last span", "borChainID", borChainID, "lastSpan", lastSpan.ID, "startBlock", lastSpan.StartBlock, "endBlock", lastSpan.EndBlock)
+
+		// additional bor chain starts with span 0 from block 0
+		nextSpanID, nextStartBlock := lastSpan.ID+1, lastSpan.EndBlock+1
+		if isFirstSpan(borChainID, lastSpan) {
+			nextSpanID, nextStartBlock = 0, 0
+		}
+		nextSpanMsg, err := sp.fetchNextSpanDetails(borChainID, nextSpanID, nextStartBlock)
 
 		// check if current user is among next span producers
 		if err == nil && sp.isSpanProposer(nextSpanMsg.SelectedProducers) {
-			go sp.propose(lastSpan, nextSpanMsg)
+			go sp.propose(borChainID, lastSpan, nextSpanMsg)
 		} else {
-			sp.Logger.Error("Unable to fetch next span details", "lastSpanId", lastSpan.ID)
+			sp.Logger.Error("Unable to fetch next span details", "borChainID", borChainID, "lastSpanId", lastSpan.ID)
 			return
 		}
 	}
 }
 
 // propose producers for next span if needed
-func (sp *SpanProcessor) propose(lastSpan *types.Span, nextSpanMsg *types.Span) {
+func (sp *SpanProcessor) propose(borChainID string, lastSpan *types.Span, nextSpanMsg *types.Span) {
 	// call with last span on record + new span duration and see if it has been proposed
-	currentBlock, err := sp.getCurrentChildBlock()
+	currentBlock, err := sp.getCurrentChildBlock(borChainID)
 	if err != nil {
-		sp.Logger.Error("Unable to fetch current block", "error", err)
+		sp.Logger.Error("Unable to fetch current block", "borChainID", borChainID, "error", err)
 		return
 	}
 
-	if lastSpan.StartBlock <= currentBlock && currentBlock <= lastSpan.EndBlock {
+	if isFirstSpan(borChainID, lastSpan) || (lastSpan.StartBlock <= currentBlock && currentBlock <= lastSpan.EndBlock) {
 		// log new span
-		sp.Logger.Info("✅ Proposing new span", "spanId", nextSpanMsg.ID, "startBlock", nextSpanMsg.StartBlock, "endBlock", nextSpanMsg.EndBlock)
+		sp.Logger.Info("✅ Proposing new span", "borChainID", borChainID, "spanId", nextSpanMsg.ID, "startBlock", nextSpanMsg.StartBlock, "endBlock", nextSpanMsg.EndBlock)
 
 		//Get NextSpanSeed from HeimdallServer
 		var seed common.Hash
-		if seed, err = sp.fetchNextSpanSeed(); err != nil {
+		if seed, err = sp.fetchNextSpanSeed(borChainID); err != nil {
 			sp.Logger.Info("Error while fetching next span seed from HeimdallServer", "err", err)
 			return
 		}
@@ -120,10 +138,15 @@ func (sp *SpanProcessor) propose(lastSpan *types.Span, nextSpanMsg *types.Span)
 
 // checks span status
 func (sp *SpanProcessor) getLastSpan() (*types.Span, error) {
+	return sp.getChainLastSpan("")
+}
+
+// getChainLastSpan returns last span of the bor chain, empty span if chain has none yet
+func (sp *SpanProcessor) getChainLastSpan(borChainID string) (*types.Span, error) {
 	// fetch latest start block from heimdall via rest query
-	result, err := helper.FetchFromAPI(sp.cliCtx, helper.GetHeimdallServerEndpoint(util.LatestSpanURL))
+	result, err := helper.FetchFromAPI(sp.cliCtx, helper.GetHeimdallServerEndpoint(util.BorChainURL(util.LatestSpanURL, borChainID)))
 	if err != nil {
-		sp.Logger.Error("Error while fetching latest span")
+		sp.Logger.Error("Error while fetching latest span", "borChainID", borChainID)
 		return nil, err
 	}
 	var lastSpan types.Span
@@ -135,15 +158,39 @@ func (sp *SpanProcessor) getLastSpan() (*types.Span, error) {
 	return &lastSpan, nil
 }
 
-// getCurrentChildBlock gets the current child block
-func (sp *SpanProcessor) getCurrentChildBlock() (uint64, error) {
-	childBlock, err := sp.contractConnector.GetMaticChainBlock(nil)
+// getCurrentChildBlock gets the current child block of the bor chain
+func (sp *SpanProcessor) getCurrentChildBlock(borChainID string) (uint64, error) {
+	var borChainCaller helper.IContractCaller = &sp.contractConnector
+	if borChainID != "" {
+		caller, err := sp.contractConnector.GetBorChainCaller(borChainID)
+		if err != nil {
+			return 0, err
+		}
+		borChainCaller = caller
+	}
+
+	childBlock, err := borChainCaller.GetMaticChainBlock(nil)
 	if err != nil {
 		return 0, err
 	}
 	return childBlock.Number.Uint64(), nil
 }
 
+// IsSpanProposer checks if current user is among producers of next span and can propose it
+func (sp *SpanProcessor) IsSpanProposer() (bool, error) {
+	lastSpan, err := sp.getLastSpan()
+	if err != nil {
+		return false, err
+	}
+
+	nextSpanMsg, err := sp.fetchNextSpanDetails("", lastSpan.ID+1, lastSpan.EndBlock+1)
+	if err != nil {
+		return false, err
+	}
+
+	return sp.isSpanProposer(nextSpanMsg.SelectedProducers), nil
+}
+
 // isSpanProposer checks if current user is span proposer
 func (sp *SpanProcessor) isSpanProposer(nextSpanProducers []types.Validator) bool {
 	// anyone among next span producers can become next span proposer
@@ -155,8 +202,8 @@ func (sp *SpanProcessor) isSpanProposer(nextSpanProducers []types.Validator) boo
 	return false
 }
 
-// fetch next span details from heimdall.
-func (sp *SpanProcessor) fetchNextSpanDetails(id uint64, start uint64) (*types.Span, error) {
+// fetch next span details of the bor chain from heimdall.
+func (sp *SpanProcessor) fetchNextSpanDetails(borChainID string, id uint64, start uint64) (*types.Span, error) {
 	req, err := http.NewRequest("GET", helper.GetHeimdallServerEndpoint(util.NextSpanInfoURL), nil)
 	if err != nil {
 		sp.Logger.Error("Error creating a new request", "error", err)
@@ -168,10 +215,14 @@ func (sp *SpanProcessor) fetchNextSpanDetails(id uint64, start uint64) (*types.S
 		return nil, err
 	}
 
+	if borChainID == "" {
+		borChainID = configParams.ChainParams.BorChainID
+	}
+
 	q := req.URL.Query()
 	q.Add("span_id", strconv.FormatUint(id, 10))
 	q.Add("start_block", strconv.FormatUint(start, 10))
-	q.Add("chain_id", configParams.ChainParams.BorChainID)
+	q.Add("chain_id", borChainID)
 	q.Add("proposer", helper.GetFromAddress(sp.cliCtx).String())
 	req.URL.RawQuery = q.Encode()
 
@@ -192,10 +243,10 @@ func (sp *SpanProcessor) fetchNextSpanDetails(id uint64, start uint64) (*types.S
 	return &msg, nil
 }
 
-// fetchNextSpanSeed - fetches seed for next span
-func (sp *SpanProcessor) fetchNextSpanSeed() (nextSpanSeed common.Hash, err error) {
-	sp.Logger.Info("Sending Rest call to Get Seed for next span")
-	response, err := helper.FetchFromAPI(sp.cliCtx, helper.GetHeimdallServerEndpoint(util.NextSpanSeedURL))
+// fetchNextSpanSeed - fetches seed for next span of the bor chain
+func (sp *SpanProcessor) fetchNextSpanSeed(borChainID string) (nextSpanSeed common.Hash, err error) {
+	sp.Logger.Info("Sending Rest call to Get Seed for next span", "borChainID", borChainID)
+	response, err := helper.FetchFromAPI(sp.cliCtx, helper.GetHeimdallServerEndpoint(util.BorChainURL(util.NextSpanSeedURL, borChainID)))
 	if err != nil {
 		sp.Logger.Error("Error Fetching nextspanseed from HeimdallServer ", "error", err)
 		return nextSpanSeed, err
@@ -208,6 +259,11 @@ func (sp *SpanProcessor) fetchNextSpanSeed() (nextSpanSeed common.Hash, err erro
 	return nextSpanSeed, nil
 }
 
+// isFirstSpan returns true if additional bor chain has no span yet, heimdall returns an empty span then
+func isFirstSpan(borChainID string, lastSpan *types.Span) bool {
+	return borChainID != "" && lastSpan.ID == 0 && lastSpan.StartBlock == 0 && lastSpan.EndBlock == 0
+}
+
 // OnStop stops all necessary go routines
 func (sp *SpanProcessor) Stop() {
 
@@ -44,18 +44,30 @@ func (sp *StakingProcessor) Start() error {
 // RegisterTasks - Registers staking tasks with machinery
 func (sp *StakingProcessor) RegisterTasks() {
 	sp.Logger.Info("Registering staking related tasks")
-	if err := sp.queueConnector.Server.RegisterTask("sendValidatorJoinToHeimdall", sp.sendValidatorJoinToHeimdall); err != nil {
+	if err := sp.queueConnector.RegisterTask("sendValidatorJoinToHeimdall", sp.sendValidatorJoinToHeimdall); err != nil {
 		sp.Logger.Error("RegisterTasks | sendValidatorJoinToHeimdall", "error", err)
 	}
-	if err := sp.queueConnector.Server.RegisterTask("sendUnstakeInitToHeimdall", sp.sendUnstakeInitToHeimdall); err != nil {
+	if err := sp.queueConnector.RegisterTask("sendUnstakeInitToHeimdall", sp.sendUnstakeInitToHeimdall); err != nil {
 		sp.Logger.Error("RegisterTasks | sendUnstakeInitToHeimdall", "error", err)
 	}
-	if err := sp.queueConnector.Server.RegisterTask("sendStakeUpdateToHeimdall", sp.sendStakeUpdateToHeimdall); err != nil {
+	if err := sp.queueConnector.RegisterTask("sendStakeUpdateToHeimdall", sp.sendStakeUpdateToHeimdall); err != nil {
 		sp.Logger.Error("RegisterTasks | sendStakeUpdateToHeimdall", "error", err)
 	}
-	if err := sp.queueConnector.Server.RegisterTask("sendSignerChangeToHeimdall", sp.sendSignerChangeToHeimdall); err != nil {
+	if err := sp.queueConnector.RegisterTask("sendSignerChangeToHeimdall", sp.sendSignerChangeToHeimdall); err != nil {
 		sp.Logger.Error("RegisterTasks | sendSignerChangeToHeimdall", "error", err)
 	}
+	if err := sp.queueConnector.RegisterTask("sendShareMintedToHeimdall", sp.sendShareMintedToHeimdall); err != nil {
+		sp.Logger.Error("RegisterTasks | sendShareMintedToHeimdall", "error", err)
+	}
+	if err := sp.queueConnector.RegisterTask("sendShareBurnedToHeimdall", sp.sendShareBurnedToHeimdall); err != nil {
+		sp.Logger.Error("RegisterTasks | sendShareBurnedToHeimdall", "error", err)
+	}
+	if err := sp.queueConnector.RegisterTask("sendDelegatorClaimedRewardsToHeimdall", sp.sendDelegatorClaimedRewardsToHeimdall); err != nil {
+		sp.Logger.Error("RegisterTasks | sendDelegatorClaimedRewardsToHeimdall", "error", err)
+	}
+	if err := sp.queueConnector.RegisterTask("sendUpdateCommissionRateToHeimdall", sp.sendUpdateCommissionRateToHeimdall); err != nil {
+		sp.Logger.Error("RegisterTasks | sendUpdateCommissionRateToHeimdall", "error", err)
+	}
 }
 
 func (sp *StakingProcessor) sendValidatorJoinToHeimdall(eventName string, logBytes string) error {
@@ -73,7 +85,8 @@ func (sp *StakingProcessor) sendValidatorJoinToHeimdall(eventName string, logByt
 		if len(signerPubKey) == 64 {
 			signerPubKey = util.AppendPrefix(signerPubKey)
 		}
-		if isOld, _ := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index), util.StakingEvent); isOld {
+		isOld, err := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index), util.StakingEvent)
+		if isOld {
 			sp.Logger.Info("Ignoring task to send validatorjoin to heimdall as already processed",
 				"event", eventName,
 				"validatorID", event.ValidatorId,
@@ -88,6 +101,9 @@ func (sp *StakingProcessor) sendValidatorJoinToHeimdall(eventName string, logByt
 			)
 			return nil
 		}
+		if inFlight, ok := err.(tasks.ErrRetryTaskLater); ok {
+			return inFlight
+		}
 
 		// if account doesn't exists Retry with delay for topup to process first.
 		if _, err := util.GetAccount(sp.cliCtx, hmTypes.HeimdallAddress(event.Signer)); err != nil {
@@ -131,6 +147,8 @@ func (sp *StakingProcessor) sendValidatorJoinToHeimdall(eventName string, logByt
 			sp.Logger.Error("Error while broadcasting unstakeInit to heimdall", "validatorId", event.ValidatorId.Uint64(), "error", err)
 			return err
 		}
+
+		sp.markInFlight(msg)
 	}
 	return nil
 }
@@ -146,7 +164,8 @@ func (sp *StakingProcessor) sendUnstakeInitToHeimdall(eventName string, logBytes
 	if err := helper.UnpackLog(sp.stakingInfoAbi, event, eventName, &vLog); err != nil {
 		sp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
 	} else {
-		if isOld, _ := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index), util.StakingEvent); isOld {
+		isOld, err := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index), util.StakingEvent)
+		if isOld {
 			sp.Logger.Info("Ignoring task to send unstakeinit to heimdall as already processed",
 				"event", eventName,
 				"validator", event.User,
@@ -160,6 +179,9 @@ func (sp *StakingProcessor) sendUnstakeInitToHeimdall(eventName string, logBytes
 			)
 			return nil
 		}
+		if inFlight, ok := err.(tasks.ErrRetryTaskLater); ok {
+			return inFlight
+		}
 
 		validNonce, nonceDelay, err := sp.checkValidNonce(event.ValidatorId.Uint64(), event.Nonce.Uint64())
 		if err != nil {
@@ -201,6 +223,8 @@ func (sp *StakingProcessor) sendUnstakeInitToHeimdall(eventName string, logBytes
 			sp.Logger.Error("Error while broadcasting unstakeInit to heimdall", "validatorId", event.ValidatorId.Uint64(), "error", err)
 			return err
 		}
+
+		sp.markInFlight(msg)
 	}
 	return nil
 }
@@ -216,7 +240,8 @@ func (sp *StakingProcessor) sendStakeUpdateToHeimdall(eventName string, logBytes
 	if err := helper.UnpackLog(sp.stakingInfoAbi, event, eventName, &vLog); err != nil {
 		sp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
 	} else {
-		if isOld, _ := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index), util.StakingEvent); isOld {
+		isOld, err := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index), util.StakingEvent)
+		if isOld {
 			sp.Logger.Info("Ignoring task to send unstakeinit to heimdall as already processed",
 				"event", eventName,
 				"validatorID", event.ValidatorId,
@@ -228,6 +253,9 @@ func (sp *StakingProcessor) sendStakeUpdateToHeimdall(eventName string, logBytes
 			)
 			return nil
 		}
+		if inFlight, ok := err.(tasks.ErrRetryTaskLater); ok {
+			return inFlight
+		}
 
 		validNonce, nonceDelay, err := sp.checkValidNonce(event.ValidatorId.Uint64(), event.Nonce.Uint64())
 		if err != nil {
@@ -267,6 +295,8 @@ func (sp *StakingProcessor) sendStakeUpdateToHeimdall(eventName string, logBytes
 			sp.Logger.Error("Error while broadcasting stakeupdate to heimdall", "validatorId", event.ValidatorId.Uint64(), "error", err)
 			return err
 		}
+
+		sp.markInFlight(msg)
 	}
 	return nil
 }
@@ -287,7 +317,8 @@ func (sp *StakingProcessor) sendSignerChangeToHeimdall(eventName string, logByte
 			newSignerPubKey = util.AppendPrefix(newSignerPubKey)
 		}
 
-		if isOld, _ := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index), util.StakingEvent); isOld {
+		isOld, err := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index), util.StakingEvent)
+		if isOld {
 			sp.Logger.Info("Ignoring task to send unstakeinit to heimdall as already processed",
 				"event", eventName,
 				"validatorID", event.ValidatorId,
@@ -301,6 +332,9 @@ func (sp *StakingProcessor) sendSignerChangeToHeimdall(eventName string, logByte
 			)
 			return nil
 		}
+		if inFlight, ok := err.(tasks.ErrRetryTaskLater); ok {
+			return inFlight
+		}
 
 		validNonce, nonceDelay, err := sp.checkValidNonce(event.ValidatorId.Uint64(), event.Nonce.Uint64())
 		if err != nil {
@@ -342,6 +376,260 @@ func (sp *StakingProcessor) sendSignerChangeToHeimdall(eventName string, logByte
 			sp.Logger.Error("Error while broadcasting signerChainge to heimdall", "validatorId", event.ValidatorId.Uint64(), "error", err)
 			return err
 		}
+
+		sp.markInFlight(msg)
+	}
+	return nil
+}
+
+func (sp *StakingProcessor) sendShareMintedToHeimdall(eventName string, logBytes string) error {
+	var vLog = types.Log{}
+	if err := json.Unmarshal([]byte(logBytes), &vLog); err != nil {
+		sp.Logger.Error("Error while unmarshalling event from rootchain", "error", err)
+		return err
+	}
+
+	event := new(stakinginfo.StakinginfoShareMinted)
+	if err := helper.UnpackLog(sp.stakingInfoAbi, event, eventName, &vLog); err != nil {
+		sp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
+	} else {
+		isOld, err := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index), util.StakingEvent)
+		if isOld {
+			sp.Logger.Info("Ignoring task to send share-minted to heimdall as already processed",
+				"event", eventName,
+				"validatorID", event.ValidatorId,
+				"user", event.User,
+				"amount", event.Amount,
+				"tokens", event.Tokens,
+				"txHash", hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+				"logIndex", uint64(vLog.Index),
+				"blockNumber", vLog.BlockNumber,
+			)
+			return nil
+		}
+		if inFlight, ok := err.(tasks.ErrRetryTaskLater); ok {
+			return inFlight
+		}
+
+		sp.Logger.Info(
+			"✅ Received task to send share-minted to heimdall",
+			"event", eventName,
+			"validatorID", event.ValidatorId,
+			"user", event.User,
+			"amount", event.Amount,
+			"tokens", event.Tokens,
+			"txHash", hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			"logIndex", uint64(vLog.Index),
+			"blockNumber", vLog.BlockNumber,
+		)
+
+		// msg share-minted
+		msg := stakingTypes.NewMsgShareMinted(
+			hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+			event.ValidatorId.Uint64(),
+			hmTypes.BytesToHeimdallAddress(event.User.Bytes()),
+			sdk.NewIntFromBigInt(event.Amount),
+			sdk.NewIntFromBigInt(event.Tokens),
+			hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			uint64(vLog.Index),
+			vLog.BlockNumber,
+		)
+
+		// return broadcast to heimdall
+		if err := sp.txBroadcaster.BroadcastToHeimdall(msg); err != nil {
+			sp.Logger.Error("Error while broadcasting share-minted to heimdall", "validatorId", event.ValidatorId.Uint64(), "error", err)
+			return err
+		}
+
+		sp.markInFlight(msg)
+	}
+	return nil
+}
+
+func (sp *StakingProcessor) sendShareBurnedToHeimdall(eventName string, logBytes string) error {
+	var vLog = types.Log{}
+	if err := json.Unmarshal([]byte(logBytes), &vLog); err != nil {
+		sp.Logger.Error("Error while unmarshalling event from rootchain", "error", err)
+		return err
+	}
+
+	event := new(stakinginfo.StakinginfoShareBurned)
+	if err := helper.UnpackLog(sp.stakingInfoAbi, event, eventName, &vLog); err != nil {
+		sp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
+	} else {
+		isOld, err := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index), util.StakingEvent)
+		if isOld {
+			sp.Logger.Info("Ignoring task to send share-burned to heimdall as already processed",
+				"event", eventName,
+				"validatorID", event.ValidatorId,
+				"user", event.User,
+				"amount", event.Amount,
+				"tokens", event.Tokens,
+				"txHash", hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+				"logIndex", uint64(vLog.Index),
+				"blockNumber", vLog.BlockNumber,
+			)
+			return nil
+		}
+		if inFlight, ok := err.(tasks.ErrRetryTaskLater); ok {
+			return inFlight
+		}
+
+		sp.Logger.Info(
+			"✅ Received task to send share-burned to heimdall",
+			"event", eventName,
+			"validatorID", event.ValidatorId,
+			"user", event.User,
+			"amount", event.Amount,
+			"tokens", event.Tokens,
+			"txHash", hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			"logIndex", uint64(vLog.Index),
+			"blockNumber", vLog.BlockNumber,
+		)
+
+		// msg share-burned
+		msg := stakingTypes.NewMsgShareBurned(
+			hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+			event.ValidatorId.Uint64(),
+			hmTypes.BytesToHeimdallAddress(event.User.Bytes()),
+			sdk.NewIntFromBigInt(event.Amount),
+			sdk.NewIntFromBigInt(event.Tokens),
+			hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			uint64(vLog.Index),
+			vLog.BlockNumber,
+		)
+
+		// return broadcast to heimdall
+		if err := sp.txBroadcaster.BroadcastToHeimdall(msg); err != nil {
+			sp.Logger.Error("Error while broadcasting share-burned to heimdall", "validatorId", event.ValidatorId.Uint64(), "error", err)
+			return err
+		}
+
+		sp.markInFlight(msg)
+	}
+	return nil
+}
+
+func (sp *StakingProcessor) sendDelegatorClaimedRewardsToHeimdall(eventName string, logBytes string) error {
+	var vLog = types.Log{}
+	if err := json.Unmarshal([]byte(logBytes), &vLog); err != nil {
+		sp.Logger.Error("Error while unmarshalling event from rootchain", "error", err)
+		return err
+	}
+
+	event := new(stakinginfo.StakinginfoDelClaimRewards)
+	if err := helper.UnpackLog(sp.stakingInfoAbi, event, eventName, &vLog); err != nil {
+		sp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
+	} else {
+		isOld, err := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index), util.StakingEvent)
+		if isOld {
+			sp.Logger.Info("Ignoring task to send delegator-claimed-rewards to heimdall as already processed",
+				"event", eventName,
+				"validatorID", event.ValidatorId,
+				"user", event.User,
+				"rewards", event.Rewards,
+				"tokens", event.Tokens,
+				"txHash", hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+				"logIndex", uint64(vLog.Index),
+				"blockNumber", vLog.BlockNumber,
+			)
+			return nil
+		}
+		if inFlight, ok := err.(tasks.ErrRetryTaskLater); ok {
+			return inFlight
+		}
+
+		sp.Logger.Info(
+			"✅ Received task to send delegator-claimed-rewards to heimdall",
+			"event", eventName,
+			"validatorID", event.ValidatorId,
+			"user", event.User,
+			"rewards", event.Rewards,
+			"tokens", event.Tokens,
+			"txHash", hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			"logIndex", uint64(vLog.Index),
+			"blockNumber", vLog.BlockNumber,
+		)
+
+		// msg delegator-claimed-rewards
+		msg := stakingTypes.NewMsgDelegatorClaimedRewards(
+			hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+			event.ValidatorId.Uint64(),
+			hmTypes.BytesToHeimdallAddress(event.User.Bytes()),
+			sdk.NewIntFromBigInt(event.Rewards),
+			sdk.NewIntFromBigInt(event.Tokens),
+			hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			uint64(vLog.Index),
+			vLog.BlockNumber,
+		)
+
+		// return broadcast to heimdall
+		if err := sp.txBroadcaster.BroadcastToHeimdall(msg); err != nil {
+			sp.Logger.Error("Error while broadcasting delegator-claimed-rewards to heimdall", "validatorId", event.ValidatorId.Uint64(), "error", err)
+			return err
+		}
+
+		sp.markInFlight(msg)
+	}
+	return nil
+}
+
+func (sp *StakingProcessor) sendUpdateCommissionRateToHeimdall(eventName string, logBytes string) error {
+	var vLog = types.Log{}
+	if err := json.Unmarshal([]byte(logBytes), &vLog); err != nil {
+		sp.Logger.Error("Error while unmarshalling event from rootchain", "error", err)
+		return err
+	}
+
+	event := new(stakinginfo.StakinginfoUpdateCommissionRate)
+	if err := helper.UnpackLog(sp.stakingInfoAbi, event, eventName, &vLog); err != nil {
+		sp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
+	} else {
+		isOld, err := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index), util.StakingEvent)
+		if isOld {
+			sp.Logger.Info("Ignoring task to send update-commission-rate to heimdall as already processed",
+				"event", eventName,
+				"validatorID", event.ValidatorId,
+				"newCommissionRate", event.NewCommissionRate,
+				"oldCommissionRate", event.OldCommissionRate,
+				"txHash", hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+				"logIndex", uint64(vLog.Index),
+				"blockNumber", vLog.BlockNumber,
+			)
+			return nil
+		}
+		if inFlight, ok := err.(tasks.ErrRetryTaskLater); ok {
+			return inFlight
+		}
+
+		sp.Logger.Info(
+			"✅ Received task to send update-commission-rate to heimdall",
+			"event", eventName,
+			"validatorID", event.ValidatorId,
+			"newCommissionRate", event.NewCommissionRate,
+			"oldCommissionRate", event.OldCommissionRate,
+			"txHash", hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			"logIndex", uint64(vLog.Index),
+			"blockNumber", vLog.BlockNumber,
+		)
+
+		// msg update-commission-rate
+		msg := stakingTypes.NewMsgUpdateCommissionRate(
+			hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+			event.ValidatorId.Uint64(),
+			event.NewCommissionRate.Uint64(),
+			hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			uint64(vLog.Index),
+			vLog.BlockNumber,
+		)
+
+		// return broadcast to heimdall
+		if err := sp.txBroadcaster.BroadcastToHeimdall(msg); err != nil {
+			sp.Logger.Error("Error while broadcasting update-commission-rate to heimdall", "validatorId", event.ValidatorId.Uint64(), "error", err)
+			return err
+		}
+
+		sp.markInFlight(msg)
 	}
 	return nil
 }
@@ -1,30 +1,117 @@
 package queue
 
 import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/spf13/viper"
 	"github.com/streadway/amqp"
+	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/RichardKnop/machinery/v1"
+	"github.com/RichardKnop/machinery/v1/backends/null"
 	"github.com/RichardKnop/machinery/v1/config"
+	"github.com/RichardKnop/machinery/v1/tasks"
 
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 )
 
 type QueueConnector struct {
 	logger log.Logger
 	Server *machinery.Server
+
+	// selected backend
+	Backend string
+
+	// failed tasks
+	DeadLetters *DeadLetterStore
 }
 
 const (
 	// machinery task queue
 	QueueName = "machinery_tasks"
+
+	// queue backends
+	AMQPBackend     = "amqp"
+	EmbeddedBackend = "embedded"
 )
 
-func NewQueueConnector(dialer string) *QueueConnector {
+// NewQueueConnector creates queue connector for selected backend.
+// `amqp` uses RabbitMQ at dialer, `embedded` stores tasks in bridge db.
+func NewQueueConnector(backend string, dialer string) *QueueConnector {
+	var server *machinery.Server
+	var err error
+
+	db := util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag))
+
+	switch backend {
+	case EmbeddedBackend:
+		server = newEmbeddedServer(db)
+	case AMQPBackend, "":
+		backend = AMQPBackend
+		server, err = newAMQPServer(dialer)
+	default:
+		err = fmt.Errorf("invalid task queue backend: %v", backend)
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return newQueueConnector(backend, server, db)
+}
+
+// NewEmbeddedQueueConnector creates queue connector with embedded backend storing tasks and dead letters in given db
+func NewEmbeddedQueueConnector(db *leveldb.DB) *QueueConnector {
+	return newQueueConnector(EmbeddedBackend, newEmbeddedServer(db), db)
+}
+
+func newQueueConnector(backend string, server *machinery.Server, db *leveldb.DB) *QueueConnector {
+	// route tasks which failed all retries to dead-letter store, and delay retries by task retry policy
+	deadLetters := NewDeadLetterStore(db)
+	server.SetPreTaskHandler(func(signature *tasks.Signature) {
+		attachDeadLetterCallback(signature)
+		recordPublish(signature)
+		scheduleRetryNow(signature)
+	})
+	if err := server.RegisterTask(DeadLetterTaskName, deadLetters.storeDeadLetter); err != nil {
+		panic(err)
+	}
+
+	// queue connector
+	connector := QueueConnector{
+		logger:      util.Logger().With("module", "QueueConnector"),
+		Server:      server,
+		Backend:     backend,
+		DeadLetters: deadLetters,
+	}
+
+	// connector
+	return &connector
+}
+
+// RegisterTask registers task with machinery, recording its executions in metrics
+func (qc *QueueConnector) RegisterTask(name string, taskFunc interface{}) error {
+	return qc.Server.RegisterTask(name, instrumentTask(name, taskFunc))
+}
+
+// StartWorker - starts worker to process registered tasks
+func (qc *QueueConnector) StartWorker() {
+	worker := qc.Server.NewWorker("invoke-processor", 10)
+	qc.logger.Info("Starting machinery worker", "backend", qc.Backend)
+	errors := make(chan error)
+	worker.LaunchAsync(errors)
+}
+
+// newAMQPServer creates machinery server with AMQP broker and result backend
+func newAMQPServer(dialer string) (*machinery.Server, error) {
 	// amqp dialer
 	_, err := amqp.Dial(dialer)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
 	var cnf = &config.Config{
@@ -38,25 +125,59 @@ func NewQueueConnector(dialer string) *QueueConnector {
 		},
 	}
 
-	server, err := machinery.NewServer(cnf)
-	if err != nil {
-		// do something with the error
+	return machinery.NewServer(cnf)
+}
+
+// newEmbeddedServer creates machinery server with embedded broker on given db.
+// Task results are not needed by bridge, so they are not stored.
+func newEmbeddedServer(db *leveldb.DB) *machinery.Server {
+	var cnf = &config.Config{
+		Broker:        EmbeddedBackend,
+		DefaultQueue:  QueueName,
+		ResultBackend: "null",
 	}
 
-	// queue connector
-	connector := QueueConnector{
-		logger: util.Logger().With("module", "QueueConnector"),
-		Server: server,
+	return machinery.NewServerWithBrokerBackend(cnf, NewEmbeddedBroker(cnf, db), null.New())
+}
+
+// instrumentTask wraps task function to record its latency and result
+func instrumentTask(name string, taskFunc interface{}) interface{} {
+	fn := reflect.ValueOf(taskFunc)
+	if fn.Kind() != reflect.Func || fn.Type().NumOut() == 0 {
+		// let machinery reject invalid task
+		return taskFunc
 	}
 
-	// connector
-	return &connector
+	return reflect.MakeFunc(fn.Type(), func(args []reflect.Value) []reflect.Value {
+		start := time.Now()
+
+		var results []reflect.Value
+		if fn.Type().IsVariadic() {
+			results = fn.CallSlice(args)
+		} else {
+			results = fn.Call(args)
+		}
+
+		metrics.TaskDuration.WithLabelValues(name).Observe(time.Since(start).Seconds())
+		if err, ok := results[len(results)-1].Interface().(error); ok && err != nil {
+			metrics.TasksFailed.WithLabelValues(name).Inc()
+		} else {
+			metrics.TasksSucceeded.WithLabelValues(name).Inc()
+		}
+
+		return results
+	}).Interface()
 }
 
-// StartWorker - starts worker to process registered tasks
-func (qc *QueueConnector) StartWorker() {
-	worker := qc.Server.NewWorker("invoke-processor", 10)
-	qc.logger.Info("Starting machinery worker")
-	errors := make(chan error)
-	worker.LaunchAsync(errors)
+// recordPublish counts published task as enqueued or retried based on its attempts
+func recordPublish(signature *tasks.Signature) {
+	if signature.Name == DeadLetterTaskName {
+		return
+	}
+
+	if getAttempts(signature) > 1 {
+		metrics.TasksRetried.WithLabelValues(signature.Name).Inc()
+	} else {
+		metrics.TasksEnqueued.WithLabelValues(signature.Name).Inc()
+	}
 }
@@ -0,0 +1,48 @@
+package queue
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
+)
+
+func TestInstrumentTask(t *testing.T) {
+	taskFunc := func(eventName string, fail bool) error {
+		if fail {
+			return errors.New("failed")
+		}
+		return nil
+	}
+
+	instrumented, ok := instrumentTask("testTask", taskFunc).(func(string, bool) error)
+	require.True(t, ok, "instrumented task should keep the task signature")
+
+	require.NoError(t, instrumented("event", false))
+	require.Error(t, instrumented("event", true))
+	require.Error(t, instrumented("event", true))
+
+	require.Equal(t, float64(1), testutil.ToFloat64(metrics.TasksSucceeded.WithLabelValues("testTask")))
+	require.Equal(t, float64(2), testutil.ToFloat64(metrics.TasksFailed.WithLabelValues("testTask")))
+
+	// machinery should accept instrumented task
+	_, err := tasks.New(instrumented, []tasks.Arg{{Type: "string", Value: "event"}, {Type: "bool", Value: false}})
+	require.NoError(t, err)
+}
+
+func TestRecordPublish(t *testing.T) {
+	signature := &tasks.Signature{Name: "publishTask"}
+
+	// first publish is enqueue, next ones are retries
+	for i := 0; i < 3; i++ {
+		attachDeadLetterCallback(signature)
+		recordPublish(signature)
+	}
+
+	require.Equal(t, float64(1), testutil.ToFloat64(metrics.TasksEnqueued.WithLabelValues("publishTask")))
+	require.Equal(t, float64(2), testutil.ToFloat64(metrics.TasksRetried.WithLabelValues("publishTask")))
+}
@@ -0,0 +1,227 @@
+package queue
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/syndtr/goleveldb/leveldb"
+	levelUtil "github.com/syndtr/goleveldb/leveldb/util"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
+)
+
+const (
+	// DeadLetterTaskName is the error callback task which stores failed tasks
+	DeadLetterTaskName = "storeDeadLetterTask"
+
+	// DefaultReplayRetryCount is the retry count of replayed tasks
+	DefaultReplayRetryCount = 3
+
+	deadLetterPrefix = "dlq-" // storage key prefix
+	attemptsHeader   = "attempts"
+)
+
+// ErrDeadLetterNotFound is returned when dead-letter entry doesn't exist
+var ErrDeadLetterNotFound = errors.New("dead-letter task not found")
+
+// DeadLetter represents a task which failed after all retries
+type DeadLetter struct {
+	UUID      string      `json:"uuid"`
+	TaskName  string      `json:"taskName"`
+	Args      []tasks.Arg `json:"args"`
+	LastError string      `json:"lastError"`
+	Attempts  int64       `json:"attempts"`
+	FailedAt  time.Time   `json:"failedAt"`
+}
+
+// DeadLetterStore keeps failed tasks in bridge db
+type DeadLetterStore struct {
+	db *leveldb.DB
+}
+
+// NewDeadLetterStore creates dead-letter store on given db
+func NewDeadLetterStore(db *leveldb.DB) *DeadLetterStore {
+	return &DeadLetterStore{db: db}
+}
+
+// Put stores dead-letter entry
+func (s *DeadLetterStore) Put(deadLetter *DeadLetter) error {
+	value, err := json.Marshal(deadLetter)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Put(deadLetterKey(deadLetter.UUID), value, nil)
+}
+
+// Get returns dead-letter entry by task uuid
+func (s *DeadLetterStore) Get(uuid string) (*DeadLetter, error) {
+	value, err := s.db.Get(deadLetterKey(uuid), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, ErrDeadLetterNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return decodeDeadLetter(value)
+}
+
+// List returns all dead-letter entries
+func (s *DeadLetterStore) List() ([]*DeadLetter, error) {
+	result := make([]*DeadLetter, 0)
+
+	iter := s.db.NewIterator(levelUtil.BytesPrefix([]byte(deadLetterPrefix)), nil)
+	defer iter.Release()
+	for iter.Next() {
+		deadLetter, err := decodeDeadLetter(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, deadLetter)
+	}
+
+	return result, iter.Error()
+}
+
+// Delete removes dead-letter entry
+func (s *DeadLetterStore) Delete(uuid string) error {
+	if has, err := s.db.Has(deadLetterKey(uuid), nil); err != nil {
+		return err
+	} else if !has {
+		return ErrDeadLetterNotFound
+	}
+
+	return s.db.Delete(deadLetterKey(uuid), nil)
+}
+
+// Replay publishes dead-letter task again and removes it from the store
+func (s *DeadLetterStore) Replay(qc *QueueConnector, uuid string, retryCount int) error {
+	deadLetter, err := s.Get(uuid)
+	if err != nil {
+		return err
+	}
+
+	signature := &tasks.Signature{
+		Name:       deadLetter.TaskName,
+		Args:       deadLetter.Args,
+		RetryCount: retryCount,
+	}
+	if _, err := qc.Server.SendTask(signature); err != nil {
+		return err
+	}
+
+	return s.db.Delete(deadLetterKey(uuid), nil)
+}
+
+// storeDeadLetter is called by machinery when a task fails after all retries
+func (s *DeadLetterStore) storeDeadLetter(taskErr string, taskName string, taskUUID string, args string, attempts int64) error {
+	var taskArgs []tasks.Arg
+	decoder := json.NewDecoder(bytes.NewReader([]byte(args)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&taskArgs); err != nil {
+		return err
+	}
+
+	metrics.TasksDeadLettered.WithLabelValues(taskName).Inc()
+	return s.Put(&DeadLetter{
+		UUID:      taskUUID,
+		TaskName:  taskName,
+		Args:      taskArgs,
+		LastError: taskErr,
+		Attempts:  attempts,
+		FailedAt:  time.Now().UTC(),
+	})
+}
+
+// attachDeadLetterCallback counts publish attempts of a task and adds an error callback,
+// so the task lands in dead-letter store once machinery gives up on it.
+func attachDeadLetterCallback(signature *tasks.Signature) {
+	if signature.Name == DeadLetterTaskName {
+		return
+	}
+
+	attempts := getAttempts(signature) + 1
+	if signature.Headers == nil {
+		signature.Headers = tasks.Headers{}
+	}
+	signature.Headers[attemptsHeader] = attempts
+
+	argsBytes, err := json.Marshal(signature.Args)
+	if err != nil {
+		return
+	}
+
+	signature.OnError = []*tasks.Signature{
+		{
+			Name: DeadLetterTaskName,
+			Args: []tasks.Arg{
+				{
+					Type:  "string",
+					Value: signature.Name,
+				},
+				{
+					Type:  "string",
+					Value: signature.UUID,
+				},
+				{
+					Type:  "string",
+					Value: string(argsBytes),
+				},
+				{
+					Type:  "int64",
+					Value: attempts,
+				},
+			},
+		},
+	}
+}
+
+//
+// utils
+//
+
+func getAttempts(signature *tasks.Signature) int64 {
+	return getInt64Header(signature, attemptsHeader)
+}
+
+// getInt64Header returns integer header of task, which brokers may have decoded as float, json number or string
+func getInt64Header(signature *tasks.Signature, key string) int64 {
+	value, ok := signature.Headers[key]
+	if !ok {
+		return 0
+	}
+
+	switch v := value.(type) {
+	case int64:
+		return v
+	case float64:
+		return int64(v)
+	case json.Number:
+		number, _ := v.Int64()
+		return number
+	case string:
+		number, _ := strconv.ParseInt(v, 10, 64)
+		return number
+	}
+
+	return 0
+}
+
+func deadLetterKey(uuid string) []byte {
+	return []byte(deadLetterPrefix + uuid)
+}
+
+func decodeDeadLetter(value []byte) (*DeadLetter, error) {
+	var deadLetter DeadLetter
+	decoder := json.NewDecoder(bytes.NewReader(value))
+	decoder.UseNumber()
+	if err := decoder.Decode(&deadLetter); err != nil {
+		return nil, err
+	}
+
+	return &deadLetter, nil
+}
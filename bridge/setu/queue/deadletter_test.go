@@ -0,0 +1,64 @@
+package queue
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+func TestDeadLetterCallback(t *testing.T) {
+	signature := &tasks.Signature{
+		UUID: "task_1",
+		Name: "sendStateSyncedToHeimdall",
+		Args: []tasks.Arg{
+			{Type: "string", Value: "StateSynced"},
+			{Type: "int64", Value: int64(10)},
+		},
+	}
+
+	// every publish (first send and retries) counts as an attempt
+	attachDeadLetterCallback(signature)
+	attachDeadLetterCallback(signature)
+	require.Equal(t, int64(2), getAttempts(signature))
+	require.Len(t, signature.OnError, 1)
+	require.Equal(t, DeadLetterTaskName, signature.OnError[0].Name)
+
+	// dead-letter task itself is never routed to dead-letter store
+	callback := signature.OnError[0]
+	attachDeadLetterCallback(callback)
+	require.Empty(t, callback.OnError)
+
+	// machinery prepends error to callback args
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+	store := NewDeadLetterStore(db)
+
+	args := make([]interface{}, 0, len(callback.Args))
+	for _, arg := range callback.Args {
+		args = append(args, arg.Value)
+	}
+	err = store.storeDeadLetter("broadcast failed", args[0].(string), args[1].(string), args[2].(string), args[3].(int64))
+	require.NoError(t, err)
+
+	deadLetters, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+
+	deadLetter, err := store.Get("task_1")
+	require.NoError(t, err)
+	require.Equal(t, "sendStateSyncedToHeimdall", deadLetter.TaskName)
+	require.Equal(t, "broadcast failed", deadLetter.LastError)
+	require.Equal(t, int64(2), deadLetter.Attempts)
+	require.Equal(t, "StateSynced", deadLetter.Args[0].Value)
+	require.Equal(t, json.Number("10"), deadLetter.Args[1].Value)
+
+	require.NoError(t, store.Delete("task_1"))
+	_, err = store.Get("task_1")
+	require.Equal(t, ErrDeadLetterNotFound, err)
+	require.Equal(t, ErrDeadLetterNotFound, store.Delete("task_1"))
+}
@@ -0,0 +1,331 @@
+package queue
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/brokers/iface"
+	"github.com/RichardKnop/machinery/v1/common"
+	"github.com/RichardKnop/machinery/v1/config"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/syndtr/goleveldb/leveldb"
+	levelUtil "github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+const (
+	// storage key prefixes for embedded queue
+	embeddedPendingPrefix  = "queue-pending-"
+	embeddedInflightPrefix = "queue-inflight-"
+
+	// DefaultEmbeddedPollInterval is the interval at which embedded broker looks for due tasks
+	DefaultEmbeddedPollInterval = 1 * time.Second
+)
+
+// EmbeddedBroker is a machinery broker which persists tasks in bridge leveldb.
+// Delayed tasks are stored under their ETA and picked up once they are due.
+// Tasks which were being processed during a crash are re-queued on next start.
+type EmbeddedBroker struct {
+	common.Broker
+
+	logger       log.Logger
+	db           *leveldb.DB
+	pollInterval time.Duration
+
+	// wakes up consumer when a task is published
+	wakeup chan struct{}
+
+	// serializes claiming of due tasks
+	claimMutex sync.Mutex
+
+	processingWG sync.WaitGroup
+}
+
+// NewEmbeddedBroker creates new embedded broker on top of given leveldb instance
+func NewEmbeddedBroker(cnf *config.Config, db *leveldb.DB) *EmbeddedBroker {
+	return &EmbeddedBroker{
+		Broker:       common.NewBroker(cnf),
+		logger:       util.Logger().With("module", "EmbeddedBroker"),
+		db:           db,
+		pollInterval: DefaultEmbeddedPollInterval,
+		wakeup:       make(chan struct{}, 1),
+	}
+}
+
+// StartConsuming enters a loop and processes due tasks until broker is stopped
+func (b *EmbeddedBroker) StartConsuming(consumerTag string, concurrency int, taskProcessor iface.TaskProcessor) (bool, error) {
+	b.Broker.StartConsuming(consumerTag, concurrency, taskProcessor)
+
+	// move tasks left in-flight by previous run back to pending
+	if err := b.requeueInflight(); err != nil {
+		return b.GetRetry(), err
+	}
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	pool := make(chan struct{}, concurrency)
+
+	ticker := time.NewTicker(b.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.GetStopChan():
+			b.processingWG.Wait()
+			return b.GetRetry(), nil
+		case <-ticker.C:
+		case <-b.wakeup:
+		}
+
+		signatures, err := b.claimDueTasks(concurrency - len(pool))
+		if err != nil {
+			return b.GetRetry(), err
+		}
+
+		for _, signature := range signatures {
+			pool <- struct{}{}
+			b.processingWG.Add(1)
+			go func(signature *tasks.Signature) {
+				defer func() {
+					b.processingWG.Done()
+					<-pool
+				}()
+				b.consumeOne(signature, taskProcessor)
+			}(signature)
+		}
+	}
+}
+
+// StopConsuming quits the loop and waits for running tasks
+func (b *EmbeddedBroker) StopConsuming() {
+	b.Broker.StopConsuming()
+	b.processingWG.Wait()
+}
+
+// Publish stores a new task in the queue. Tasks with ETA are consumed once ETA is reached.
+func (b *EmbeddedBroker) Publish(ctx context.Context, signature *tasks.Signature) error {
+	// Adjust routing key (this decides which queue the message will be published to)
+	b.AdjustRoutingKey(signature)
+
+	message, err := json.Marshal(signature)
+	if err != nil {
+		return fmt.Errorf("JSON marshal error: %s", err)
+	}
+
+	eta := time.Now().UTC()
+	if signature.ETA != nil {
+		eta = *signature.ETA
+	}
+
+	if err := b.db.Put(pendingTaskKey(eta, signature.UUID), message, nil); err != nil {
+		return err
+	}
+
+	// notify consumer
+	select {
+	case b.wakeup <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// GetPendingTasks returns tasks which are due but not yet processed
+func (b *EmbeddedBroker) GetPendingTasks(queue string) ([]*tasks.Signature, error) {
+	now := time.Now().UTC()
+	return b.getTasks(func(eta time.Time) bool {
+		return !eta.After(now)
+	})
+}
+
+// GetDelayedTasks returns tasks which are scheduled in future
+func (b *EmbeddedBroker) GetDelayedTasks() ([]*tasks.Signature, error) {
+	now := time.Now().UTC()
+	return b.getTasks(func(eta time.Time) bool {
+		return eta.After(now)
+	})
+}
+
+// Purge removes all pending and in-flight tasks from the queue
+func (b *EmbeddedBroker) Purge() error {
+	return PurgeEmbeddedQueue(b.db)
+}
+
+// PurgeEmbeddedQueue removes all tasks stored by embedded broker in given db
+func PurgeEmbeddedQueue(db *leveldb.DB) error {
+	batch := new(leveldb.Batch)
+	for _, prefix := range []string{embeddedPendingPrefix, embeddedInflightPrefix} {
+		iter := db.NewIterator(levelUtil.BytesPrefix([]byte(prefix)), nil)
+		for iter.Next() {
+			batch.Delete(append([]byte{}, iter.Key()...))
+		}
+		iter.Release()
+		if err := iter.Error(); err != nil {
+			return err
+		}
+	}
+
+	return db.Write(batch, nil)
+}
+
+// consumeOne processes a single task and removes it from in-flight tasks
+func (b *EmbeddedBroker) consumeOne(signature *tasks.Signature, taskProcessor iface.TaskProcessor) {
+	if err := taskProcessor.Process(signature); err != nil {
+		b.logger.Error("Error while processing task", "taskName", signature.Name, "uuid", signature.UUID, "error", err)
+	}
+
+	if err := b.db.Delete(inflightTaskKey(signature.UUID), nil); err != nil {
+		b.logger.Error("Error while removing in-flight task", "taskName", signature.Name, "uuid", signature.UUID, "error", err)
+	}
+}
+
+// claimDueTasks moves up to limit due tasks from pending to in-flight
+func (b *EmbeddedBroker) claimDueTasks(limit int) ([]*tasks.Signature, error) {
+	b.claimMutex.Lock()
+	defer b.claimMutex.Unlock()
+
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	now := time.Now().UTC()
+	result := make([]*tasks.Signature, 0, limit)
+	batch := new(leveldb.Batch)
+
+	iter := b.db.NewIterator(levelUtil.BytesPrefix([]byte(embeddedPendingPrefix)), nil)
+	for iter.Next() && len(result) < limit {
+		eta, err := parsePendingTaskKey(iter.Key())
+		if err != nil {
+			b.logger.Error("Invalid pending task key, dropping it", "key", string(iter.Key()), "error", err)
+			batch.Delete(append([]byte{}, iter.Key()...))
+			continue
+		}
+
+		// keys are sorted by eta
+		if eta.After(now) {
+			break
+		}
+
+		signature, err := decodeSignature(iter.Value())
+		if err != nil {
+			b.logger.Error("Invalid pending task, dropping it", "key", string(iter.Key()), "error", err)
+			batch.Delete(append([]byte{}, iter.Key()...))
+			continue
+		}
+
+		// leave it for workers which have this task registered
+		if !b.IsTaskRegistered(signature.Name) {
+			if signature.IgnoreWhenTaskNotRegistered {
+				batch.Delete(append([]byte{}, iter.Key()...))
+			}
+			continue
+		}
+
+		batch.Delete(append([]byte{}, iter.Key()...))
+		batch.Put(inflightTaskKey(signature.UUID), append([]byte{}, iter.Value()...))
+		result = append(result, signature)
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	if err := b.db.Write(batch, nil); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// requeueInflight moves all in-flight tasks back to pending so they are processed again
+func (b *EmbeddedBroker) requeueInflight() error {
+	b.claimMutex.Lock()
+	defer b.claimMutex.Unlock()
+
+	now := time.Now().UTC()
+	batch := new(leveldb.Batch)
+
+	iter := b.db.NewIterator(levelUtil.BytesPrefix([]byte(embeddedInflightPrefix)), nil)
+	for iter.Next() {
+		batch.Delete(append([]byte{}, iter.Key()...))
+
+		signature, err := decodeSignature(iter.Value())
+		if err != nil {
+			b.logger.Error("Invalid in-flight task, dropping it", "key", string(iter.Key()), "error", err)
+			continue
+		}
+
+		b.logger.Info("Re-queueing in-flight task", "taskName", signature.Name, "uuid", signature.UUID)
+		batch.Put(pendingTaskKey(now, signature.UUID), append([]byte{}, iter.Value()...))
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	return b.db.Write(batch, nil)
+}
+
+func (b *EmbeddedBroker) getTasks(filter func(time.Time) bool) ([]*tasks.Signature, error) {
+	result := make([]*tasks.Signature, 0)
+
+	iter := b.db.NewIterator(levelUtil.BytesPrefix([]byte(embeddedPendingPrefix)), nil)
+	defer iter.Release()
+	for iter.Next() {
+		eta, err := parsePendingTaskKey(iter.Key())
+		if err != nil || !filter(eta) {
+			continue
+		}
+
+		signature, err := decodeSignature(iter.Value())
+		if err != nil {
+			continue
+		}
+		result = append(result, signature)
+	}
+
+	return result, iter.Error()
+}
+
+//
+// utils
+//
+
+// pendingTaskKey returns storage key for pending task. Keys sort by ETA.
+func pendingTaskKey(eta time.Time, uuid string) []byte {
+	return []byte(fmt.Sprintf("%s%020d-%s", embeddedPendingPrefix, eta.UnixNano(), uuid))
+}
+
+func parsePendingTaskKey(key []byte) (time.Time, error) {
+	parts := strings.SplitN(strings.TrimPrefix(string(key), embeddedPendingPrefix), "-", 2)
+	nanos, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(0, nanos).UTC(), nil
+}
+
+func inflightTaskKey(uuid string) []byte {
+	return []byte(embeddedInflightPrefix + uuid)
+}
+
+func decodeSignature(message []byte) (*tasks.Signature, error) {
+	signature := new(tasks.Signature)
+	decoder := json.NewDecoder(bytes.NewReader(message))
+	decoder.UseNumber()
+	if err := decoder.Decode(signature); err != nil {
+		return nil, err
+	}
+
+	return signature, nil
+}
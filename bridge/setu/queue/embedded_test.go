@@ -0,0 +1,110 @@
+package queue
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/config"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+type testProcessor struct {
+	mu        sync.Mutex
+	processed []string
+	done      chan struct{}
+}
+
+func (p *testProcessor) Process(signature *tasks.Signature) error {
+	p.mu.Lock()
+	p.processed = append(p.processed, signature.UUID)
+	p.mu.Unlock()
+	p.done <- struct{}{}
+	return nil
+}
+
+func (p *testProcessor) CustomQueue() string {
+	return ""
+}
+
+func newTestEmbeddedBroker(t *testing.T, db *leveldb.DB) *EmbeddedBroker {
+	viper.Set("log_level", "info")
+	broker := NewEmbeddedBroker(&config.Config{DefaultQueue: QueueName}, db)
+	broker.pollInterval = 10 * time.Millisecond
+	broker.SetRegisteredTaskNames([]string{"testTask"})
+	return broker
+}
+
+func TestEmbeddedBrokerDelayedTasks(t *testing.T) {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	broker := newTestEmbeddedBroker(t, db)
+
+	later := time.Now().UTC().Add(200 * time.Millisecond)
+	require.NoError(t, broker.Publish(context.Background(), &tasks.Signature{UUID: "later", Name: "testTask", ETA: &later}))
+	require.NoError(t, broker.Publish(context.Background(), &tasks.Signature{UUID: "now", Name: "testTask"}))
+
+	pending, err := broker.GetPendingTasks(QueueName)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	delayed, err := broker.GetDelayedTasks()
+	require.NoError(t, err)
+	require.Len(t, delayed, 1)
+
+	processor := &testProcessor{done: make(chan struct{}, 2)}
+	go broker.StartConsuming("test", 2, processor)
+	defer broker.StopConsuming()
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-processor.done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for tasks")
+		}
+	}
+
+	require.Equal(t, []string{"now", "later"}, processor.processed)
+}
+
+func TestEmbeddedBrokerRequeueInflight(t *testing.T) {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	// simulate a crash after task was claimed
+	broker := newTestEmbeddedBroker(t, db)
+	require.NoError(t, broker.Publish(context.Background(), &tasks.Signature{UUID: "crashed", Name: "testTask"}))
+	claimed, err := broker.claimDueTasks(1)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+
+	pending, err := broker.GetPendingTasks(QueueName)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	// restart
+	broker = newTestEmbeddedBroker(t, db)
+	processor := &testProcessor{done: make(chan struct{}, 1)}
+	go broker.StartConsuming("test", 1, processor)
+	defer broker.StopConsuming()
+
+	select {
+	case <-processor.done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for re-queued task")
+	}
+	require.Equal(t, []string{"crashed"}, processor.processed)
+
+	// purge leaves nothing behind
+	require.NoError(t, PurgeEmbeddedQueue(db))
+	pending, err = broker.GetPendingTasks(QueueName)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
@@ -0,0 +1,99 @@
+package queue
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const (
+	enqueuedAtHeader = "enqueued-at" // unix time task was first sent
+	retryCountHeader = "retry-count" // retry count task was last sent with
+)
+
+// ApplyRetryPolicy sets retry count of configured task retry policy on new task
+func ApplyRetryPolicy(signature *tasks.Signature) {
+	signature.RetryCount = helper.GetTaskRetryPolicy(signature.Name).RetryCount
+}
+
+// scheduleRetry delays task retried by machinery as its retry policy says.
+// Machinery retries failed tasks by publishing them again with decremented retry count and fibonacci delay,
+// tasks sent first or retried by task itself (tasks.ErrRetryTaskLater) keep their delay.
+func scheduleRetry(signature *tasks.Signature, now time.Time, random float64) {
+	if signature.Name == DeadLetterTaskName {
+		return
+	}
+
+	if signature.Headers == nil {
+		signature.Headers = tasks.Headers{}
+	}
+
+	enqueuedAt := getInt64Header(signature, enqueuedAtHeader)
+	lastRetryCount := getInt64Header(signature, retryCountHeader)
+	signature.Headers[retryCountHeader] = int64(signature.RetryCount)
+
+	if enqueuedAt == 0 {
+		signature.Headers[enqueuedAtHeader] = now.Unix()
+		return
+	}
+
+	if int64(signature.RetryCount) >= lastRetryCount {
+		return
+	}
+
+	policy := helper.GetTaskRetryPolicy(signature.Name)
+	delay := retryDelay(policy, policy.RetryCount-signature.RetryCount, random)
+
+	// too old, last attempt right away and dead-letter store if it fails
+	if policy.MaxAge > 0 && now.Add(delay).Sub(time.Unix(enqueuedAt, 0)) > policy.MaxAge {
+		signature.RetryCount = 0
+		signature.Headers[retryCountHeader] = int64(0)
+		delay = 0
+	}
+
+	eta := now.Add(delay)
+	signature.ETA = &eta
+	signature.RetryTimeout = int(delay / time.Second)
+}
+
+// retryDelay returns delay before given retry (starting at 1), random in [0, 1) picks jitter
+func retryDelay(policy helper.TaskRetryPolicy, retry int, random float64) time.Duration {
+	if retry < 1 {
+		retry = 1
+	}
+
+	delay := policy.InitialDelay
+	switch policy.Backoff {
+	case helper.LinearBackoff:
+		delay = policy.InitialDelay * time.Duration(retry)
+	case helper.ExponentialBackoff:
+		for i := 1; i < retry && delay < policy.MaxDelay; i++ {
+			delay *= 2
+		}
+	case helper.FibonacciBackoff:
+		prev, cur := time.Duration(0), policy.InitialDelay
+		for i := 1; i < retry && cur < policy.MaxDelay; i++ {
+			prev, cur = cur, prev+cur
+		}
+		delay = cur
+	}
+
+	if policy.MaxDelay > 0 && delay > policy.MaxDelay {
+		delay = policy.MaxDelay
+	}
+
+	// spread retries of validators
+	if policy.Jitter > 0 {
+		delay += time.Duration(float64(delay) * policy.Jitter * (2*random - 1))
+	}
+
+	return delay
+}
+
+// scheduleRetryNow schedules retry of task with current time and random jitter
+func scheduleRetryNow(signature *tasks.Signature) {
+	scheduleRetry(signature, time.Now(), rand.Float64())
+}
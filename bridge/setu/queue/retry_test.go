@@ -0,0 +1,70 @@
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+func TestRetryDelay(t *testing.T) {
+	policy := helper.TaskRetryPolicy{InitialDelay: time.Second, MaxDelay: 10 * time.Second}
+
+	delays := func(backoff string) []time.Duration {
+		policy.Backoff = backoff
+		var result []time.Duration
+		for retry := 1; retry <= 6; retry++ {
+			result = append(result, retryDelay(policy, retry, 0.5)/time.Second)
+		}
+		return result
+	}
+
+	require.Equal(t, []time.Duration{1, 1, 1, 1, 1, 1}, delays(helper.FixedBackoff))
+	require.Equal(t, []time.Duration{1, 2, 3, 4, 5, 6}, delays(helper.LinearBackoff))
+	require.Equal(t, []time.Duration{1, 2, 4, 8, 10, 10}, delays(helper.ExponentialBackoff))
+	require.Equal(t, []time.Duration{1, 1, 2, 3, 5, 8}, delays(helper.FibonacciBackoff))
+
+	// jitter spreads delay around its value
+	policy.Backoff = helper.FixedBackoff
+	policy.Jitter = 0.5
+	require.Equal(t, 500*time.Millisecond, retryDelay(policy, 1, 0))
+	require.Equal(t, time.Second, retryDelay(policy, 1, 0.5))
+}
+
+func TestScheduleRetry(t *testing.T) {
+	now := time.Unix(1000000, 0)
+	policy := helper.DefaultTaskRetryPolicies["sendCheckpointAckToHeimdall"]
+
+	signature := &tasks.Signature{Name: "sendCheckpointAckToHeimdall"}
+	ApplyRetryPolicy(signature)
+	require.Equal(t, policy.RetryCount, signature.RetryCount)
+
+	// first send keeps its delay
+	eta := now.Add(time.Minute)
+	signature.ETA = &eta
+	scheduleRetry(signature, now, 0.5)
+	require.Equal(t, eta, *signature.ETA)
+
+	// retried by task itself keeps its delay
+	scheduleRetry(signature, now, 0.5)
+	require.Equal(t, eta, *signature.ETA)
+
+	// retried by machinery is delayed by policy
+	signature.RetryCount--
+	scheduleRetry(signature, now, 0.5)
+	require.Equal(t, now.Add(policy.InitialDelay), *signature.ETA)
+
+	signature.RetryCount--
+	scheduleRetry(signature, now, 0.5)
+	require.Equal(t, now.Add(2*policy.InitialDelay), *signature.ETA)
+
+	// too old, last attempt right away
+	later := now.Add(policy.MaxAge)
+	signature.RetryCount--
+	scheduleRetry(signature, later, 0.5)
+	require.Equal(t, later, *signature.ETA)
+	require.Equal(t, 0, signature.RetryCount)
+}
@@ -0,0 +1,198 @@
+package status
+
+import (
+	"encoding/json"
+	"net/http"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/broadcaster"
+	"github.com/maticnetwork/heimdall/bridge/setu/listener"
+	"github.com/maticnetwork/heimdall/bridge/setu/processor"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+// Status is the bridge status reported at /status
+type Status struct {
+	Services    []util.ServiceStatus `json:"services"`
+	Cursors     map[string]uint64    `json:"cursors"`
+	Broadcaster BroadcasterStatus    `json:"broadcaster"`
+	Proposer    ProposerStatus       `json:"proposer"`
+	CatchingUp  bool                 `json:"catching_up"`
+	Errors      []string             `json:"errors,omitempty"`
+}
+
+// BroadcasterStatus compares broadcaster's cached sequence with the account on heimdall
+type BroadcasterStatus struct {
+	LastSeqNo       uint64 `json:"last_seq_no"`
+	AccountSequence uint64 `json:"account_sequence"`
+	InFlight        int    `json:"in_flight"`
+	InSync          bool   `json:"in_sync"`
+}
+
+// ProposerStatus tells if this node is current checkpoint or span proposer
+type ProposerStatus struct {
+	Checkpoint bool `json:"checkpoint"`
+	Span       bool `json:"span"`
+}
+
+// ServiceReporter reports state of started bridge services
+type ServiceReporter interface {
+	ServiceStatuses() []util.ServiceStatus
+}
+
+// Server serves bridge status, liveness and readiness
+type Server struct {
+	logger log.Logger
+	cliCtx cliContext.CLIContext
+
+	txBroadcaster    *broadcaster.TxBroadcaster
+	processorService *processor.ProcessorService
+
+	// services reported by liveness and readiness
+	services []ServiceReporter
+	// if heimdall node is catching up
+	isCatchingUp func() bool
+
+	server *http.Server
+}
+
+// NewServer creates status server
+func NewServer(
+	cliCtx cliContext.CLIContext,
+	txBroadcaster *broadcaster.TxBroadcaster,
+	listenerService *listener.ListenerService,
+	processorService *processor.ProcessorService,
+) *Server {
+	return &Server{
+		logger:           util.Logger().With("module", "status"),
+		cliCtx:           cliCtx,
+		txBroadcaster:    txBroadcaster,
+		processorService: processorService,
+		services:         []ServiceReporter{listenerService, processorService},
+		isCatchingUp: func() bool {
+			return util.IsCatchingUp(cliCtx)
+		},
+	}
+}
+
+// Start serves status on given address
+func (s *Server) Start(addr string) {
+	s.server = &http.Server{Addr: addr, Handler: s.Handler()}
+	go func() {
+		s.logger.Info("Starting status server", "address", addr)
+		if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			s.logger.Error("Status server stopped", "error", err)
+		}
+	}()
+}
+
+// Handler returns http handler of status endpoints
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status", s.handleStatus)
+	mux.HandleFunc("/healthz", s.handleLiveness)
+	mux.HandleFunc("/readyz", s.handleReadiness)
+	return mux
+}
+
+// Stop stops status server
+func (s *Server) Stop() error {
+	if s.server == nil {
+		return nil
+	}
+	return s.server.Close()
+}
+
+// GetStatus collects current bridge status
+func (s *Server) GetStatus() Status {
+	status := Status{
+		Services: s.serviceStatuses(),
+		Cursors:  make(map[string]uint64),
+	}
+
+	if db := util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag)); db != nil {
+		cursors, err := listener.GetCursors(db)
+		if err != nil {
+			status.Errors = append(status.Errors, "cursors: "+err.Error())
+		} else {
+			status.Cursors = cursors
+		}
+	}
+
+	lastSeqNo, accountSequence, err := s.txBroadcaster.GetSequences()
+	if err != nil {
+		status.Errors = append(status.Errors, "account sequence: "+err.Error())
+	}
+	inFlight := s.txBroadcaster.InFlight()
+	status.Broadcaster = BroadcasterStatus{
+		LastSeqNo:       lastSeqNo,
+		AccountSequence: accountSequence,
+		InFlight:        inFlight,
+		InSync:          err == nil && lastSeqNo == accountSequence+uint64(inFlight),
+	}
+
+	if status.Proposer.Checkpoint, err = util.IsCurrentProposer(s.cliCtx); err != nil {
+		status.Errors = append(status.Errors, "checkpoint proposer: "+err.Error())
+	}
+
+	if status.Proposer.Span, err = s.processorService.IsSpanProposer(); err != nil {
+		status.Errors = append(status.Errors, "span proposer: "+err.Error())
+	}
+
+	status.CatchingUp = s.isCatchingUp()
+	return status
+}
+
+func (s *Server) handleStatus(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.GetStatus())
+}
+
+// handleLiveness fails if any started service stopped running or its loops stopped beating
+func (s *Server) handleLiveness(w http.ResponseWriter, r *http.Request) {
+	services := s.serviceStatuses()
+	for _, service := range services {
+		if !service.IsAlive() {
+			writeJSON(w, http.StatusServiceUnavailable, services)
+			return
+		}
+	}
+
+	writeJSON(w, http.StatusOK, services)
+}
+
+// handleReadiness succeeds once services are started and heimdall node is synced
+func (s *Server) handleReadiness(w http.ResponseWriter, r *http.Request) {
+	services := s.serviceStatuses()
+	ready := len(services) > 0 && !s.isCatchingUp()
+	for _, service := range services {
+		ready = ready && service.IsAlive()
+	}
+
+	if !ready {
+		writeJSON(w, http.StatusServiceUnavailable, services)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, services)
+}
+
+func (s *Server) serviceStatuses() []util.ServiceStatus {
+	var statuses []util.ServiceStatus
+	for _, service := range s.services {
+		statuses = append(statuses, service.ServiceStatuses()...)
+	}
+	return statuses
+}
+
+//
+// utils
+//
+
+func writeJSON(w http.ResponseWriter, code int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(data)
+}
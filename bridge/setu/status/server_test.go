@@ -0,0 +1,95 @@
+package status
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+// testServices reports given statuses with heartbeats of their loops
+type testServices []util.ServiceStatus
+
+func (ts testServices) ServiceStatuses() []util.ServiceStatus {
+	statuses := make([]util.ServiceStatus, 0, len(ts))
+	for _, status := range ts {
+		statuses = append(statuses, status.WithHeartbeat())
+	}
+	return statuses
+}
+
+func newTestServer(catchingUp bool, services ...util.ServiceStatus) *Server {
+	return &Server{
+		services:     []ServiceReporter{testServices(services)},
+		isCatchingUp: func() bool { return catchingUp },
+	}
+}
+
+func getCode(t *testing.T, s *Server, path string) int {
+	recorder := httptest.NewRecorder()
+	s.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+	return recorder.Code
+}
+
+func TestLivenessAndReadiness(t *testing.T) {
+	util.StartHeartbeat("test-fresh", "loop", time.Hour)
+	defer util.StopHeartbeat("test-fresh", "loop")
+
+	// loop which stopped beating
+	util.StartHeartbeat("test-stale", "loop", time.Millisecond)
+	defer util.StopHeartbeat("test-stale", "loop")
+	time.Sleep(10 * time.Millisecond)
+
+	running := util.ServiceStatus{Name: "test-fresh", Service: "listener", Running: true}
+	stopped := util.ServiceStatus{Name: "test-stopped", Service: "processor", Running: false}
+	stale := util.ServiceStatus{Name: "test-stale", Service: "listener", Running: true}
+	// services without loops are alive while running
+	noLoops := util.ServiceStatus{Name: "test-no-loops", Service: "processor", Running: true}
+
+	testCases := []struct {
+		name       string
+		catchingUp bool
+		services   []util.ServiceStatus
+		liveness   int
+		readiness  int
+	}{
+		{"running", false, []util.ServiceStatus{running, noLoops}, http.StatusOK, http.StatusOK},
+		{"catching up", true, []util.ServiceStatus{running}, http.StatusOK, http.StatusServiceUnavailable},
+		{"not started", false, nil, http.StatusOK, http.StatusServiceUnavailable},
+		{"stopped service", false, []util.ServiceStatus{running, stopped}, http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+		{"stale heartbeat", false, []util.ServiceStatus{running, stale}, http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+	}
+
+	for _, tc := range testCases {
+		s := newTestServer(tc.catchingUp, tc.services...)
+		require.Equal(t, tc.liveness, getCode(t, s, "/healthz"), "liveness: %v", tc.name)
+		require.Equal(t, tc.readiness, getCode(t, s, "/readyz"), "readiness: %v", tc.name)
+	}
+}
+
+func TestHeartbeat(t *testing.T) {
+	util.StartHeartbeat("test-heartbeat", "loop", 50*time.Millisecond)
+	defer util.StopHeartbeat("test-heartbeat", "loop")
+
+	status := util.ServiceStatus{Name: "test-heartbeat", Running: true}
+	require.True(t, status.WithHeartbeat().IsAlive())
+	require.NotNil(t, status.WithHeartbeat().LastHeartbeat)
+
+	// stale once loop stops beating
+	time.Sleep(60 * time.Millisecond)
+	require.False(t, status.WithHeartbeat().IsAlive())
+
+	// alive again after beat
+	util.Heartbeat("test-heartbeat", "loop")
+	require.True(t, status.WithHeartbeat().IsAlive())
+
+	// loop stopped on purpose is not stale
+	util.StartHeartbeat("test-heartbeat", "stopped", time.Millisecond)
+	util.StopHeartbeat("test-heartbeat", "stopped")
+	time.Sleep(5 * time.Millisecond)
+	require.True(t, status.WithHeartbeat().IsAlive())
+}
@@ -26,6 +26,7 @@ import (
 	chainManagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/helper"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
 	"github.com/maticnetwork/heimdall/types"
 	hmtypes "github.com/maticnetwork/heimdall/types"
 )
@@ -51,10 +52,12 @@ const (
 	StakingTxStatusURL      = "/staking/isoldtx"
 	TopupTxStatusURL        = "/topup/isoldtx"
 	ClerkTxStatusURL        = "/clerk/isoldtx"
+	ClerkRecordStatusURL    = "/clerk/event-record/status"
 	LatestSlashInfoBytesURL = "/slashing/latest_slash_info_bytes"
 	TickSlashInfoListURL    = "/slashing/tick_slash_infos"
 	SlashingTxStatusURL     = "/slashing/isoldtx"
 	SlashingTickCountURL    = "/slashing/tick-count"
+	SlashingParamsURL       = "/slashing/parameters"
 
 	TendermintUnconfirmedTxsURL      = "/unconfirmed_txs"
 	TendermintUnconfirmedTxsCountURL = "/num_unconfirmed_txs"
@@ -83,7 +86,11 @@ var loggerOnce sync.Once
 func Logger() log.Logger {
 	loggerOnce.Do(func() {
 		logger = log.NewTMLogger(log.NewSyncWriter(os.Stdout))
-		option, _ := log.AllowLevel(viper.GetString("log_level"))
+		option, err := log.AllowLevel(viper.GetString("log_level"))
+		if err != nil {
+			// log level is not set for commands other than start
+			option = log.AllowInfo()
+		}
 		logger = log.NewFilter(logger, option)
 
 		// set no-op logger if log level is not debug for machinery
@@ -150,6 +157,31 @@ func IsInProposerList(cliCtx cliContext.CLIContext, count uint64) (bool, error)
 	return false, nil
 }
 
+// GetProposerPosition returns our position in next count proposers, 0 being current proposer and -1 if we are not in the list
+func GetProposerPosition(cliCtx cliContext.CLIContext, count uint64) (int, error) {
+	response, err := helper.FetchFromAPI(
+		cliCtx,
+		helper.GetHeimdallServerEndpoint(fmt.Sprintf(ProposersURL, strconv.FormatUint(count, 10))),
+	)
+	if err != nil {
+		logger.Error("Unable to send request for next proposers", "url", ProposersURL, "error", err)
+		return -1, err
+	}
+
+	var proposers []hmtypes.Validator
+	if err := json.Unmarshal(response.Result, &proposers); err != nil {
+		logger.Error("Error unmarshalling validator data ", "error", err)
+		return -1, err
+	}
+
+	for i, proposer := range proposers {
+		if bytes.Equal(proposer.Signer.Bytes(), helper.GetAddress()) {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
 // CalculateTaskDelay calculates delay required for current validator to propose the tx
 // It solves for multiple validators sending same transaction.
 func CalculateTaskDelay(cliCtx cliContext.CLIContext) (bool, time.Duration) {
@@ -360,13 +392,18 @@ func GetCheckpointParams(cliCtx cliContext.CLIContext) (*checkpointTypes.Params,
 
 // GetBufferedCheckpoint return checkpoint from bueffer
 func GetBufferedCheckpoint(cliCtx cliContext.CLIContext) (*hmtypes.Checkpoint, error) {
+	return GetChainBufferedCheckpoint(cliCtx, "")
+}
+
+// GetChainBufferedCheckpoint return checkpoint from buffer of the bor chain, empty chain id is the default chain
+func GetChainBufferedCheckpoint(cliCtx cliContext.CLIContext, borChainID string) (*hmtypes.Checkpoint, error) {
 	response, err := helper.FetchFromAPI(
 		cliCtx,
-		helper.GetHeimdallServerEndpoint(BufferedCheckpointURL),
+		helper.GetHeimdallServerEndpoint(BorChainURL(BufferedCheckpointURL, borChainID)),
 	)
 
 	if err != nil {
-		logger.Debug("Error fetching buffered checkpoint", "err", err)
+		logger.Debug("Error fetching buffered checkpoint", "borChainID", borChainID, "err", err)
 		return nil, err
 	}
 
@@ -381,13 +418,18 @@ func GetBufferedCheckpoint(cliCtx cliContext.CLIContext) (*hmtypes.Checkpoint, e
 
 // GetlastestCheckpoint return last successful checkpoint
 func GetlastestCheckpoint(cliCtx cliContext.CLIContext) (*hmtypes.Checkpoint, error) {
+	return GetChainLatestCheckpoint(cliCtx, "")
+}
+
+// GetChainLatestCheckpoint return last successful checkpoint of the bor chain, empty chain id is the default chain
+func GetChainLatestCheckpoint(cliCtx cliContext.CLIContext, borChainID string) (*hmtypes.Checkpoint, error) {
 	response, err := helper.FetchFromAPI(
 		cliCtx,
-		helper.GetHeimdallServerEndpoint(LatestCheckpointURL),
+		helper.GetHeimdallServerEndpoint(BorChainURL(LatestCheckpointURL, borChainID)),
 	)
 
 	if err != nil {
-		logger.Debug("Error fetching latest checkpoint", "err", err)
+		logger.Debug("Error fetching latest checkpoint", "borChainID", borChainID, "err", err)
 		return nil, err
 	}
 
@@ -400,6 +442,20 @@ func GetlastestCheckpoint(cliCtx cliContext.CLIContext) (*hmtypes.Checkpoint, er
 	return &checkpoint, nil
 }
 
+// BorChainURL scopes heimdall rest url to the bor chain, empty chain id is the default chain
+func BorChainURL(uri string, borChainID string) string {
+	if borChainID == "" {
+		return uri
+	}
+
+	scoped, err := CreateURLWithQuery(uri, map[string]interface{}{"bor_chain_id": borChainID})
+	if err != nil {
+		return uri
+	}
+
+	return scoped
+}
+
 // AppendPrefix returns publickey in uncompressed format
 func AppendPrefix(signerPubKey []byte) []byte {
 	// append prefix - "0x04" as heimdall uses publickey in uncompressed format. Refer below link
@@ -449,6 +505,27 @@ func GetBlockHeight(cliCtx cliContext.CLIContext) int64 {
 	return response.Height
 }
 
+// GetSlashingParams return slashing params, along with heimdall height they were fetched at
+func GetSlashingParams(cliCtx cliContext.CLIContext) (*slashingTypes.Params, int64, error) {
+	response, err := helper.FetchFromAPI(
+		cliCtx,
+		helper.GetHeimdallServerEndpoint(SlashingParamsURL),
+	)
+
+	if err != nil {
+		logger.Error("Error fetching slashing params", "err", err)
+		return nil, 0, err
+	}
+
+	var params slashingTypes.Params
+	if err := cliCtx.Codec.UnmarshalJSON(response.Result, &params); err != nil {
+		logger.Error("Error unmarshalling slashing params", "url", SlashingParamsURL, "err", err)
+		return nil, 0, err
+	}
+
+	return &params, response.Height, nil
+}
+
 func GetUnconfirmedTxnCount() int {
 	endpoint := helper.GetConfig().TendermintRPCUrl + TendermintUnconfirmedTxsCountURL
 	resp, err := http.Get(endpoint)
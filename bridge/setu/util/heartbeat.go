@@ -0,0 +1,84 @@
+package util
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// HeartbeatInterval is how often idle loops of bridge services beat
+	HeartbeatInterval = 30 * time.Second
+
+	// DefaultHeartbeatTimeout is age after which heartbeat of a loop is stale
+	DefaultHeartbeatTimeout = 5 * time.Minute
+)
+
+// heartbeat of a long running loop of bridge service
+type heartbeat struct {
+	last    time.Time
+	timeout time.Duration
+}
+
+var heartbeats = make(map[string]*heartbeat)
+var heartbeatsMutex sync.RWMutex
+
+// HeartbeatTimeout returns heartbeat timeout of a loop running every interval
+func HeartbeatTimeout(interval time.Duration) time.Duration {
+	if 3*interval > DefaultHeartbeatTimeout {
+		return 3 * interval
+	}
+	return DefaultHeartbeatTimeout
+}
+
+// StartHeartbeat registers loop of service, it is stale if it doesn't beat within timeout
+func StartHeartbeat(service string, loop string, timeout time.Duration) {
+	heartbeatsMutex.Lock()
+	defer heartbeatsMutex.Unlock()
+
+	heartbeats[heartbeatKey(service, loop)] = &heartbeat{last: time.Now(), timeout: timeout}
+}
+
+// Heartbeat records that loop of service is alive
+func Heartbeat(service string, loop string) {
+	heartbeatsMutex.Lock()
+	defer heartbeatsMutex.Unlock()
+
+	if hb, ok := heartbeats[heartbeatKey(service, loop)]; ok {
+		hb.last = time.Now()
+	}
+}
+
+// StopHeartbeat unregisters loop of service, when it was stopped on purpose
+func StopHeartbeat(service string, loop string) {
+	heartbeatsMutex.Lock()
+	defer heartbeatsMutex.Unlock()
+
+	delete(heartbeats, heartbeatKey(service, loop))
+}
+
+// GetHeartbeat returns oldest heartbeat of registered loops of service, and if any of them is stale.
+// Services without registered loops are never stale.
+func GetHeartbeat(service string) (last time.Time, stale bool) {
+	heartbeatsMutex.RLock()
+	defer heartbeatsMutex.RUnlock()
+
+	prefix := service + "/"
+	for key, hb := range heartbeats {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+
+		if last.IsZero() || hb.last.Before(last) {
+			last = hb.last
+		}
+		if time.Since(hb.last) > hb.timeout {
+			stale = true
+		}
+	}
+	return last, stale
+}
+
+func heartbeatKey(service string, loop string) string {
+	return service + "/" + loop
+}
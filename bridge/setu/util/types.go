@@ -1,8 +1,41 @@
 package util
 
+import "time"
+
 type TendermintUnconfirmedTxs struct {
 	Result struct {
 		Total string   `json:"total"`
 		Txs   []string `json:"txs"`
 	} `json:"result"`
 }
+
+// ServiceStatus is state of a bridge listener or processor
+type ServiceStatus struct {
+	Name    string `json:"name"`
+	Service string `json:"service"`
+	Running bool   `json:"running"`
+	Error   string `json:"error,omitempty"`
+
+	// oldest heartbeat of service loops, stale if any loop stopped beating
+	LastHeartbeat *time.Time `json:"last_heartbeat,omitempty"`
+	Stale         bool       `json:"stale,omitempty"`
+}
+
+// IsAlive returns true if service is running and none of its loops is stale
+func (s ServiceStatus) IsAlive() bool {
+	return s.Running && !s.Stale
+}
+
+// WithHeartbeat returns status with heartbeat of its loops
+func (s ServiceStatus) WithHeartbeat() ServiceStatus {
+	if !s.Running {
+		return s
+	}
+
+	last, stale := GetHeartbeat(s.Name)
+	if !last.IsZero() {
+		s.LastHeartbeat = &last
+	}
+	s.Stale = stale
+	return s
+}
@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	chainmanagerUtils "github.com/maticnetwork/heimdall/chainmanager/client/utils"
+	"github.com/maticnetwork/heimdall/chainmanager/types"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+var logger = helper.Logger.With("module", "chainmanager/client/cli")
+
+// GetCmdSubmitProposal implements a command handler for submitting a chain params
+// proposal transaction.
+func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chain-params [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to add, rotate or deprecate contract addresses",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a chain params proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Actions:
+  add        accept the address besides the current one from activation height
+  rotate     make the address the current one at activation height, previous
+             address is accepted until deprecation height
+  deprecate  stop accepting an added address at deprecation height
+
+Contracts: %s
+
+Example:
+$ %s tx gov submit-proposal chain-params <path/to/proposal.json> --validator-id=1 --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Rotate state sender",
+  "description": "Migrate to the new state sender contract",
+  "changes": [
+    {
+      "action": "rotate",
+      "contract": "state_sender",
+      "address": "0x...",
+      "activation_height": 1000000,
+      "deprecation_height": 1100000
+    }
+  ],
+  "deposit": [
+    {
+      "denom": "matic",
+      "amount": "1000000000000000000"
+    }
+  ]
+}
+`,
+				strings.Join([]string{
+					types.ContractMaticToken,
+					types.ContractStakingManager,
+					types.ContractSlashManager,
+					types.ContractRootChain,
+					types.ContractStakingInfo,
+					types.ContractStateSender,
+					types.ContractStateReceiver,
+					types.ContractValidatorSet,
+				}, ", "),
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := chainmanagerUtils.ParseChainParamsProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			validatorID := viper.GetUint64(FlagValidatorID)
+			if validatorID == 0 {
+				return fmt.Errorf("Valid validator ID required")
+			}
+
+			from := helper.GetFromAddress(cliCtx)
+			content := types.NewChainParamsProposal(proposal.Title, proposal.Description, proposal.Changes)
+
+			// create submit proposal
+			msg := govTypes.NewMsgSubmitProposal(content, proposal.Deposit, from, hmTypes.NewValidatorID(validatorID))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int(FlagValidatorID, 0, "--validator-id=<validator ID here>")
+	if err := cmd.MarkFlagRequired(FlagValidatorID); err != nil {
+		logger.Error("GetCmdSubmitProposal | MarkFlagRequired | FlagValidatorID", "Error", err)
+	}
+
+	return cmd
+}
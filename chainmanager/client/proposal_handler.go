@@ -0,0 +1,10 @@
+package client
+
+import (
+	"github.com/maticnetwork/heimdall/chainmanager/client/cli"
+	"github.com/maticnetwork/heimdall/chainmanager/client/rest"
+	govclient "github.com/maticnetwork/heimdall/gov/client"
+)
+
+// chain params proposal handler
+var ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/chainmanager/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/types/rest"
+)
+
+type (
+	// ChainParamsProposalJSON defines a ChainParamsProposal with a deposit used
+	// to parse chain params proposals from a JSON file.
+	ChainParamsProposalJSON struct {
+		Title       string                 `json:"title" yaml:"title"`
+		Description string                 `json:"description" yaml:"description"`
+		Changes     []types.ContractChange `json:"changes" yaml:"changes"`
+		Deposit     sdk.Coins              `json:"deposit" yaml:"deposit"`
+	}
+
+	// ChainParamsProposalReq defines a chain params proposal request body.
+	ChainParamsProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string                  `json:"title" yaml:"title"`
+		Description string                  `json:"description" yaml:"description"`
+		Changes     []types.ContractChange  `json:"changes" yaml:"changes"`
+		Proposer    hmTypes.HeimdallAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins               `json:"deposit" yaml:"deposit"`
+		Validator   hmTypes.ValidatorID     `json:"validator" yaml:"validator"`
+	}
+)
+
+// ParseChainParamsProposalJSON reads and parses a ChainParamsProposalJSON from
+// file.
+func ParseChainParamsProposalJSON(cdc *codec.Codec, proposalFile string) (ChainParamsProposalJSON, error) {
+	proposal := ChainParamsProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -1,10 +1,16 @@
 package chainmanager
 
 import (
+	"bytes"
+
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+
 	"github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/params/subspace"
@@ -60,3 +66,73 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
 	return
 }
+
+// -----------------------------------------------------------------------------
+// Contract addresses
+
+// GetAcceptedAddresses returns addresses of the contract accepted at current height, chain params address comes first
+func (k Keeper) GetAcceptedAddresses(ctx sdk.Context, contract string) []common.Address {
+	return k.GetParams(ctx).ChainParams.AcceptedAddresses(contract, uint64(ctx.BlockHeight()))
+}
+
+// GetLogContractAddress returns address of the contract which emitted log with given index in receipt,
+// if it is accepted at current height. Otherwise it returns chain params address of the contract.
+func (k Keeper) GetLogContractAddress(ctx sdk.Context, contract string, receipt *ethTypes.Receipt, logIndex uint64) common.Address {
+	accepted := k.GetAcceptedAddresses(ctx, contract)
+	if receipt != nil {
+		for _, vLog := range receipt.Logs {
+			if uint64(vLog.Index) != logIndex {
+				continue
+			}
+
+			for _, address := range accepted {
+				if bytes.Equal(vLog.Address.Bytes(), address.Bytes()) {
+					return address
+				}
+			}
+		}
+	}
+
+	return accepted[0]
+}
+
+// ApplyContractAddresses activates rotated addresses and removes deprecated ones at current height
+func (k Keeper) ApplyContractAddresses(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.ChainParams.ApplyContractAddresses(uint64(ctx.BlockHeight())) {
+		k.Logger(ctx).Info("Applied contract address changes", "height", ctx.BlockHeight())
+		k.SetParams(ctx, params)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Bor chains
+
+// GetBorChain returns contracts of the bor chain, false if chain is not known
+func (k Keeper) GetBorChain(ctx sdk.Context, chainID string) (types.BorChain, bool) {
+	return k.GetParams(ctx).ChainParams.GetBorChain(chainID)
+}
+
+// IsKnownBorChain returns true if chain id is of the default or an additional bor chain
+func (k Keeper) IsKnownBorChain(ctx sdk.Context, chainID string) bool {
+	return chainID == "" || k.GetParams(ctx).ChainParams.IsKnownBorChain(chainID)
+}
+
+// BorChainStore returns part of the module store keeping data of the bor chain. Data of the
+// default bor chain, and of chain ids which are not additional bor chains, is kept in store itself.
+func (k Keeper) BorChainStore(ctx sdk.Context, store sdk.KVStore, chainID string) sdk.KVStore {
+	if chainID == "" || !k.GetParams(ctx).ChainParams.IsAdditionalBorChain(chainID) {
+		return store
+	}
+
+	return prefix.NewStore(store, types.GetBorChainStorePrefix(chainID))
+}
+
+// GetBorChainCaller returns contract caller reading data of the bor chain, caller itself is used for the default bor chain
+func (k Keeper) GetBorChainCaller(ctx sdk.Context, caller helper.IContractCaller, chainID string) (helper.IContractCaller, error) {
+	if chainID == "" || !k.GetParams(ctx).ChainParams.IsAdditionalBorChain(chainID) {
+		return caller, nil
+	}
+
+	return caller.GetBorChainCaller(chainID)
+}
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/app"
 	"github.com/maticnetwork/heimdall/chainmanager/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
@@ -37,3 +38,37 @@ func (suite *KeeperTestSuite) TestParamsGetterSetter() {
 
 	require.Equal(t, params, actualParams)
 }
+
+func (suite *KeeperTestSuite) TestBorChains() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	params := types.DefaultParams()
+	params.ChainParams.BorChains = []types.BorChain{
+		{
+			BorChainID:           "15002",
+			RootChainAddress:     hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000000b01"),
+			StateReceiverAddress: hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000000b02"),
+			ValidatorSetAddress:  hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000000b03"),
+		},
+	}
+	require.NoError(t, params.Validate())
+	app.ChainKeeper.SetParams(ctx, params)
+
+	require.True(t, app.ChainKeeper.IsKnownBorChain(ctx, ""))
+	require.True(t, app.ChainKeeper.IsKnownBorChain(ctx, params.ChainParams.BorChainID))
+	require.True(t, app.ChainKeeper.IsKnownBorChain(ctx, "15002"))
+	require.False(t, app.ChainKeeper.IsKnownBorChain(ctx, "15003"))
+
+	borChain, ok := app.ChainKeeper.GetBorChain(ctx, "15002")
+	require.True(t, ok)
+	require.Equal(t, params.ChainParams.BorChains[0], borChain)
+
+	defaultChain, ok := app.ChainKeeper.GetBorChain(ctx, "")
+	require.True(t, ok)
+	require.Equal(t, params.ChainParams.RootChainAddress, defaultChain.RootChainAddress)
+
+	require.Equal(t, []string{params.ChainParams.BorChainID, "15002"}, params.ChainParams.BorChainIDs())
+
+	// duplicate chain is rejected
+	params.ChainParams.BorChains = append(params.ChainParams.BorChains, params.ChainParams.BorChains[0])
+	require.Error(t, params.Validate())
+}
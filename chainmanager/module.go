@@ -140,8 +140,10 @@ func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	return types.ModuleCdc.MustMarshalJSON(gs)
 }
 
-// BeginBlock returns the begin blocker for the auth module.
-func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+// BeginBlock activates rotated contract addresses and removes deprecated ones.
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	am.keeper.ApplyContractAddresses(ctx)
+}
 
 // EndBlock returns the end blocker for the auth module. It returns no validator
 // updates.
@@ -0,0 +1,82 @@
+package chainmanager
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/chainmanager/types"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+)
+
+// NewChainParamsProposalHandler creates a governance handler for chain params proposals
+func NewChainParamsProposalHandler(k Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, content govTypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case types.ChainParamsProposal:
+			return handleChainParamsProposal(ctx, k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized chainmanager proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+func handleChainParamsProposal(ctx sdk.Context, k Keeper, p types.ChainParamsProposal) sdk.Error {
+	params := k.GetParams(ctx)
+	chainParams := &params.ChainParams
+
+	for _, c := range p.Changes {
+		k.Logger(ctx).Info(
+			fmt.Sprintf("changing contract address; action: %s, contract: %s, address: %s", c.Action, c.Contract, c.Address),
+		)
+
+		if chainParams.ContractAddress(c.Contract).Equals(c.Address) {
+			if c.Action == types.ActionDeprecate {
+				return types.ErrInvalidContractChange(types.DefaultCodespace, c, "current address can only be rotated")
+			}
+			return types.ErrInvalidContractChange(types.DefaultCodespace, c, "address is current address already")
+		}
+
+		switch c.Action {
+		case types.ActionAdd, types.ActionRotate:
+			for _, ca := range chainParams.ContractAddresses {
+				if ca.Contract == c.Contract && ca.Address.Equals(c.Address) {
+					return types.ErrInvalidContractChange(types.DefaultCodespace, c, "address is known already")
+				}
+			}
+
+			chainParams.ContractAddresses = append(chainParams.ContractAddresses, types.ContractAddress{
+				Contract:          c.Contract,
+				Address:           c.Address,
+				ActivationHeight:  c.ActivationHeight,
+				DeprecationHeight: c.DeprecationHeight,
+				Rotation:          c.Action == types.ActionRotate,
+			})
+
+		case types.ActionDeprecate:
+			found := false
+			for i, ca := range chainParams.ContractAddresses {
+				if ca.Contract == c.Contract && ca.Address.Equals(c.Address) && !ca.Rotation {
+					if c.DeprecationHeight <= ca.ActivationHeight {
+						return types.ErrInvalidContractChange(types.DefaultCodespace, c, "deprecation height must be greater than activation height")
+					}
+					chainParams.ContractAddresses[i].DeprecationHeight = c.DeprecationHeight
+					found = true
+				}
+			}
+
+			if !found {
+				return types.ErrInvalidContractChange(types.DefaultCodespace, c, "address is not known")
+			}
+		}
+	}
+
+	if err := params.Validate(); err != nil {
+		return sdk.ErrInternal(err.Error())
+	}
+
+	k.SetParams(ctx, params)
+	return nil
+}
@@ -0,0 +1,98 @@
+package chainmanager_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/common"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/chainmanager"
+	"github.com/maticnetwork/heimdall/chainmanager/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+type ProposalHandlerTestSuite struct {
+	suite.Suite
+
+	app *app.HeimdallApp
+	ctx sdk.Context
+}
+
+func (suite *ProposalHandlerTestSuite) SetupTest() {
+	suite.app, suite.ctx = createTestApp(false)
+
+	params := suite.app.ChainKeeper.GetParams(suite.ctx)
+	params.ChainParams.StateSenderAddress = hmTypes.HexToHeimdallAddress("0x01")
+	suite.app.ChainKeeper.SetParams(suite.ctx, params)
+}
+
+func TestProposalHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ProposalHandlerTestSuite))
+}
+
+func (suite *ProposalHandlerTestSuite) handle(changes ...types.ContractChange) sdk.Error {
+	handler := chainmanager.NewChainParamsProposalHandler(suite.app.ChainKeeper)
+	return handler(suite.ctx, types.NewChainParamsProposal("title", "description", changes))
+}
+
+func (suite *ProposalHandlerTestSuite) accepted(height int64) []common.Address {
+	suite.ctx = suite.ctx.WithBlockHeight(height)
+	suite.app.ChainKeeper.ApplyContractAddresses(suite.ctx)
+	return suite.app.ChainKeeper.GetAcceptedAddresses(suite.ctx, types.ContractStateSender)
+}
+
+// Tests
+
+func (suite *ProposalHandlerTestSuite) TestRotate() {
+	t := suite.T()
+	previous, next := common.HexToAddress("0x01"), common.HexToAddress("0x02")
+
+	err := suite.handle(types.NewContractChange(types.ActionRotate, types.ContractStateSender, hmTypes.BytesToHeimdallAddress(next.Bytes()), 10, 20))
+	require.Nil(t, err)
+
+	require.Equal(t, []common.Address{previous}, suite.accepted(5))
+	require.Equal(t, []common.Address{next, previous}, suite.accepted(10))
+	require.Equal(t, []common.Address{next, previous}, suite.accepted(19))
+	require.Equal(t, []common.Address{next}, suite.accepted(20))
+
+	chainParams := suite.app.ChainKeeper.GetParams(suite.ctx).ChainParams
+	require.Equal(t, next, chainParams.StateSenderAddress.EthAddress())
+	require.Empty(t, chainParams.ContractAddresses)
+}
+
+func (suite *ProposalHandlerTestSuite) TestAddAndDeprecate() {
+	t := suite.T()
+	current, added := common.HexToAddress("0x01"), common.HexToAddress("0x03")
+	addedAddress := hmTypes.BytesToHeimdallAddress(added.Bytes())
+
+	err := suite.handle(types.NewContractChange(types.ActionAdd, types.ContractStateSender, addedAddress, 0, 0))
+	require.Nil(t, err)
+	require.Equal(t, []common.Address{current, added}, suite.accepted(1))
+
+	// already known
+	err = suite.handle(types.NewContractChange(types.ActionAdd, types.ContractStateSender, addedAddress, 0, 0))
+	require.NotNil(t, err)
+
+	err = suite.handle(types.NewContractChange(types.ActionDeprecate, types.ContractStateSender, addedAddress, 0, 30))
+	require.Nil(t, err)
+	require.Equal(t, []common.Address{current, added}, suite.accepted(29))
+	require.Equal(t, []common.Address{current}, suite.accepted(30))
+}
+
+func (suite *ProposalHandlerTestSuite) TestInvalidChanges() {
+	t := suite.T()
+
+	// current address can't be deprecated
+	err := suite.handle(types.NewContractChange(types.ActionDeprecate, types.ContractStateSender, hmTypes.HexToHeimdallAddress("0x01"), 0, 30))
+	require.NotNil(t, err)
+
+	// unknown address can't be deprecated
+	err = suite.handle(types.NewContractChange(types.ActionDeprecate, types.ContractStateSender, hmTypes.HexToHeimdallAddress("0x04"), 0, 30))
+	require.NotNil(t, err)
+
+	// failed proposal leaves params untouched
+	require.Empty(t, suite.app.ChainKeeper.GetParams(suite.ctx).ChainParams.ContractAddresses)
+}
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// BorChainStorePrefix is the store prefix under which modules keep data of additional bor chains
+var BorChainStorePrefix = []byte{0x60}
+
+// BorChain keeps contracts of an additional bor chain served by heimdall. Data of the
+// bor chain in chain params (BorChainID) is kept as before, additional chains have their
+// spans, checkpoints and ack count kept separately under their chain id.
+type BorChain struct {
+	BorChainID       string                  `json:"bor_chain_id" yaml:"bor_chain_id"`
+	RootChainAddress hmTypes.HeimdallAddress `json:"root_chain_address" yaml:"root_chain_address"`
+
+	// Bor Chain Contracts
+	StateReceiverAddress hmTypes.HeimdallAddress `json:"state_receiver_address" yaml:"state_receiver_address"`
+	ValidatorSetAddress  hmTypes.HeimdallAddress `json:"validator_set_address" yaml:"validator_set_address"`
+}
+
+// String implements the Stringer interface.
+func (bc BorChain) String() string {
+	return fmt.Sprintf("%s (root chain: %s, state receiver: %s, validator set: %s)",
+		bc.BorChainID, bc.RootChainAddress, bc.StateReceiverAddress, bc.ValidatorSetAddress)
+}
+
+// IsDefaultBorChain returns true if chain id is empty or the bor chain id of chain params
+func (cp ChainParams) IsDefaultBorChain(chainID string) bool {
+	return chainID == "" || chainID == cp.BorChainID
+}
+
+// IsAdditionalBorChain returns true if chain id is of an additional bor chain
+func (cp ChainParams) IsAdditionalBorChain(chainID string) bool {
+	if cp.IsDefaultBorChain(chainID) {
+		return false
+	}
+
+	for _, bc := range cp.BorChains {
+		if bc.BorChainID == chainID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsKnownBorChain returns true if chain id is of the default or an additional bor chain
+func (cp ChainParams) IsKnownBorChain(chainID string) bool {
+	return cp.IsDefaultBorChain(chainID) || cp.IsAdditionalBorChain(chainID)
+}
+
+// GetBorChain returns contracts of the bor chain, default chain is built from chain params
+func (cp ChainParams) GetBorChain(chainID string) (BorChain, bool) {
+	if cp.IsDefaultBorChain(chainID) {
+		return BorChain{
+			BorChainID:           cp.BorChainID,
+			RootChainAddress:     cp.RootChainAddress,
+			StateReceiverAddress: cp.StateReceiverAddress,
+			ValidatorSetAddress:  cp.ValidatorSetAddress,
+		}, true
+	}
+
+	for _, bc := range cp.BorChains {
+		if bc.BorChainID == chainID {
+			return bc, true
+		}
+	}
+
+	return BorChain{}, false
+}
+
+// BorChainIDs returns ids of all bor chains, default chain comes first
+func (cp ChainParams) BorChainIDs() []string {
+	ids := []string{cp.BorChainID}
+	for _, bc := range cp.BorChains {
+		ids = append(ids, bc.BorChainID)
+	}
+
+	return ids
+}
+
+func validateBorChains(defaultChainID string, chains []BorChain) error {
+	seen := map[string]bool{defaultChainID: true}
+	for _, bc := range chains {
+		if bc.BorChainID == "" {
+			return fmt.Errorf("Invalid empty bor_chain_id in bor_chains")
+		}
+
+		if seen[bc.BorChainID] {
+			return fmt.Errorf("Duplicate bor chain %s in bor_chains", bc.BorChainID)
+		}
+		seen[bc.BorChainID] = true
+
+		if bc.RootChainAddress.Empty() || bc.StateReceiverAddress.Empty() || bc.ValidatorSetAddress.Empty() {
+			return fmt.Errorf("Invalid contract address of bor chain %s in bor_chains", bc.BorChainID)
+		}
+	}
+
+	return nil
+}
+
+// GetBorChainStorePrefix returns store prefix of the additional bor chain
+func GetBorChainStorePrefix(chainID string) []byte {
+	return append(append(BorChainStorePrefix, []byte(chainID)...), '/')
+}
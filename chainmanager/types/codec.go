@@ -15,5 +15,5 @@ func init() {
 
 // RegisterCodec registers all necessary param module types with a given codec.
 func RegisterCodec(cdc *codec.Codec) {
-	//TODO: implement here
+	cdc.RegisterConcrete(ChainParamsProposal{}, "heimdall/ChainParamsProposal", nil)
 }
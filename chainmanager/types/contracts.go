@@ -0,0 +1,166 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/maticnetwork/bor/common"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Contracts whose addresses are kept in chain params
+const (
+	ContractMaticToken     = "matic_token"
+	ContractStakingManager = "staking_manager"
+	ContractSlashManager   = "slash_manager"
+	ContractRootChain      = "root_chain"
+	ContractStakingInfo    = "staking_info"
+	ContractStateSender    = "state_sender"
+	ContractStateReceiver  = "state_receiver"
+	ContractValidatorSet   = "validator_set"
+)
+
+// ContractAddress is an address of a contract accepted besides the one in chain params,
+// from activation height until deprecation height (0 means not deprecated).
+//
+// Rotation entries become the chain params address at activation height, the previous
+// address is kept as an entry until deprecation height of the rotation entry.
+type ContractAddress struct {
+	Contract          string                  `json:"contract" yaml:"contract"`
+	Address           hmTypes.HeimdallAddress `json:"address" yaml:"address"`
+	ActivationHeight  uint64                  `json:"activation_height" yaml:"activation_height"`
+	DeprecationHeight uint64                  `json:"deprecation_height,omitempty" yaml:"deprecation_height,omitempty"`
+	Rotation          bool                    `json:"rotation,omitempty" yaml:"rotation,omitempty"`
+}
+
+// IsActive returns true if address is accepted at given heimdall height
+func (ca ContractAddress) IsActive(height uint64) bool {
+	if ca.Rotation {
+		return ca.ActivationHeight <= height
+	}
+
+	return ca.ActivationHeight <= height && (ca.DeprecationHeight == 0 || height < ca.DeprecationHeight)
+}
+
+// String implements the Stringer interface.
+func (ca ContractAddress) String() string {
+	return fmt.Sprintf("%s: %s (activation: %d, deprecation: %d, rotation: %v)",
+		ca.Contract, ca.Address, ca.ActivationHeight, ca.DeprecationHeight, ca.Rotation)
+}
+
+// contractField returns chain params field of the contract, nil for unknown contract
+func (cp *ChainParams) contractField(contract string) *hmTypes.HeimdallAddress {
+	switch contract {
+	case ContractMaticToken:
+		return &cp.MaticTokenAddress
+	case ContractStakingManager:
+		return &cp.StakingManagerAddress
+	case ContractSlashManager:
+		return &cp.SlashManagerAddress
+	case ContractRootChain:
+		return &cp.RootChainAddress
+	case ContractStakingInfo:
+		return &cp.StakingInfoAddress
+	case ContractStateSender:
+		return &cp.StateSenderAddress
+	case ContractStateReceiver:
+		return &cp.StateReceiverAddress
+	case ContractValidatorSet:
+		return &cp.ValidatorSetAddress
+	default:
+		return nil
+	}
+}
+
+// IsKnownContract returns true if chain params keep address of the contract
+func IsKnownContract(contract string) bool {
+	return (&ChainParams{}).contractField(contract) != nil
+}
+
+// ContractAddress returns chain params address of the contract
+func (cp ChainParams) ContractAddress(contract string) hmTypes.HeimdallAddress {
+	if field := cp.contractField(contract); field != nil {
+		return *field
+	}
+
+	return hmTypes.ZeroHeimdallAddress
+}
+
+// AcceptedAddresses returns addresses of the contract accepted at given heimdall height,
+// chain params address comes first
+func (cp ChainParams) AcceptedAddresses(contract string, height uint64) []common.Address {
+	addresses := []common.Address{cp.ContractAddress(contract).EthAddress()}
+	for _, ca := range cp.ContractAddresses {
+		if ca.Contract == contract && ca.IsActive(height) && ca.Address.EthAddress() != addresses[0] {
+			addresses = append(addresses, ca.Address.EthAddress())
+		}
+	}
+
+	return addresses
+}
+
+// IsAcceptedAddress returns true if address of the contract is accepted at given heimdall height
+func (cp ChainParams) IsAcceptedAddress(contract string, address common.Address, height uint64) bool {
+	for _, accepted := range cp.AcceptedAddresses(contract, height) {
+		if accepted == address {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ApplyContractAddresses moves rotation entries activated at given height to chain params and
+// removes entries deprecated at given height, it returns true if chain params changed
+func (cp *ChainParams) ApplyContractAddresses(height uint64) bool {
+	changed := false
+	remaining := make([]ContractAddress, 0, len(cp.ContractAddresses))
+	for _, ca := range cp.ContractAddresses {
+		if ca.Rotation && ca.ActivationHeight <= height {
+			if field := cp.contractField(ca.Contract); field != nil {
+				// previous address is accepted during migration window
+				if ca.DeprecationHeight == 0 || height < ca.DeprecationHeight {
+					remaining = append(remaining, ContractAddress{
+						Contract:          ca.Contract,
+						Address:           *field,
+						DeprecationHeight: ca.DeprecationHeight,
+					})
+				}
+				*field = ca.Address
+			}
+			changed = true
+			continue
+		}
+
+		if ca.DeprecationHeight != 0 && ca.DeprecationHeight <= height {
+			changed = true
+			continue
+		}
+
+		remaining = append(remaining, ca)
+	}
+
+	if changed {
+		cp.ContractAddresses = remaining
+	}
+
+	return changed
+}
+
+func validateContractAddresses(addresses []ContractAddress) error {
+	for _, ca := range addresses {
+		if !IsKnownContract(ca.Contract) {
+			return fmt.Errorf("Invalid contract %s in contract_addresses", ca.Contract)
+		}
+
+		if ca.Address.Empty() {
+			return fmt.Errorf("Invalid address of %s in contract_addresses", ca.Contract)
+		}
+
+		if ca.DeprecationHeight != 0 && ca.DeprecationHeight <= ca.ActivationHeight {
+			return fmt.Errorf("Deprecation height of %s %s must be greater than activation height", ca.Contract, ca.Address)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Chainmanager module codespace constants
+const (
+	DefaultCodespace sdk.CodespaceType = "chainmanager"
+
+	CodeInvalidContractChange sdk.CodeType = 1
+)
+
+// ErrEmptyContractChanges returns an error for empty contract changes.
+func ErrEmptyContractChanges(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidContractChange, "submitted contract changes are empty")
+}
+
+// ErrInvalidContractChange returns an error for invalid contract change.
+func ErrInvalidContractChange(codespace sdk.CodespaceType, change ContractChange, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidContractChange, fmt.Sprintf("invalid %s of %s %s: %s", change.Action, change.Contract, change.Address, msg))
+}
@@ -43,10 +43,17 @@ type ChainParams struct {
 	// Bor Chain Contracts
 	StateReceiverAddress hmTypes.HeimdallAddress `json:"state_receiver_address" yaml:"state_receiver_address"`
 	ValidatorSetAddress  hmTypes.HeimdallAddress `json:"validator_set_address" yaml:"validator_set_address"`
+
+	// Contract addresses accepted besides the ones above, during migrations
+	ContractAddresses []ContractAddress `json:"contract_addresses,omitempty" yaml:"contract_addresses,omitempty"`
+
+	// Additional bor chains served besides the one above
+	BorChains []BorChain `json:"bor_chains,omitempty" yaml:"bor_chains,omitempty"`
 }
 
 func (cp ChainParams) String() string {
-	return fmt.Sprintf(`
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(`
 	BorChainID: 									%s
   MaticTokenAddress:            %s
 	StakingManagerAddress:        %s
@@ -56,7 +63,17 @@ func (cp ChainParams) String() string {
 	StateSenderAddress:           %s
 	StateReceiverAddress: 				%s
 	ValidatorSetAddress:					%s`,
-		cp.BorChainID, cp.MaticTokenAddress, cp.StakingManagerAddress, cp.SlashManagerAddress, cp.RootChainAddress, cp.StakingInfoAddress, cp.StateSenderAddress, cp.StateReceiverAddress, cp.ValidatorSetAddress)
+		cp.BorChainID, cp.MaticTokenAddress, cp.StakingManagerAddress, cp.SlashManagerAddress, cp.RootChainAddress, cp.StakingInfoAddress, cp.StateSenderAddress, cp.StateReceiverAddress, cp.ValidatorSetAddress))
+
+	for _, ca := range cp.ContractAddresses {
+		sb.WriteString(fmt.Sprintf("\n\tContractAddress:              %s", ca.String()))
+	}
+
+	for _, bc := range cp.BorChains {
+		sb.WriteString(fmt.Sprintf("\n\tBorChain:                     %s", bc.String()))
+	}
+
+	return sb.String()
 }
 
 // Params defines the parameters for the chainmanager module.
@@ -137,7 +154,11 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return nil
+	if err := validateContractAddresses(p.ChainParams.ContractAddresses); err != nil {
+		return err
+	}
+
+	return validateBorChains(p.ChainParams.BorChainID, p.ChainParams.BorChains)
 }
 
 func validateHeimdallAddress(key string, value hmTypes.HeimdallAddress) error {
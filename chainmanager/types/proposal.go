@@ -0,0 +1,149 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	// ProposalTypeChainParams defines the type for a ChainParamsProposal
+	ProposalTypeChainParams = "ChainParams"
+)
+
+// Contract change actions
+const (
+	// ActionAdd accepts address of the contract besides the current one from activation height,
+	// until deprecation height if given
+	ActionAdd = "add"
+
+	// ActionRotate makes address the current address of the contract at activation height,
+	// previous address stays accepted until deprecation height if given
+	ActionRotate = "rotate"
+
+	// ActionDeprecate stops accepting added address of the contract at deprecation height
+	ActionDeprecate = "deprecate"
+)
+
+// Assert ChainParamsProposal implements govtypes.Content at compile-time
+var _ govTypes.Content = ChainParamsProposal{}
+
+func init() {
+	govTypes.RegisterProposalType(ProposalTypeChainParams)
+	govTypes.RegisterProposalTypeCodec(ChainParamsProposal{}, "heimdall/ChainParamsProposal")
+}
+
+// ChainParamsProposal defines a proposal which adds, rotates or deprecates contract addresses
+type ChainParamsProposal struct {
+	Title       string           `json:"title" yaml:"title"`
+	Description string           `json:"description" yaml:"description"`
+	Changes     []ContractChange `json:"changes" yaml:"changes"`
+}
+
+// NewChainParamsProposal creates new chain params proposal
+func NewChainParamsProposal(title, description string, changes []ContractChange) ChainParamsProposal {
+	return ChainParamsProposal{title, description, changes}
+}
+
+// GetTitle returns the title of a chain params proposal.
+func (cpp ChainParamsProposal) GetTitle() string { return cpp.Title }
+
+// GetDescription returns the description of a chain params proposal.
+func (cpp ChainParamsProposal) GetDescription() string { return cpp.Description }
+
+// ProposalRoute returns the routing key of a chain params proposal.
+func (cpp ChainParamsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a chain params proposal.
+func (cpp ChainParamsProposal) ProposalType() string { return ProposalTypeChainParams }
+
+// ValidateBasic validates the chain params proposal
+func (cpp ChainParamsProposal) ValidateBasic() sdk.Error {
+	if err := govTypes.ValidateAbstract(DefaultCodespace, cpp); err != nil {
+		return err
+	}
+
+	if len(cpp.Changes) == 0 {
+		return ErrEmptyContractChanges(DefaultCodespace)
+	}
+
+	for _, change := range cpp.Changes {
+		if err := change.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (cpp ChainParamsProposal) String() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf(`Chain Params Proposal:
+  Title:       %s
+  Description: %s
+  Changes:
+`, cpp.Title, cpp.Description))
+
+	for _, change := range cpp.Changes {
+		b.WriteString(change.String())
+	}
+
+	return b.String()
+}
+
+// ContractChange defines a change of contract address
+type ContractChange struct {
+	Action            string                  `json:"action" yaml:"action"`
+	Contract          string                  `json:"contract" yaml:"contract"`
+	Address           hmTypes.HeimdallAddress `json:"address" yaml:"address"`
+	ActivationHeight  uint64                  `json:"activation_height,omitempty" yaml:"activation_height,omitempty"`
+	DeprecationHeight uint64                  `json:"deprecation_height,omitempty" yaml:"deprecation_height,omitempty"`
+}
+
+// NewContractChange creates new contract change
+func NewContractChange(action, contract string, address hmTypes.HeimdallAddress, activationHeight, deprecationHeight uint64) ContractChange {
+	return ContractChange{action, contract, address, activationHeight, deprecationHeight}
+}
+
+// ValidateBasic validates the contract change
+func (c ContractChange) ValidateBasic() sdk.Error {
+	if !IsKnownContract(c.Contract) {
+		return ErrInvalidContractChange(DefaultCodespace, c, "unknown contract")
+	}
+
+	if c.Address.Empty() {
+		return ErrInvalidContractChange(DefaultCodespace, c, "address is empty")
+	}
+
+	switch c.Action {
+	case ActionAdd, ActionRotate:
+		if c.DeprecationHeight != 0 && c.DeprecationHeight <= c.ActivationHeight {
+			return ErrInvalidContractChange(DefaultCodespace, c, "deprecation height must be greater than activation height")
+		}
+	case ActionDeprecate:
+		if c.DeprecationHeight == 0 {
+			return ErrInvalidContractChange(DefaultCodespace, c, "deprecation height is required")
+		}
+	default:
+		return ErrInvalidContractChange(DefaultCodespace, c, "unknown action")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (c ContractChange) String() string {
+	return fmt.Sprintf(`    Contract Change:
+      Action:            %s
+      Contract:          %s
+      Address:           %s
+      ActivationHeight:  %d
+      DeprecationHeight: %d
+`, c.Action, c.Contract, c.Address, c.ActivationHeight, c.DeprecationHeight)
+}
@@ -84,7 +84,13 @@ func GetCheckpointBuffer(cdc *codec.Codec) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBuffer), nil)
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorChainID(viper.GetString(FlagBorChainID)))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBuffer), queryParams)
 			if err != nil {
 				return err
 			}
@@ -98,6 +104,8 @@ func GetCheckpointBuffer(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>")
+
 	return cmd
 }
 
@@ -141,7 +149,7 @@ func GetCheckpointByNumber(cdc *codec.Codec) *cobra.Command {
 			headerNumber := viper.GetUint64(FlagHeaderNumber)
 
 			// get query params
-			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(headerNumber))
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryChainCheckpointParams(viper.GetString(FlagBorChainID), headerNumber))
 			if err != nil {
 				return err
 			}
@@ -158,6 +166,7 @@ func GetCheckpointByNumber(cdc *codec.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().Uint64(FlagHeaderNumber, 0, "--header=<header-number>")
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>")
 	if err := cmd.MarkFlagRequired(FlagHeaderNumber); err != nil {
 		logger.Error("GetHeaderFromIndex | MarkFlagRequired | FlagHeaderNumber", "Error", err)
 	}
@@ -173,7 +182,13 @@ func GetCheckpointCount(cdc *codec.Codec) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), nil)
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorChainID(viper.GetString(FlagBorChainID)))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), queryParams)
 			if err != nil {
 				return err
 			}
@@ -192,5 +207,7 @@ func GetCheckpointCount(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>")
+
 	return cmd
 }
@@ -293,9 +293,16 @@ func SendCheckpointACKTx(cdc *codec.Codec) *cobra.Command {
 				return errors.New("Transaction is not confirmed yet. Please wait for sometime and try again")
 			}
 
+			// checkpoints of additional bor chains are submitted to their root chain contract
+			borChainID := viper.GetString(FlagBorChainID)
+			borChain, ok := chainmanagerParams.ChainParams.GetBorChain(borChainID)
+			if !ok {
+				return fmt.Errorf("unknown bor chain %v", borChainID)
+			}
+
 			// decode new header block event
 			res, err := contractCallerObj.DecodeNewHeaderBlockEvent(
-				chainmanagerParams.ChainParams.RootChainAddress.EthAddress(),
+				borChain.RootChainAddress.EthAddress(),
 				receipt,
 				uint64(viper.GetInt64(FlagCheckpointLogIndex)),
 			)
@@ -313,6 +320,7 @@ func SendCheckpointACKTx(cdc *codec.Codec) *cobra.Command {
 				res.Root,
 				txHash,
 				uint64(viper.GetInt64(FlagCheckpointLogIndex)),
+				borChainID,
 			)
 
 			// msg
@@ -324,6 +332,7 @@ func SendCheckpointACKTx(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(FlagHeaderNumber, "", "--header=<header-index>")
 	cmd.Flags().StringP(FlagCheckpointTxHash, "t", "", "--txhash=<checkpoint-txhash>")
 	cmd.Flags().String(FlagCheckpointLogIndex, "", "--log-index=<log-index>")
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>")
 
 	if err := cmd.MarkFlagRequired(FlagHeaderNumber); err != nil {
 		logger.Error("SendCheckpointACKTx | MarkFlagRequired | FlagHeaderNumber", "Error", err)
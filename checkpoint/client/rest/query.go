@@ -69,8 +69,15 @@ func checkpointBufferHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorChainID(r.URL.Query().Get("bor_chain_id")))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		// fetch checkpoint
-		result, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBuffer), nil)
+		result, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBuffer), queryParams)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -88,8 +95,15 @@ func checkpointCountHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorChainID(r.URL.Query().Get("bor_chain_id")))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		RestLogger.Debug("Fetching number of checkpoints from state")
-		ackCountBytes, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), nil)
+		ackCountBytes, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), queryParams)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -158,8 +172,17 @@ func prepareCheckpointHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			json.Unmarshal(res, &params)
 			contractCallerObj, err := helper.NewContractCaller()
 
+			// headers of additional bor chains are read from their own endpoints
+			var borChainCaller helper.IContractCaller = &contractCallerObj
+			if borChainID := r.URL.Query().Get("bor_chain_id"); helper.GetBorChainPool(borChainID) != nil {
+				if borChainCaller, err = contractCallerObj.GetBorChainCaller(borChainID); err != nil {
+					hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+					return
+				}
+			}
+
 			// get headers
-			roothash, err := contractCallerObj.GetRootHash(uint64(start), uint64(end), params.MaxCheckpointLength)
+			roothash, err := borChainCaller.GetRootHash(uint64(start), uint64(end), params.MaxCheckpointLength)
 			if err != nil {
 				RestLogger.Error("Unable to get roothash", "Start", start, "End", end, "Error", err)
 				hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -361,11 +384,20 @@ func latestCheckpointHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		// checkpoints of additional bor chains are queried by bor chain id
+		borChainID := r.URL.Query().Get("bor_chain_id")
+
 		//
 		// Get ack count
 		//
 
-		ackcountBytes, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), nil)
+		ackCountParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorChainID(borChainID))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		ackcountBytes, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), ackCountParams)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -391,7 +423,7 @@ func latestCheckpointHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
 		RestLogger.Debug("Last checkpoint key generated", "lastCheckpointKey", lastCheckpointKey)
 
 		// get query params
-		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(lastCheckpointKey))
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryChainCheckpointParams(borChainID, lastCheckpointKey))
 		if err != nil {
 			return
 		}
@@ -461,7 +493,7 @@ func checkpointByNumberHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		// get query params
-		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(number))
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryChainCheckpointParams(r.URL.Query().Get("bor_chain_id"), number))
 		if err != nil {
 			return
 		}
@@ -525,7 +557,7 @@ func checkpointListhandlerFn(
 		}
 
 		// get query params
-		queryParams, err := cliCtx.Codec.MarshalJSON(hmTypes.NewQueryPaginationParams(page, limit))
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointListParams(vars.Get("bor_chain_id"), page, limit))
 		if err != nil {
 			return
 		}
@@ -48,6 +48,7 @@ type (
 		RootHash    hmTypes.HeimdallHash    `json:"root_Hash"`
 		TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
 		LogIndex    uint64                  `json:"log_index"`
+		BorChainID  string                  `json:"bor_chain_id"`
 	}
 
 	// HeaderNoACKReq struct for sending no-ack for a new headers
@@ -107,6 +108,7 @@ func newCheckpointACKHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			req.RootHash,
 			req.TxHash,
 			req.LogIndex,
+			req.BorChainID,
 		)
 
 		// send response
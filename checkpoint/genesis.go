@@ -44,6 +44,32 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 
 	// Set initial ack count
 	keeper.UpdateACKCountWithValue(ctx, data.AckCount)
+
+	// Add checkpoints, buffer and ack count of additional bor chains
+	for _, borChain := range data.BorChains {
+		if len(borChain.Checkpoints) != 0 {
+			if int(borChain.AckCount) != len(borChain.Checkpoints) {
+				panic(errors.New("Incorrect state in state-dump , Please Check "))
+			}
+
+			for i, checkpoint := range hmTypes.SortHeaders(borChain.Checkpoints) {
+				checkpoint.BorChainID = borChain.BorChainID
+				if err := keeper.AddCheckpoint(ctx, uint64(i)+1, checkpoint); err != nil {
+					keeper.Logger(ctx).Error("InitGenesis | AddCheckpoint", "borChainID", borChain.BorChainID, "error", err)
+				}
+			}
+		}
+
+		if borChain.BufferedCheckpoint != nil {
+			checkpoint := *borChain.BufferedCheckpoint
+			checkpoint.BorChainID = borChain.BorChainID
+			if err := keeper.SetCheckpointBuffer(ctx, checkpoint); err != nil {
+				keeper.Logger(ctx).Error("InitGenesis | SetCheckpointBuffer", "borChainID", borChain.BorChainID, "error", err)
+			}
+		}
+
+		keeper.UpdateChainACKCountWithValue(ctx, borChain.BorChainID, borChain.AckCount)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -51,11 +77,24 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	params := keeper.GetParams(ctx)
 
 	bufferedCheckpoint, _ := keeper.GetCheckpointFromBuffer(ctx)
-	return types.NewGenesisState(
+	genesisState := types.NewGenesisState(
 		params,
 		bufferedCheckpoint,
 		keeper.GetLastNoAck(ctx),
 		keeper.GetACKCount(ctx),
 		hmTypes.SortHeaders(keeper.GetCheckpoints(ctx)),
 	)
+
+	// export state of additional bor chains
+	for _, borChain := range keeper.ck.GetParams(ctx).ChainParams.BorChains {
+		chainBufferedCheckpoint, _ := keeper.GetChainCheckpointFromBuffer(ctx, borChain.BorChainID)
+		genesisState.BorChains = append(genesisState.BorChains, types.BorChainGenesisState{
+			BorChainID:         borChain.BorChainID,
+			BufferedCheckpoint: chainBufferedCheckpoint,
+			AckCount:           keeper.GetChainACKCount(ctx, borChain.BorChainID),
+			Checkpoints:        hmTypes.SortHeaders(keeper.GetChainCheckpoints(ctx, borChain.BorChainID)),
+		})
+	}
+
+	return genesisState
 }
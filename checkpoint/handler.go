@@ -81,13 +81,13 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 	// Check checkpoint buffer
 	//
 
-	checkpointBuffer, err := k.GetCheckpointFromBuffer(ctx)
+	checkpointBuffer, err := k.GetChainCheckpointFromBuffer(ctx, msg.BorChainID)
 	if err == nil {
 		checkpointBufferTime := uint64(params.CheckpointBufferTime.Seconds())
 
 		if checkpointBuffer.TimeStamp == 0 || ((timeStamp > checkpointBuffer.TimeStamp) && timeStamp-checkpointBuffer.TimeStamp >= checkpointBufferTime) {
 			logger.Debug("Checkpoint has been timed out. Flushing buffer.", "checkpointTimestamp", timeStamp, "prevCheckpointTimestamp", checkpointBuffer.TimeStamp)
-			k.FlushCheckpointBuffer(ctx)
+			k.FlushChainCheckpointBuffer(ctx, msg.BorChainID)
 		} else {
 			expiryTime := checkpointBuffer.TimeStamp + checkpointBufferTime
 			logger.Error("Checkpoint already exits in buffer", "Checkpoint", checkpointBuffer.String(), "Expires", expiryTime)
@@ -100,7 +100,7 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 	//
 
 	// fetch last checkpoint from store
-	if lastCheckpoint, err := k.GetLastCheckpoint(ctx); err == nil {
+	if lastCheckpoint, err := k.GetChainLastCheckpoint(ctx, msg.BorChainID); err == nil {
 		// make sure new checkpoint is after tip
 		if lastCheckpoint.EndBlock > msg.StartBlock {
 			logger.Error("Checkpoint already exists",
@@ -179,6 +179,7 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 			sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(msg.EndBlock, 10)),
 			sdk.NewAttribute(types.AttributeKeyRootHash, msg.RootHash.String()),
 			sdk.NewAttribute(types.AttributeKeyAccountHash, msg.AccountRootHash.String()),
+			sdk.NewAttribute(types.AttributeKeyBorChainID, msg.BorChainID),
 		),
 	})
 
@@ -192,7 +193,7 @@ func handleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keepe
 	logger := k.Logger(ctx)
 
 	// Get last checkpoint from buffer
-	headerBlock, err := k.GetCheckpointFromBuffer(ctx)
+	headerBlock, err := k.GetChainCheckpointFromBuffer(ctx, msg.BorChainID)
 	if err != nil {
 		logger.Error("Unable to get checkpoint", "error", err)
 		return common.ErrBadAck(k.Codespace()).Result()
@@ -221,6 +222,7 @@ func handleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keepe
 			types.EventTypeCheckpointAck,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(types.AttributeKeyHeaderIndex, strconv.FormatUint(msg.Number, 10)),
+			sdk.NewAttribute(types.AttributeKeyBorChainID, msg.BorChainID),
 		),
 	})
 
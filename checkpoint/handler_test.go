@@ -327,6 +327,7 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointAck() {
 			header.RootHash,
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			header.BorChainID,
 		)
 		result := suite.handler(ctx, msgCheckpointAck)
 		require.True(t, result.IsOK(), "expected send-ack to be ok, got %v", result)
@@ -344,6 +345,7 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointAck() {
 			header.RootHash,
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			header.BorChainID,
 		)
 
 		got := suite.handler(ctx, msgCheckpointAck)
@@ -360,6 +362,7 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointAck() {
 			hmTypes.HexToHeimdallHash("9887"),
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			header.BorChainID,
 		)
 
 		got := suite.handler(ctx, msgCheckpointAck)
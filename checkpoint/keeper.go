@@ -79,10 +79,15 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
 }
 
-// AddCheckpoint adds checkpoint into final blocks
+// chainStore returns the store keeping checkpoints of the bor chain
+func (k Keeper) chainStore(ctx sdk.Context, chainID string) sdk.KVStore {
+	return k.ck.BorChainStore(ctx, ctx.KVStore(k.storeKey), chainID)
+}
+
+// AddCheckpoint adds checkpoint into final blocks of its bor chain
 func (k *Keeper) AddCheckpoint(ctx sdk.Context, checkpointNumber uint64, checkpoint hmTypes.Checkpoint) error {
 	key := GetCheckpointKey(checkpointNumber)
-	err := k.addCheckpoint(ctx, key, checkpoint)
+	err := k.addCheckpoint(ctx, k.chainStore(ctx, checkpoint.BorChainID), key, checkpoint)
 	if err != nil {
 		return err
 	}
@@ -90,9 +95,9 @@ func (k *Keeper) AddCheckpoint(ctx sdk.Context, checkpointNumber uint64, checkpo
 	return nil
 }
 
-// SetCheckpointBuffer flushes Checkpoint Buffer
+// SetCheckpointBuffer flushes Checkpoint Buffer of checkpoint's bor chain
 func (k *Keeper) SetCheckpointBuffer(ctx sdk.Context, checkpoint hmTypes.Checkpoint) error {
-	err := k.addCheckpoint(ctx, BufferCheckpointKey, checkpoint)
+	err := k.addCheckpoint(ctx, k.chainStore(ctx, checkpoint.BorChainID), BufferCheckpointKey, checkpoint)
 	if err != nil {
 		return err
 	}
@@ -100,9 +105,7 @@ func (k *Keeper) SetCheckpointBuffer(ctx sdk.Context, checkpoint hmTypes.Checkpo
 }
 
 // addCheckpoint adds checkpoint to store
-func (k *Keeper) addCheckpoint(ctx sdk.Context, key []byte, checkpoint hmTypes.Checkpoint) error {
-	store := ctx.KVStore(k.storeKey)
-
+func (k *Keeper) addCheckpoint(ctx sdk.Context, store sdk.KVStore, key []byte, checkpoint hmTypes.Checkpoint) error {
 	// create Checkpoint block and marshall
 	out, err := k.cdc.MarshalBinaryBare(checkpoint)
 	if err != nil {
@@ -118,7 +121,12 @@ func (k *Keeper) addCheckpoint(ctx sdk.Context, key []byte, checkpoint hmTypes.C
 
 // GetCheckpointByNumber to get checkpoint by checkpoint number
 func (k *Keeper) GetCheckpointByNumber(ctx sdk.Context, number uint64) (hmTypes.Checkpoint, error) {
-	store := ctx.KVStore(k.storeKey)
+	return k.GetChainCheckpointByNumber(ctx, "", number)
+}
+
+// GetChainCheckpointByNumber to get checkpoint of the bor chain by checkpoint number
+func (k *Keeper) GetChainCheckpointByNumber(ctx sdk.Context, chainID string, number uint64) (hmTypes.Checkpoint, error) {
+	store := k.chainStore(ctx, chainID)
 	checkpointKey := GetCheckpointKey(number)
 	var _checkpoint hmTypes.Checkpoint
 
@@ -136,7 +144,12 @@ func (k *Keeper) GetCheckpointByNumber(ctx sdk.Context, number uint64) (hmTypes.
 
 // GetCheckpointList returns all checkpoints with params like page and limit
 func (k *Keeper) GetCheckpointList(ctx sdk.Context, page uint64, limit uint64) ([]hmTypes.Checkpoint, error) {
-	store := ctx.KVStore(k.storeKey)
+	return k.GetChainCheckpointList(ctx, "", page, limit)
+}
+
+// GetChainCheckpointList returns checkpoints of the bor chain with params like page and limit
+func (k *Keeper) GetChainCheckpointList(ctx sdk.Context, chainID string, page uint64, limit uint64) ([]hmTypes.Checkpoint, error) {
+	store := k.chainStore(ctx, chainID)
 
 	// create headers
 	var checkpoints []hmTypes.Checkpoint
@@ -162,8 +175,13 @@ func (k *Keeper) GetCheckpointList(ctx sdk.Context, page uint64, limit uint64) (
 
 // GetLastCheckpoint gets last checkpoint, checkpoint number = TotalACKs
 func (k *Keeper) GetLastCheckpoint(ctx sdk.Context) (hmTypes.Checkpoint, error) {
-	store := ctx.KVStore(k.storeKey)
-	acksCount := k.GetACKCount(ctx)
+	return k.GetChainLastCheckpoint(ctx, "")
+}
+
+// GetChainLastCheckpoint gets last checkpoint of the bor chain, checkpoint number = TotalACKs of the chain
+func (k *Keeper) GetChainLastCheckpoint(ctx sdk.Context, chainID string) (hmTypes.Checkpoint, error) {
+	store := k.chainStore(ctx, chainID)
+	acksCount := k.GetChainACKCount(ctx, chainID)
 
 	lastCheckpointKey := acksCount
 
@@ -199,13 +217,23 @@ func (k *Keeper) HasStoreValue(ctx sdk.Context, key []byte) bool {
 
 // FlushCheckpointBuffer flushes Checkpoint Buffer
 func (k *Keeper) FlushCheckpointBuffer(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
+	k.FlushChainCheckpointBuffer(ctx, "")
+}
+
+// FlushChainCheckpointBuffer flushes Checkpoint Buffer of the bor chain
+func (k *Keeper) FlushChainCheckpointBuffer(ctx sdk.Context, chainID string) {
+	store := k.chainStore(ctx, chainID)
 	store.Delete(BufferCheckpointKey)
 }
 
 // GetCheckpointFromBuffer gets checkpoint in buffer
 func (k *Keeper) GetCheckpointFromBuffer(ctx sdk.Context) (*hmTypes.Checkpoint, error) {
-	store := ctx.KVStore(k.storeKey)
+	return k.GetChainCheckpointFromBuffer(ctx, "")
+}
+
+// GetChainCheckpointFromBuffer gets checkpoint in buffer of the bor chain
+func (k *Keeper) GetChainCheckpointFromBuffer(ctx sdk.Context, chainID string) (*hmTypes.Checkpoint, error) {
+	store := k.chainStore(ctx, chainID)
 
 	// checkpoint block header
 	var checkpoint hmTypes.Checkpoint
@@ -244,7 +272,12 @@ func (k *Keeper) GetLastNoAck(ctx sdk.Context) uint64 {
 
 // GetCheckpoints get checkpoint all checkpoints
 func (k *Keeper) GetCheckpoints(ctx sdk.Context) []hmTypes.Checkpoint {
-	store := ctx.KVStore(k.storeKey)
+	return k.GetChainCheckpoints(ctx, "")
+}
+
+// GetChainCheckpoints get all checkpoints of the bor chain
+func (k *Keeper) GetChainCheckpoints(ctx sdk.Context, chainID string) []hmTypes.Checkpoint {
+	store := k.chainStore(ctx, chainID)
 	// get checkpoint header iterator
 	iterator := sdk.KVStorePrefixIterator(store, CheckpointKey)
 	defer iterator.Close()
@@ -266,9 +299,14 @@ func (k *Keeper) GetCheckpoints(ctx sdk.Context) []hmTypes.Checkpoint {
 // Ack count
 //
 
-// GetACKCount returns current ACK count
+// GetACKCount returns current ACK count of the default bor chain, which is the validator epoch
 func (k Keeper) GetACKCount(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
+	return k.GetChainACKCount(ctx, "")
+}
+
+// GetChainACKCount returns current ACK count of the bor chain
+func (k Keeper) GetChainACKCount(ctx sdk.Context, chainID string) uint64 {
+	store := k.chainStore(ctx, chainID)
 	// check if ack count is there
 	if store.Has(ACKCountKey) {
 		// get current ACK count
@@ -285,7 +323,12 @@ func (k Keeper) GetACKCount(ctx sdk.Context) uint64 {
 
 // UpdateACKCountWithValue updates ACK with value
 func (k Keeper) UpdateACKCountWithValue(ctx sdk.Context, value uint64) {
-	store := ctx.KVStore(k.storeKey)
+	k.UpdateChainACKCountWithValue(ctx, "", value)
+}
+
+// UpdateChainACKCountWithValue updates ACK of the bor chain with value
+func (k Keeper) UpdateChainACKCountWithValue(ctx sdk.Context, chainID string, value uint64) {
+	store := k.chainStore(ctx, chainID)
 
 	// convert
 	ackCount := []byte(strconv.FormatUint(value, 10))
@@ -296,10 +339,15 @@ func (k Keeper) UpdateACKCountWithValue(ctx sdk.Context, value uint64) {
 
 // UpdateACKCount updates ACK count by 1
 func (k Keeper) UpdateACKCount(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
+	k.UpdateChainACKCount(ctx, "")
+}
+
+// UpdateChainACKCount updates ACK count of the bor chain by 1
+func (k Keeper) UpdateChainACKCount(ctx sdk.Context, chainID string) {
+	store := k.chainStore(ctx, chainID)
 
 	// get current ACK Count
-	ACKCount := k.GetACKCount(ctx)
+	ACKCount := k.GetChainACKCount(ctx, chainID)
 
 	// increment by 1
 	ACKs := []byte(strconv.FormatUint(ACKCount+1, 10))
@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/app"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/checkpoint"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 
@@ -114,3 +115,62 @@ func (suite *KeeperTestSuite) TestFlushCheckpointBuffer() {
 	result := keeper.HasStoreValue(ctx, key)
 	require.False(t, result)
 }
+
+func (suite *KeeperTestSuite) TestBorChainCheckpoints() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	params := app.ChainKeeper.GetParams(ctx)
+	params.ChainParams.BorChains = []chainmanagerTypes.BorChain{
+		{
+			BorChainID:           "15002",
+			RootChainAddress:     hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000000b01"),
+			StateReceiverAddress: hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000000b02"),
+			ValidatorSetAddress:  hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000000b03"),
+		},
+	}
+	app.ChainKeeper.SetParams(ctx, params)
+
+	rootHash := hmTypes.HexToHeimdallHash("123")
+	proposerAddress := hmTypes.HexToHeimdallAddress("123")
+	timestamp := uint64(time.Now().Unix())
+
+	defaultCheckpoint := hmTypes.CreateBlock(0, 255, rootHash, proposerAddress, params.ChainParams.BorChainID, timestamp)
+	require.NoError(t, keeper.AddCheckpoint(ctx, 1, defaultCheckpoint))
+	keeper.UpdateACKCount(ctx)
+
+	chainCheckpoint := hmTypes.CreateBlock(0, 511, rootHash, proposerAddress, "15002", timestamp)
+	require.NoError(t, keeper.AddCheckpoint(ctx, 1, chainCheckpoint))
+	keeper.UpdateChainACKCount(ctx, "15002")
+	keeper.UpdateChainACKCount(ctx, "15002")
+
+	// checkpoints of additional chain are kept apart
+	result, err := keeper.GetCheckpointByNumber(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(255), result.EndBlock)
+
+	result, err = keeper.GetChainCheckpointByNumber(ctx, "15002", 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(511), result.EndBlock)
+
+	// default chain id shares store with legacy data
+	result, err = keeper.GetChainCheckpointByNumber(ctx, params.ChainParams.BorChainID, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(255), result.EndBlock)
+
+	require.Equal(t, keeper.GetACKCount(ctx), keeper.GetChainACKCount(ctx, params.ChainParams.BorChainID))
+	require.Equal(t, uint64(2), keeper.GetChainACKCount(ctx, "15002"))
+
+	// buffers are separate
+	require.NoError(t, keeper.SetCheckpointBuffer(ctx, chainCheckpoint))
+	_, err = keeper.GetCheckpointFromBuffer(ctx)
+	require.Error(t, err)
+
+	buffered, err := keeper.GetChainCheckpointFromBuffer(ctx, "15002")
+	require.NoError(t, err)
+	require.Equal(t, chainCheckpoint, *buffered)
+
+	keeper.FlushChainCheckpointBuffer(ctx, "15002")
+	_, err = keeper.GetChainCheckpointFromBuffer(ctx, "15002")
+	require.Error(t, err)
+}
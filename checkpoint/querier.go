@@ -47,7 +47,12 @@ func handleQueryParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([
 }
 
 func handleQueryAckCount(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	bz, err := json.Marshal(keeper.GetACKCount(ctx))
+	borChainID, sdkErr := queryBorChainID(ctx, req, keeper)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	bz, err := json.Marshal(keeper.GetChainACKCount(ctx, borChainID))
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 	}
@@ -60,7 +65,11 @@ func handleQueryCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
-	res, err := keeper.GetCheckpointByNumber(ctx, params.Number)
+	if !keeper.ck.IsKnownBorChain(ctx, params.BorChainID) {
+		return nil, common.ErrInvalidBorChainID(keeper.Codespace())
+	}
+
+	res, err := keeper.GetChainCheckpointByNumber(ctx, params.BorChainID, params.Number)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch checkpoint by index %v", params.Number), err.Error()))
 	}
@@ -73,7 +82,12 @@ func handleQueryCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 }
 
 func handleQueryCheckpointBuffer(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	res, err := keeper.GetCheckpointFromBuffer(ctx)
+	borChainID, sdkErr := queryBorChainID(ctx, req, keeper)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	res, err := keeper.GetChainCheckpointFromBuffer(ctx, borChainID)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch checkpoint buffer", err.Error()))
 	}
@@ -101,12 +115,16 @@ func handleQueryLastNoAck(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 }
 
 func handleQueryCheckpointList(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	var params hmTypes.QueryPaginationParams
+	var params types.QueryCheckpointListParams
 	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
-	res, err := keeper.GetCheckpointList(ctx, params.Page, params.Limit)
+	if !keeper.ck.IsKnownBorChain(ctx, params.BorChainID) {
+		return nil, common.ErrInvalidBorChainID(keeper.Codespace())
+	}
+
+	res, err := keeper.GetChainCheckpointList(ctx, params.BorChainID, params.Page, params.Limit)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch checkpoint list with page %v and limit %v", params.Page, params.Limit), err.Error()))
 	}
@@ -127,14 +145,14 @@ func handleQueryNextCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Ke
 	// get validator set
 	validatorSet := sk.GetValidatorSet(ctx)
 	proposer := validatorSet.GetProposer()
-	ackCount := keeper.GetACKCount(ctx)
+	ackCount := keeper.GetChainACKCount(ctx, queryParams.BorChainID)
 	params := keeper.GetParams(ctx)
 
 	var start uint64
 
 	if ackCount != 0 {
 		checkpointNumber := ackCount
-		lastCheckpoint, err := keeper.GetCheckpointByNumber(ctx, checkpointNumber)
+		lastCheckpoint, err := keeper.GetChainCheckpointByNumber(ctx, queryParams.BorChainID, checkpointNumber)
 		if err != nil {
 			return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch checkpoint by index %v", checkpointNumber), err.Error()))
 		}
@@ -143,7 +161,12 @@ func handleQueryNextCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Ke
 
 	end := start + params.AvgCheckpointLength
 
-	rootHash, err := contractCaller.GetRootHash(start, end, params.MaxCheckpointLength)
+	borChainCaller, err := keeper.ck.GetBorChainCaller(ctx, contractCaller, queryParams.BorChainID)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not get contract caller of bor chain %v", queryParams.BorChainID), err.Error()))
+	}
+
+	rootHash, err := borChainCaller.GetRootHash(start, end, params.MaxCheckpointLength)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch roothash for start:%v end:%v error:%v", start, end, err), err.Error()))
 	}
@@ -168,3 +191,21 @@ func handleQueryNextCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Ke
 	}
 	return bz, nil
 }
+
+// queryBorChainID returns bor chain id of optional query params, empty for the default bor chain
+func queryBorChainID(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (string, sdk.Error) {
+	if len(req.Data) == 0 {
+		return "", nil
+	}
+
+	var params types.QueryBorChainID
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return "", sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if !keeper.ck.IsKnownBorChain(ctx, params.BorChainID) {
+		return "", common.ErrInvalidBorChainID(keeper.Codespace())
+	}
+
+	return params.BorChainID, nil
+}
@@ -5,9 +5,11 @@ import (
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethCommon "github.com/maticnetwork/bor/common"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmTypes "github.com/tendermint/tendermint/types"
 
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/helper"
@@ -52,13 +54,7 @@ func SideHandleMsgCheckpointAdjust(ctx sdk.Context, k Keeper, msg types.MsgCheck
 		return common.ErrorSideTx(k.Codespace(), common.CodeNoCheckpoint)
 	}
 
-	rootChainInstance, err := contractCaller.GetRootChainInstance(chainParams.RootChainAddress.EthAddress())
-	if err != nil {
-		logger.Error("Unable to fetch rootchain contract instance", "error", err)
-		return common.ErrorSideTx(k.Codespace(), common.CodeOldCheckpoint)
-	}
-
-	root, start, end, _, proposer, err := contractCaller.GetHeaderInfo(msg.HeaderIndex, rootChainInstance, params.ChildBlockInterval)
+	root, start, end, _, proposer, err := contractCaller.GetHeaderInfo(msg.HeaderIndex, chainParams.RootChainAddress.EthAddress(), params.ChildBlockInterval)
 	if err != nil {
 		logger.Error("Unable to fetch checkpoint from rootchain", "error", err, "checkpointNumber", msg.HeaderIndex)
 		return common.ErrorSideTx(k.Codespace(), common.CodeNoCheckpoint)
@@ -87,8 +83,15 @@ func SideHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 	// logger
 	logger := k.Logger(ctx)
 
+	// contract caller of the bor chain
+	borChainCaller, err := k.ck.GetBorChainCaller(ctx, contractCaller, msg.BorChainID)
+	if err != nil {
+		logger.Error("Error fetching contract caller of bor chain", "borChainID", msg.BorChainID, "error", err)
+		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidBlockInput)
+	}
+
 	// validate checkpoint
-	validCheckpoint, err := types.ValidateCheckpoint(msg.StartBlock, msg.EndBlock, msg.RootHash, params.MaxCheckpointLength, contractCaller, maticTxConfirmations)
+	validCheckpoint, err := types.ValidateCheckpoint(msg.StartBlock, msg.EndBlock, msg.RootHash, params.MaxCheckpointLength, borChainCaller, maticTxConfirmations)
 	if err != nil {
 		logger.Error("Error validating checkpoint",
 			"error", err,
@@ -116,31 +119,40 @@ func SideHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 	logger := k.Logger(ctx)
 
 	params := k.GetParams(ctx)
-	chainParams := k.ck.GetParams(ctx).ChainParams
 
 	//
 	// Validate data from root chain
 	//
 
-	rootChainInstance, err := contractCaller.GetRootChainInstance(chainParams.RootChainAddress.EthAddress())
-	if err != nil {
-		logger.Error("Unable to fetch rootchain contract instance", "error", err)
-		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
+	// the checkpoint may have been submitted to any root chain address accepted
+	// at this height, which includes a previous address during its rotation window.
+	// checkpoints of additional bor chains are submitted to their own root chain contract
+	rootChainAddresses := k.ck.GetAcceptedAddresses(ctx, chainmanagerTypes.ContractRootChain)
+	if chainParams := k.ck.GetParams(ctx).ChainParams; chainParams.IsAdditionalBorChain(msg.BorChainID) {
+		borChain, _ := chainParams.GetBorChain(msg.BorChainID)
+		rootChainAddresses = []ethCommon.Address{borChain.RootChainAddress.EthAddress()}
 	}
 
-	root, start, end, _, proposer, err := contractCaller.GetHeaderInfo(msg.Number, rootChainInstance, params.ChildBlockInterval)
-	if err != nil {
-		logger.Error("Unable to fetch checkpoint from rootchain", "error", err, "checkpointNumber", msg.Number)
-		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
-	}
+	matched := false
+	for _, rootChainAddress := range rootChainAddresses {
+		root, start, end, _, proposer, err := contractCaller.GetHeaderInfo(msg.Number, rootChainAddress, params.ChildBlockInterval)
+		if err != nil {
+			logger.Error("Unable to fetch checkpoint from rootchain", "error", err, "checkpointNumber", msg.Number, "address", rootChainAddress.Hex())
+			continue
+		}
 
-	// check if message data matches with contract data
-	if msg.StartBlock != start ||
-		msg.EndBlock != end ||
-		!msg.Proposer.Equals(proposer) ||
-		!bytes.Equal(msg.RootHash.Bytes(), root.Bytes()) {
+		// check if message data matches with contract data
+		if msg.StartBlock == start &&
+			msg.EndBlock == end &&
+			msg.Proposer.Equals(proposer) &&
+			bytes.Equal(msg.RootHash.Bytes(), root.Bytes()) {
+			matched = true
+			break
+		}
+	}
 
-		logger.Error("Invalid message. It doesn't match with contract state", "error", err, "checkpointNumber", msg.Number)
+	if !matched {
+		logger.Error("Invalid message. It doesn't match with contract state", "checkpointNumber", msg.Number)
 		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
 	}
 
@@ -253,7 +265,7 @@ func PostHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 	//
 
 	// fetch last checkpoint from store
-	if lastCheckpoint, err := k.GetLastCheckpoint(ctx); err == nil {
+	if lastCheckpoint, err := k.GetChainLastCheckpoint(ctx, msg.BorChainID); err == nil {
 		// make sure new checkpoint is after tip
 		if lastCheckpoint.EndBlock > msg.StartBlock {
 			logger.Error("Checkpoint already exists",
@@ -279,7 +291,7 @@ func PostHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 	// Save checkpoint to buffer store
 	//
 
-	checkpointBuffer, err := k.GetCheckpointFromBuffer(ctx)
+	checkpointBuffer, err := k.GetChainCheckpointFromBuffer(ctx, msg.BorChainID)
 	if err == nil && checkpointBuffer != nil {
 		logger.Debug("Checkpoint already exists in buffer")
 
@@ -326,6 +338,7 @@ func PostHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 			sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(msg.EndBlock, 10)),
 			sdk.NewAttribute(types.AttributeKeyRootHash, msg.RootHash.String()),
 			sdk.NewAttribute(types.AttributeKeyAccountHash, msg.AccountRootHash.String()),
+			sdk.NewAttribute(types.AttributeKeyBorChainID, msg.BorChainID),
 		),
 	})
 
@@ -345,7 +358,7 @@ func PostHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 	}
 
 	// get last checkpoint from buffer
-	checkpointObj, err := k.GetCheckpointFromBuffer(ctx)
+	checkpointObj, err := k.GetChainCheckpointFromBuffer(ctx, msg.BorChainID)
 	if err != nil {
 		logger.Error("Unable to get checkpoint buffer", "error", err)
 		return common.ErrBadAck(k.Codespace()).Result()
@@ -390,15 +403,17 @@ func PostHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 	logger.Debug("Checkpoint added to store", "checkpointNumber", msg.Number)
 
 	// Flush buffer
-	k.FlushCheckpointBuffer(ctx)
+	k.FlushChainCheckpointBuffer(ctx, msg.BorChainID)
 	logger.Debug("Checkpoint buffer flushed after receiving checkpoint ack")
 
 	// Update ack count in staking module
-	k.UpdateACKCount(ctx)
-	logger.Info("Valid ack received", "CurrentACKCount", k.GetACKCount(ctx)-1, "UpdatedACKCount", k.GetACKCount(ctx))
+	k.UpdateChainACKCount(ctx, msg.BorChainID)
+	logger.Info("Valid ack received", "borChainID", msg.BorChainID, "CurrentACKCount", k.GetChainACKCount(ctx, msg.BorChainID)-1, "UpdatedACKCount", k.GetChainACKCount(ctx, msg.BorChainID))
 
-	// Increment accum (selects new proposer)
-	k.sk.IncrementAccum(ctx, 1)
+	// Increment accum (selects new proposer), acks of additional bor chains don't start a new epoch
+	if !k.ck.GetParams(ctx).ChainParams.IsAdditionalBorChain(msg.BorChainID) {
+		k.sk.IncrementAccum(ctx, 1)
+	}
 
 	// TX bytes
 	txBytes := ctx.TxBytes()
@@ -413,6 +428,7 @@ func PostHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
 			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
 			sdk.NewAttribute(types.AttributeKeyHeaderIndex, strconv.FormatUint(msg.Number, 10)),
+			sdk.NewAttribute(types.AttributeKeyBorChainID, msg.BorChainID),
 		),
 	})
 
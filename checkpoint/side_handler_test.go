@@ -15,7 +15,6 @@ import (
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/common"
 	errs "github.com/maticnetwork/heimdall/common"
-	"github.com/maticnetwork/heimdall/contracts/rootchain"
 	"github.com/maticnetwork/heimdall/helper/mocks"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -87,8 +86,6 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointAdjustSuccess() {
 		EndBlock:    512,
 		RootHash:    hmTypes.HexToHeimdallHash("456"),
 	}
-	rootchainInstance := &rootchain.Rootchain{}
-	suite.contractCaller.On("GetRootChainInstance", mock.Anything).Return(rootchainInstance, nil)
 	suite.contractCaller.On("GetHeaderInfo", mock.Anything, mock.Anything, mock.Anything).Return(borCommon.HexToHash("456"), uint64(0), uint64(512), uint64(1), hmTypes.HexToHeimdallAddress("456"), nil)
 
 	suite.handler(ctx, checkpointAdjust)
@@ -122,8 +119,6 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointAdjustSameCheckpointAsRoot
 		EndBlock:    256,
 		RootHash:    hmTypes.HexToHeimdallHash("456"),
 	}
-	rootchainInstance := &rootchain.Rootchain{}
-	suite.contractCaller.On("GetRootChainInstance", mock.Anything).Return(rootchainInstance, nil)
 	suite.contractCaller.On("GetHeaderInfo", mock.Anything, mock.Anything, mock.Anything).Return(borCommon.HexToHash("123"), uint64(0), uint64(256), uint64(1), hmTypes.HexToHeimdallAddress("123"), nil)
 
 	suite.handler(ctx, checkpointAdjust)
@@ -153,8 +148,6 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointAdjustNotSameCheckpointAsR
 		RootHash:    hmTypes.HexToHeimdallHash("123"),
 	}
 
-	rootchainInstance := &rootchain.Rootchain{}
-	suite.contractCaller.On("GetRootChainInstance", mock.Anything).Return(rootchainInstance, nil)
 	suite.contractCaller.On("GetHeaderInfo", mock.Anything, mock.Anything, mock.Anything).Return(borCommon.HexToHash("222"), uint64(0), uint64(256), uint64(1), hmTypes.HexToHeimdallAddress("123"), nil)
 
 	result := suite.sideHandler(ctx, checkpointAdjust)
@@ -249,6 +242,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpointAck() {
 	start := uint64(0)
 	maxSize := uint64(256)
 	params := keeper.GetParams(ctx)
+	chainParams := app.ChainKeeper.GetParams(ctx).ChainParams
 
 	header, _ := chSim.GenRandCheckpoint(start, maxSize, params.MaxCheckpointLength)
 	headerId := uint64(1)
@@ -265,11 +259,9 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpointAck() {
 			header.RootHash,
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			header.BorChainID,
 		)
-		rootchainInstance := &rootchain.Rootchain{}
-
-		suite.contractCaller.On("GetRootChainInstance", mock.Anything).Return(rootchainInstance, nil)
-		suite.contractCaller.On("GetHeaderInfo", headerId, rootchainInstance, params.ChildBlockInterval).Return(header.RootHash.EthHash(), header.StartBlock, header.EndBlock, header.TimeStamp, header.Proposer, nil)
+		suite.contractCaller.On("GetHeaderInfo", headerId, chainParams.RootChainAddress.EthAddress(), params.ChildBlockInterval).Return(header.RootHash.EthHash(), header.StartBlock, header.EndBlock, header.TimeStamp, header.Proposer, nil)
 
 		result := suite.sideHandler(ctx, msgCheckpointAck)
 		require.Equal(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should be success")
@@ -289,11 +281,9 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpointAck() {
 			hmTypes.HexToHeimdallHash("123"),
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			header.BorChainID,
 		)
-		rootchainInstance := &rootchain.Rootchain{}
-
-		suite.contractCaller.On("GetRootChainInstance", mock.Anything).Return(rootchainInstance, nil)
-		suite.contractCaller.On("GetHeaderInfo", headerId, rootchainInstance, params.ChildBlockInterval).Return(nil, header.StartBlock, header.EndBlock, header.TimeStamp, header.Proposer, nil)
+		suite.contractCaller.On("GetHeaderInfo", headerId, chainParams.RootChainAddress.EthAddress(), params.ChildBlockInterval).Return(nil, header.StartBlock, header.EndBlock, header.TimeStamp, header.Proposer, nil)
 
 		result := suite.sideHandler(ctx, msgCheckpointAck)
 		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
@@ -424,6 +414,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 			header.RootHash,
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			header.BorChainID,
 		)
 
 		result := suite.postHandler(ctx, msgCheckpointAck, abci.SideTxResultType_No)
@@ -455,6 +446,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 			header.RootHash,
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			header.BorChainID,
 		)
 
 		result = suite.postHandler(ctx, msgCheckpointAck, abci.SideTxResultType_Yes)
@@ -474,6 +466,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 			header.RootHash,
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			header.BorChainID,
 		)
 
 		result := suite.postHandler(ctx, msgCheckpointAck, abci.SideTxResultType_Yes)
@@ -508,6 +501,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 			header2.RootHash,
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			header2.BorChainID,
 		)
 
 		result = suite.postHandler(ctx, msgCheckpointAck, abci.SideTxResultType_Yes)
@@ -14,6 +14,7 @@ var (
 	AttributeKeyNewProposer = "new-proposer"
 	AttributeKeyRootHash    = "root-hash"
 	AttributeKeyAccountHash = "account-hash"
+	AttributeKeyBorChainID  = "bor-chain-id"
 
 	AttributeValueCategory = ModuleName
 )
@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/maticnetwork/heimdall/bor/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -16,6 +17,16 @@ type GenesisState struct {
 	LastNoACK          uint64               `json:"last_no_ack" yaml:"last_no_ack"`
 	AckCount           uint64               `json:"ack_count" yaml:"ack_count"`
 	Checkpoints        []hmTypes.Checkpoint `json:"checkpoints" yaml:"checkpoints"`
+
+	BorChains []BorChainGenesisState `json:"bor_chains,omitempty" yaml:"bor_chains,omitempty"`
+}
+
+// BorChainGenesisState is the checkpoint state of an additional bor chain
+type BorChainGenesisState struct {
+	BorChainID         string               `json:"bor_chain_id" yaml:"bor_chain_id"`
+	BufferedCheckpoint *hmTypes.Checkpoint  `json:"buffered_checkpoint" yaml:"buffered_checkpoint"`
+	AckCount           uint64               `json:"ack_count" yaml:"ack_count"`
+	Checkpoints        []hmTypes.Checkpoint `json:"checkpoints" yaml:"checkpoints"`
 }
 
 // NewGenesisState creates a new genesis state.
@@ -55,6 +66,16 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	for _, borChain := range data.BorChains {
+		if borChain.BorChainID == "" {
+			return errors.New("Invalid empty bor chain id in state-dump")
+		}
+
+		if len(borChain.Checkpoints) != 0 && int(borChain.AckCount) != len(borChain.Checkpoints) {
+			return fmt.Errorf("Incorrect state of bor chain %v in state-dump , Please Check", borChain.BorChainID)
+		}
+	}
+
 	return nil
 }
 
@@ -186,6 +186,7 @@ type MsgCheckpointAck struct {
 	RootHash   types.HeimdallHash    `json:"root_hash"`
 	TxHash     types.HeimdallHash    `json:"tx_hash"`
 	LogIndex   uint64                `json:"log_index"`
+	BorChainID string                `json:"bor_chain_id,omitempty"`
 }
 
 func NewMsgCheckpointAck(
@@ -197,6 +198,7 @@ func NewMsgCheckpointAck(
 	rootHash types.HeimdallHash,
 	txHash types.HeimdallHash,
 	logIndex uint64,
+	borChainID string,
 ) MsgCheckpointAck {
 
 	return MsgCheckpointAck{
@@ -208,6 +210,7 @@ func NewMsgCheckpointAck(
 		RootHash:   rootHash,
 		TxHash:     txHash,
 		LogIndex:   logIndex,
+		BorChainID: borChainID,
 	}
 }
 
@@ -16,7 +16,8 @@ const (
 
 // QueryCheckpointParams defines the params for querying accounts.
 type QueryCheckpointParams struct {
-	Number uint64
+	Number     uint64
+	BorChainID string
 }
 
 // NewQueryCheckpointParams creates a new instance of QueryCheckpointHeaderIndex.
@@ -24,6 +25,23 @@ func NewQueryCheckpointParams(number uint64) QueryCheckpointParams {
 	return QueryCheckpointParams{Number: number}
 }
 
+// NewQueryChainCheckpointParams creates a new instance of QueryCheckpointParams for checkpoint of the bor chain.
+func NewQueryChainCheckpointParams(borChainID string, number uint64) QueryCheckpointParams {
+	return QueryCheckpointParams{Number: number, BorChainID: borChainID}
+}
+
+// QueryCheckpointListParams defines the params for querying checkpoints of a bor chain.
+type QueryCheckpointListParams struct {
+	BorChainID string
+	Page       uint64
+	Limit      uint64
+}
+
+// NewQueryCheckpointListParams creates a new instance of QueryCheckpointListParams.
+func NewQueryCheckpointListParams(borChainID string, page uint64, limit uint64) QueryCheckpointListParams {
+	return QueryCheckpointListParams{BorChainID: borChainID, Page: page, Limit: limit}
+}
+
 // QueryBorChainID defines the params for querying with bor chain id
 type QueryBorChainID struct {
 	BorChainID string
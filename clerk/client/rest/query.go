@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -20,6 +21,10 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/clerk/event-record/list",
 		recordListHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/clerk/event-record/status",
+		recordStatusHandlerFn(cliCtx),
+	).Methods("GET")
 	r.HandleFunc(
 		"/clerk/event-record/{recordId}",
 		recordHandlerFn(cliCtx),
@@ -62,6 +67,45 @@ func recordHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// recordStatusHandlerFn returns whether records exist, for comma separated record ids
+func recordStatusHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := r.URL.Query()
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// record ids
+		var recordIDs []uint64
+		for _, value := range strings.Split(vars.Get("record-ids"), ",") {
+			recordID, ok := rest.ParseUint64OrReturnBadRequest(w, value)
+			if !ok {
+				return
+			}
+			recordIDs = append(recordIDs, recordID)
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryRecordStatusParams(recordIDs))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryRecordStatus), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		hmRest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func recordListHandlerFn(
 	cliCtx context.CLIContext,
 ) http.HandlerFunc {
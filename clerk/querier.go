@@ -26,6 +26,8 @@ func NewQuerier(keeper Keeper, contractCaller helper.IContractCaller) sdk.Querie
 			return handleQueryRecordListWithTime(ctx, req, keeper)
 		case types.QueryRecordSequence:
 			return handleQueryRecordSequence(ctx, req, keeper, contractCaller)
+		case types.QueryRecordStatus:
+			return handleQueryRecordStatus(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown auth query endpoint")
 		}
@@ -119,3 +121,22 @@ func handleQueryRecordSequence(ctx sdk.Context, req abci.RequestQuery, keeper Ke
 
 	return bz, nil
 }
+
+func handleQueryRecordStatus(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryRecordStatusParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	// existence of each record, in order of requested ids
+	status := make([]bool, len(params.RecordIDs))
+	for i, recordID := range params.RecordIDs {
+		status[i] = keeper.HasEventRecord(ctx, recordID)
+	}
+
+	bz, err := json.Marshal(status)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -1,6 +1,7 @@
 package clerk_test
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
@@ -129,6 +130,39 @@ func (suite *QuerierTestSuite) TestHandleQueryRecordList() {
 	require.NotNil(t, record)
 }
 
+func (suite *QuerierTestSuite) TestHandleQueryRecordStatus() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	path := []string{types.QueryRecordStatus}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryRecordStatus)
+
+	req := abci.RequestQuery{
+		Path: route,
+		Data: []byte{},
+	}
+	_, err := querier(ctx, path, req)
+	require.Error(t, err, "failed to parse params")
+
+	hAddr := hmTypes.BytesToHeimdallAddress([]byte("some-address"))
+	hHash := hmTypes.BytesToHeimdallHash([]byte("some-address"))
+	testRecord1 := types.NewEventRecord(hHash, 1, 2, hAddr, make([]byte, 0), "1", time.Now())
+
+	// SetEventRecord
+	ck := app.ClerkKeeper
+	ck.SetEventRecord(ctx, testRecord1)
+
+	req = abci.RequestQuery{
+		Path: route,
+		Data: app.Codec().MustMarshalJSON(types.NewQueryRecordStatusParams([]uint64{1, 2, 3})),
+	}
+	res, err := querier(ctx, path, req)
+	require.NoError(t, err)
+
+	var status []bool
+	require.NoError(t, json.Unmarshal(res, &status))
+	require.Equal(t, []bool{false, true, false}, status)
+}
+
 func (suite *QuerierTestSuite) TestHandleQueryRecordSequence() {
 	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
 
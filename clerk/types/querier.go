@@ -10,6 +10,7 @@ const (
 	QueryRecordList         = "record-list"
 	QueryRecordListWithTime = "record-list-time"
 	QueryRecordSequence     = "record-sequence"
+	QueryRecordStatus       = "record-status"
 )
 
 // QueryRecordParams defines the params for querying accounts.
@@ -23,6 +24,11 @@ type QueryRecordSequenceParams struct {
 	LogIndex uint64
 }
 
+// QueryRecordStatusParams defines the params for querying whether records exist.
+type QueryRecordStatusParams struct {
+	RecordIDs []uint64
+}
+
 // QueryRecordTimePaginationParams defines the params for querying records with time.
 type QueryRecordTimePaginationParams struct {
 	FromTime time.Time
@@ -41,6 +47,11 @@ func NewQueryRecordSequenceParams(txHash string, logIndex uint64) QueryRecordSeq
 	return QueryRecordSequenceParams{TxHash: txHash, LogIndex: logIndex}
 }
 
+// NewQueryRecordStatusParams creates a new instance of QueryRecordStatusParams.
+func NewQueryRecordStatusParams(recordIDs []uint64) QueryRecordStatusParams {
+	return QueryRecordStatusParams{RecordIDs: recordIDs}
+}
+
 // NewQueryTimeRangePaginationParams creates a new instance of NewQueryTimeRangePaginationParams.
 func NewQueryTimeRangePaginationParams(fromTime, toTime time.Time, page, limit uint64) QueryRecordTimePaginationParams {
 	return QueryRecordTimePaginationParams{FromTime: fromTime, ToTime: toTime, Page: page, Limit: limit}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	lru "github.com/hashicorp/golang-lru"
+	ethereum "github.com/maticnetwork/bor"
 	"github.com/maticnetwork/bor/accounts/abi"
 	"github.com/maticnetwork/bor/common"
 	ethTypes "github.com/maticnetwork/bor/core/types"
@@ -29,7 +30,7 @@ import (
 
 // IContractCaller represents contract caller
 type IContractCaller interface {
-	GetHeaderInfo(headerID uint64, rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (root common.Hash, start, end, createdAt uint64, proposer types.HeimdallAddress, err error)
+	GetHeaderInfo(headerID uint64, rootChainAddress common.Address, childBlockInterval uint64) (root common.Hash, start, end, createdAt uint64, proposer types.HeimdallAddress, err error)
 	GetRootHash(start uint64, end uint64, checkpointLength uint64) ([]byte, error)
 	GetValidatorInfo(valID types.ValidatorID, stakingInfoInstance *stakinginfo.Stakinginfo) (validator types.Validator, err error)
 	GetLastChildBlock(rootChainInstance *rootchain.Rootchain) (uint64, error)
@@ -40,6 +41,7 @@ type IContractCaller interface {
 	GetCheckpointSign(txHash common.Hash) ([]byte, []byte, []byte, error)
 	GetMainChainBlock(*big.Int) (*ethTypes.Header, error)
 	GetMaticChainBlock(*big.Int) (*ethTypes.Header, error)
+	GetBorChainCaller(chainID string) (IContractCaller, error)
 	IsTxConfirmed(common.Hash, uint64) bool
 	GetConfirmedTxReceipt(common.Hash, uint64) (*ethTypes.Receipt, error)
 	GetBlockNumberFromTxHash(common.Hash) (*big.Int, error)
@@ -52,6 +54,11 @@ type IContractCaller interface {
 	DecodeValidatorStakeUpdateEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoStakeUpdate, error)
 	DecodeValidatorExitEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoUnstakeInit, error)
 	DecodeSignerUpdateEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoSignerChange, error)
+	// decode delegation events
+	DecodeShareMintedEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoShareMinted, error)
+	DecodeShareBurnedEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoShareBurned, error)
+	DecodeDelegatorClaimedRewardsEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoDelClaimRewards, error)
+	DecodeUpdateCommissionRateEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoUpdateCommissionRate, error)
 	// decode state events
 	DecodeStateSyncedEvent(common.Address, *ethTypes.Receipt, uint64) (*statesender.StatesenderStateSynced, error)
 
@@ -90,6 +97,10 @@ type ContractCaller struct {
 	MaticChainRPC     *rpc.Client
 	MaticChainTimeout time.Duration
 
+	// all configured endpoints, used for failover and quorum reads
+	MainChainPool  *RPCPool
+	MaticChainPool *RPCPool
+
 	RootChainABI     abi.ABI
 	StakingInfoABI   abi.ABI
 	ValidatorSetABI  abi.ABI
@@ -123,6 +134,8 @@ func NewContractCaller() (contractCallerObj ContractCaller, err error) {
 	contractCallerObj.MaticChainTimeout = config.BorRPCTimeout
 	contractCallerObj.MainChainRPC = GetMainChainRPCClient()
 	contractCallerObj.MaticChainRPC = GetMaticRPCClient()
+	contractCallerObj.MainChainPool = GetMainChainPool()
+	contractCallerObj.MaticChainPool = GetMaticChainPool()
 	contractCallerObj.ReceiptCache, _ = NewLru(1000)
 
 	//
@@ -166,6 +179,23 @@ func NewContractCaller() (contractCallerObj ContractCaller, err error) {
 	return
 }
 
+// GetBorChainCaller returns contract caller reading bor chain data from endpoints of the additional bor chain
+func (c *ContractCaller) GetBorChainCaller(chainID string) (IContractCaller, error) {
+	pool := GetBorChainPool(chainID)
+	if pool == nil {
+		return nil, fmt.Errorf("no rpc endpoints configured for bor chain %v", chainID)
+	}
+
+	caller := *c
+	caller.MaticChainPool = pool
+	caller.MaticChainRPC = pool.Primary().RPC
+	caller.MaticChainClient = pool.Primary().Client
+	caller.ReceiptCache, _ = NewLru(1000)
+	caller.ContractInstanceCache = make(map[common.Address]interface{})
+
+	return &caller, nil
+}
+
 // GetRootChainInstance returns RootChain contract instance for selected base chain
 func (c *ContractCaller) GetRootChainInstance(rootchainAddress common.Address) (*rootchain.Rootchain, error) {
 	contractInstance, ok := c.ContractInstanceCache[rootchainAddress]
@@ -265,7 +295,7 @@ func NewLru(size int) (*lru.Cache, error) {
 }
 
 // GetHeaderInfo get header info from checkpoint number
-func (c *ContractCaller) GetHeaderInfo(number uint64, rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (
+func (c *ContractCaller) GetHeaderInfo(number uint64, rootChainAddress common.Address, childBlockInterval uint64) (
 	root common.Hash,
 	start uint64,
 	end uint64,
@@ -275,16 +305,40 @@ func (c *ContractCaller) GetHeaderInfo(number uint64, rootChainInstance *rootcha
 ) {
 	// get header from rootchain
 	checkpointBigInt := big.NewInt(0).Mul(big.NewInt(0).SetUint64(number), big.NewInt(0).SetUint64(childBlockInterval))
-	headerBlock, err := rootChainInstance.HeaderBlocks(nil, checkpointBigInt)
+	rootChainInstance, err := c.GetRootChainInstance(rootChainAddress)
+	if err != nil {
+		return root, start, end, createdAt, proposer, err
+	}
+
+	result, err := c.quorumMainChain(func(endpoint *RPCEndpoint) (interface{}, error) {
+		instance, err := c.rootChainInstanceOn(endpoint, rootChainAddress, rootChainInstance)
+		if err != nil {
+			return nil, err
+		}
+
+		headerBlock, err := instance.HeaderBlocks(nil, checkpointBigInt)
+		if err != nil {
+			return nil, err
+		}
+
+		return headerInfo{
+			Root:      headerBlock.Root,
+			Start:     headerBlock.Start.Uint64(),
+			End:       headerBlock.End.Uint64(),
+			CreatedAt: headerBlock.CreatedAt.Uint64(),
+			Proposer:  types.BytesToHeimdallAddress(headerBlock.Proposer.Bytes()),
+		}, nil
+	})
 	if err != nil {
 		return root, start, end, createdAt, proposer, errors.New("Unable to fetch checkpoint block")
 	}
 
+	headerBlock := result.(headerInfo)
 	return headerBlock.Root,
-		headerBlock.Start.Uint64(),
-		headerBlock.End.Uint64(),
-		headerBlock.CreatedAt.Uint64(),
-		types.BytesToHeimdallAddress(headerBlock.Proposer.Bytes()),
+		headerBlock.Start,
+		headerBlock.End,
+		headerBlock.CreatedAt,
+		headerBlock.Proposer,
 		nil
 }
 
@@ -300,15 +354,17 @@ func (c *ContractCaller) GetRootHash(start uint64, end uint64, checkpointLength
 		return nil, errors.New("number of headers requested exceeds")
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), c.MaticChainTimeout)
-	defer cancel()
+	rootHash, err := c.quorumMaticChain(func(endpoint *RPCEndpoint) (interface{}, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.MaticChainTimeout)
+		defer cancel()
 
-	rootHash, err := c.MaticChainClient.GetRootHash(ctx, start, end)
+		return endpoint.Client.GetRootHash(ctx, start, end)
+	})
 	if err != nil {
 		return nil, errors.New("Could not fetch roothash from matic chain")
 	}
 
-	return common.FromHex(rootHash), nil
+	return common.FromHex(rootHash.(string)), nil
 }
 
 // GetLastChildBlock fetch current child block
@@ -333,10 +389,14 @@ func (c *ContractCaller) CurrentHeaderBlock(rootChainInstance *rootchain.Rootcha
 
 // GetBalance get balance of account (returns big.Int balance wont fit in uint64)
 func (c *ContractCaller) GetBalance(address common.Address) (*big.Int, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.MainChainTimeout)
-	defer cancel()
-
-	balance, err := c.MainChainClient.BalanceAt(ctx, address, nil)
+	var balance *big.Int
+	err := c.callMainChain(func(endpoint *RPCEndpoint) (err error) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.MainChainTimeout)
+		defer cancel()
+
+		balance, err = endpoint.Client.BalanceAt(ctx, address, nil)
+		return err
+	})
 	if err != nil {
 		Logger.Error("Unable to fetch balance of account from root chain", "Error", err, "Address", address.String())
 		return big.NewInt(0), err
@@ -373,33 +433,54 @@ func (c *ContractCaller) GetValidatorInfo(valID types.ValidatorID, stakingInfoIn
 
 // GetMainChainBlock returns main chain block header
 func (c *ContractCaller) GetMainChainBlock(blockNum *big.Int) (header *ethTypes.Header, err error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.MainChainTimeout)
-	defer cancel()
+	err = c.callMainChain(func(endpoint *RPCEndpoint) (err error) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.MainChainTimeout)
+		defer cancel()
 
-	latestBlock, err := c.MainChainClient.HeaderByNumber(ctx, blockNum)
+		header, err = endpoint.Client.HeaderByNumber(ctx, blockNum)
+		return err
+	})
 	if err != nil {
 		Logger.Error("Unable to connect to main chain", "Error", err)
-		return
+		return nil, err
 	}
-	return latestBlock, nil
+	return header, nil
+}
+
+// FilterMainChainLogs returns main chain logs matching given query
+func (c *ContractCaller) FilterMainChainLogs(query ethereum.FilterQuery) (logs []ethTypes.Log, err error) {
+	err = c.callMainChain(func(endpoint *RPCEndpoint) (err error) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.MainChainTimeout)
+		defer cancel()
+
+		logs, err = endpoint.Client.FilterLogs(ctx, query)
+		return err
+	})
+	return logs, err
 }
 
 // GetMaticChainBlock returns child chain block header
 func (c *ContractCaller) GetMaticChainBlock(blockNum *big.Int) (header *ethTypes.Header, err error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.MaticChainTimeout)
-	defer cancel()
-	latestBlock, err := c.MaticChainClient.HeaderByNumber(ctx, blockNum)
+	err = c.callMaticChain(func(endpoint *RPCEndpoint) (err error) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.MaticChainTimeout)
+		defer cancel()
+
+		header, err = endpoint.Client.HeaderByNumber(ctx, blockNum)
+		return err
+	})
 	if err != nil {
 		Logger.Error("Unable to connect to matic chain", "Error", err)
-		return
+		return nil, err
 	}
-	return latestBlock, nil
+	return header, nil
 }
 
 // GetBlockNumberFromTxHash gets block number of transaction
 func (c *ContractCaller) GetBlockNumberFromTxHash(tx common.Hash) (*big.Int, error) {
 	var rpcTx rpcTransaction
-	if err := c.MainChainRPC.CallContext(context.Background(), &rpcTx, "eth_getTransactionByHash", tx); err != nil {
+	if err := c.callMainChain(func(endpoint *RPCEndpoint) error {
+		return endpoint.RPC.CallContext(context.Background(), &rpcTx, "eth_getTransactionByHash", tx)
+	}); err != nil {
 		return nil, err
 	}
 
@@ -428,6 +509,10 @@ func (c *ContractCaller) IsTxConfirmed(tx common.Hash, requiredConfirmations uin
 
 // GetConfirmedTxReceipt returns confirmed tx receipt
 func (c *ContractCaller) GetConfirmedTxReceipt(tx common.Hash, requiredConfirmations uint64) (*ethTypes.Receipt, error) {
+	// receipt and confirmations must be agreed by quorum of endpoints
+	if c.MainChainPool != nil && c.MainChainPool.Quorum() > 1 {
+		return c.getQuorumConfirmedTxReceipt(tx, requiredConfirmations)
+	}
 
 	var receipt *ethTypes.Receipt = nil
 	receiptCache, ok := c.ReceiptCache.Get(tx.String())
@@ -465,6 +550,37 @@ func (c *ContractCaller) GetConfirmedTxReceipt(tx common.Hash, requiredConfirmat
 	return receipt, nil
 }
 
+// getQuorumConfirmedTxReceipt checks receipt and confirmations on each main chain endpoint
+// and returns receipt only if quorum of endpoints agree on it
+func (c *ContractCaller) getQuorumConfirmedTxReceipt(tx common.Hash, requiredConfirmations uint64) (*ethTypes.Receipt, error) {
+	result, err := c.MainChainPool.QuorumCall(func(endpoint *RPCEndpoint) (interface{}, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.MainChainTimeout)
+		defer cancel()
+
+		receipt, err := endpoint.Client.TransactionReceipt(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		latestBlk, err := endpoint.Client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if latestBlk.Number.Uint64() < receipt.BlockNumber.Uint64() || latestBlk.Number.Uint64()-receipt.BlockNumber.Uint64() < requiredConfirmations {
+			return nil, errors.New("Not enough confirmations")
+		}
+
+		return receipt, nil
+	})
+	if err != nil {
+		Logger.Error("Error while fetching confirmed mainchain receipt", "error", err, "txHash", tx.Hex())
+		return nil, err
+	}
+
+	return result.(*ethTypes.Receipt), nil
+}
+
 //
 // Validator decode events
 //
@@ -625,6 +741,94 @@ func (c *ContractCaller) DecodeStateSyncedEvent(contractAddress common.Address,
 
 // decode slashing events
 
+// DecodeShareMintedEvent represents delegator shares minted event
+func (c *ContractCaller) DecodeShareMintedEvent(contractAddress common.Address, receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoShareMinted, error) {
+	event := new(stakinginfo.StakinginfoShareMinted)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex && bytes.Equal(vLog.Address.Bytes(), contractAddress.Bytes()) {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "ShareMinted", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
+// DecodeShareBurnedEvent represents delegator shares burned event
+func (c *ContractCaller) DecodeShareBurnedEvent(contractAddress common.Address, receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoShareBurned, error) {
+	event := new(stakinginfo.StakinginfoShareBurned)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex && bytes.Equal(vLog.Address.Bytes(), contractAddress.Bytes()) {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "ShareBurned", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
+// DecodeDelegatorClaimedRewardsEvent represents delegator claimed rewards event
+func (c *ContractCaller) DecodeDelegatorClaimedRewardsEvent(contractAddress common.Address, receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoDelClaimRewards, error) {
+	event := new(stakinginfo.StakinginfoDelClaimRewards)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex && bytes.Equal(vLog.Address.Bytes(), contractAddress.Bytes()) {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "DelClaimRewards", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
+// DecodeUpdateCommissionRateEvent represents validator commission rate update event
+func (c *ContractCaller) DecodeUpdateCommissionRateEvent(contractAddress common.Address, receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoUpdateCommissionRate, error) {
+	event := new(stakinginfo.StakinginfoUpdateCommissionRate)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex && bytes.Equal(vLog.Address.Bytes(), contractAddress.Bytes()) {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "UpdateCommissionRate", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
 // DecodeSlashedEvent represents tick ack on contract
 func (c *ContractCaller) DecodeSlashedEvent(contractAddress common.Address, receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoSlashed, error) {
 	event := new(stakinginfo.StakinginfoSlashed)
@@ -728,8 +932,10 @@ func (c *ContractCaller) CheckIfBlocksExist(end uint64) bool {
 	// Get block by number.
 	var block *ethTypes.Header
 
-	err := c.MaticChainRPC.Call(&block, "eth_getBlockByNumber", fmt.Sprintf("0x%x", end), false)
-	if err != nil {
+	err := c.callMaticChain(func(endpoint *RPCEndpoint) error {
+		return endpoint.RPC.Call(&block, "eth_getBlockByNumber", fmt.Sprintf("0x%x", end), false)
+	})
+	if err != nil || block == nil {
 		return false
 	}
 
@@ -742,16 +948,28 @@ func (c *ContractCaller) CheckIfBlocksExist(end uint64) bool {
 
 // GetMainTxReceipt returns main tx receipt
 func (c *ContractCaller) GetMainTxReceipt(txHash common.Hash) (*ethTypes.Receipt, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.MainChainTimeout)
-	defer cancel()
-	return c.getTxReceipt(ctx, c.MainChainClient, txHash)
+	var receipt *ethTypes.Receipt
+	err := c.callMainChain(func(endpoint *RPCEndpoint) (err error) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.MainChainTimeout)
+		defer cancel()
+
+		receipt, err = c.getTxReceipt(ctx, endpoint.Client, txHash)
+		return err
+	})
+	return receipt, err
 }
 
 // GetMaticTxReceipt returns matic tx receipt
 func (c *ContractCaller) GetMaticTxReceipt(txHash common.Hash) (*ethTypes.Receipt, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.MaticChainTimeout)
-	defer cancel()
-	return c.getTxReceipt(ctx, c.MaticChainClient, txHash)
+	var receipt *ethTypes.Receipt
+	err := c.callMaticChain(func(endpoint *RPCEndpoint) (err error) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.MaticChainTimeout)
+		defer cancel()
+
+		receipt, err = c.getTxReceipt(ctx, endpoint.Client, txHash)
+		return err
+	})
+	return receipt, err
 }
 
 func (c *ContractCaller) getTxReceipt(ctx context.Context, client *ethclient.Client, txHash common.Hash) (*ethTypes.Receipt, error) {
@@ -768,11 +986,15 @@ func getABI(data string) (abi.ABI, error) {
 
 // GetCheckpointSign returns sigs input of committed checkpoint tranasction
 func (c *ContractCaller) GetCheckpointSign(txHash common.Hash) ([]byte, []byte, []byte, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.MainChainTimeout)
-	defer cancel()
-
-	mainChainClient := GetMainClient()
-	transaction, isPending, err := mainChainClient.TransactionByHash(ctx, txHash)
+	var transaction *ethTypes.Transaction
+	var isPending bool
+	err := c.callMainChain(func(endpoint *RPCEndpoint) (err error) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.MainChainTimeout)
+		defer cancel()
+
+		transaction, isPending, err = endpoint.Client.TransactionByHash(ctx, txHash)
+		return err
+	})
 	if err != nil {
 		Logger.Error("Error while Fetching Transaction By hash from MainChain", "error", err)
 		return []byte{}, []byte{}, []byte{}, err
@@ -784,3 +1006,64 @@ func (c *ContractCaller) GetCheckpointSign(txHash common.Hash) ([]byte, []byte,
 	abi := c.RootChainABI
 	return UnpackSigAndVotes(payload, abi)
 }
+
+//
+// RPC endpoint failover
+//
+
+// headerInfo is checkpoint header as stored on rootchain
+type headerInfo struct {
+	Root      common.Hash
+	Start     uint64
+	End       uint64
+	CreatedAt uint64
+	Proposer  types.HeimdallAddress
+}
+
+// callMainChain runs fn on main chain endpoints, failing over to next endpoint on error
+func (c *ContractCaller) callMainChain(fn func(*RPCEndpoint) error) error {
+	if c.MainChainPool == nil {
+		return fn(&RPCEndpoint{RPC: c.MainChainRPC, Client: c.MainChainClient})
+	}
+	return c.MainChainPool.Call(fn)
+}
+
+// callMaticChain runs fn on matic chain endpoints, failing over to next endpoint on error
+func (c *ContractCaller) callMaticChain(fn func(*RPCEndpoint) error) error {
+	if c.MaticChainPool == nil {
+		return fn(&RPCEndpoint{RPC: c.MaticChainRPC, Client: c.MaticChainClient})
+	}
+	return c.MaticChainPool.Call(fn)
+}
+
+// quorumMainChain runs fn on main chain endpoints and returns result agreed by quorum
+func (c *ContractCaller) quorumMainChain(fn func(*RPCEndpoint) (interface{}, error)) (interface{}, error) {
+	if c.MainChainPool == nil {
+		return fn(&RPCEndpoint{RPC: c.MainChainRPC, Client: c.MainChainClient})
+	}
+	return c.MainChainPool.QuorumCall(fn)
+}
+
+// quorumMaticChain runs fn on matic chain endpoints and returns result agreed by quorum
+func (c *ContractCaller) quorumMaticChain(fn func(*RPCEndpoint) (interface{}, error)) (interface{}, error) {
+	if c.MaticChainPool == nil {
+		return fn(&RPCEndpoint{RPC: c.MaticChainRPC, Client: c.MaticChainClient})
+	}
+	return c.MaticChainPool.QuorumCall(fn)
+}
+
+// rootChainInstanceOn returns rootchain contract instance bound to given endpoint.
+// rootChainInstance is the instance bound to the primary client.
+func (c *ContractCaller) rootChainInstanceOn(endpoint *RPCEndpoint, rootChainAddress common.Address, rootChainInstance *rootchain.Rootchain) (*rootchain.Rootchain, error) {
+	if c.MainChainPool == nil || endpoint.Client == mainChainClient {
+		return rootChainInstance, nil
+	}
+
+	ci, err := c.MainChainPool.binding(endpoint, "rootchain-"+rootChainAddress.Hex(), func() (interface{}, error) {
+		return rootchain.NewRootchain(rootChainAddress, endpoint.Client)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ci.(*rootchain.Rootchain), nil
+}
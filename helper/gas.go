@@ -0,0 +1,76 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/maticnetwork/bor/ethclient"
+)
+
+// getGasPrice returns gas price for a new transaction.
+// Mainchain transactions use configured gas price strategy, others use the price suggested by node.
+func getGasPrice(client *ethclient.Client) (*big.Int, error) {
+	maxGasPrice := getMainchainMaxGasPrice()
+
+	strategy := GasPriceStrategySuggested
+	if client == GetMainClient() {
+		strategy = GetConfig().MainchainGasPriceStrategy
+	}
+
+	var gasPrice *big.Int
+	switch strategy {
+	case GasPriceStrategySuggested, "":
+		suggested, err := client.SuggestGasPrice(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		gasPrice = suggested
+	case GasPriceStrategyFixed:
+		if GetConfig().MainchainGasPrice <= 0 {
+			return nil, fmt.Errorf("main_chain_gas_price must be set for %v gas price strategy", GasPriceStrategyFixed)
+		}
+		gasPrice = big.NewInt(GetConfig().MainchainGasPrice)
+	case GasPriceStrategyMax:
+		gasPrice = maxGasPrice
+	default:
+		return nil, fmt.Errorf("invalid gas price strategy: %v", strategy)
+	}
+
+	if gasPrice.Cmp(maxGasPrice) == 1 {
+		Logger.Error("Gas price is more than max gas price", "gasprice", gasPrice)
+		return nil, fmt.Errorf("gas price is more than max_gas_price, gasprice = %v, maxGasPrice = %v", gasPrice, maxGasPrice)
+	}
+
+	return gasPrice, nil
+}
+
+// getMainchainMaxGasPrice returns configured max gas price, default in case of invalid value
+func getMainchainMaxGasPrice() *big.Int {
+	mainChainMaxGasPrice := GetConfig().MainchainMaxGasPrice
+	if mainChainMaxGasPrice <= 0 {
+		mainChainMaxGasPrice = DefaultMainchainMaxGasPrice
+	}
+	return big.NewInt(mainChainMaxGasPrice)
+}
+
+// replacementGasPrice returns gas price for replacing a pending transaction.
+// Price is bumped by given percent (or to suggested price if higher) and capped at max gas price.
+// Returns false if pending transaction can't be replaced with a higher price.
+func replacementGasPrice(current *big.Int, suggested *big.Int, maxGasPrice *big.Int, bumpPercent uint64) (*big.Int, bool) {
+	bumped := new(big.Int).Mul(current, new(big.Int).SetUint64(100+bumpPercent))
+	bumped.Div(bumped, big.NewInt(100))
+	if bumped.Cmp(current) <= 0 {
+		bumped = new(big.Int).Add(current, big.NewInt(1))
+	}
+
+	if suggested != nil && suggested.Cmp(bumped) == 1 {
+		bumped = new(big.Int).Set(suggested)
+	}
+
+	if bumped.Cmp(maxGasPrice) == 1 {
+		bumped = new(big.Int).Set(maxGasPrice)
+	}
+
+	return bumped, bumped.Cmp(current) == 1
+}
@@ -0,0 +1,31 @@
+package helper
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplacementGasPrice(t *testing.T) {
+	maxGasPrice := big.NewInt(400)
+
+	// bumped by percent
+	gasPrice, ok := replacementGasPrice(big.NewInt(100), nil, maxGasPrice, 20)
+	require.True(t, ok)
+	require.Equal(t, big.NewInt(120), gasPrice)
+
+	// suggested price is used if it is higher than bumped price
+	gasPrice, ok = replacementGasPrice(big.NewInt(100), big.NewInt(150), maxGasPrice, 20)
+	require.True(t, ok)
+	require.Equal(t, big.NewInt(150), gasPrice)
+
+	// capped at max gas price
+	gasPrice, ok = replacementGasPrice(big.NewInt(380), nil, maxGasPrice, 20)
+	require.True(t, ok)
+	require.Equal(t, maxGasPrice, gasPrice)
+
+	// already at max gas price
+	_, ok = replacementGasPrice(big.NewInt(400), big.NewInt(500), maxGasPrice, 20)
+	require.False(t, ok)
+}
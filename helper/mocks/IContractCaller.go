@@ -10,6 +10,8 @@ import (
 
 	heimdalltypes "github.com/maticnetwork/heimdall/types"
 
+	helper "github.com/maticnetwork/heimdall/helper"
+
 	mock "github.com/stretchr/testify/mock"
 
 	rootchain "github.com/maticnetwork/heimdall/contracts/rootchain"
@@ -138,6 +140,29 @@ func (_m *IContractCaller) CurrentStateCounter(stateSenderInstance *statesender.
 	return r0
 }
 
+// DecodeDelegatorClaimedRewardsEvent provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) DecodeDelegatorClaimedRewardsEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*stakinginfo.StakinginfoDelClaimRewards, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *stakinginfo.StakinginfoDelClaimRewards
+	if rf, ok := ret.Get(0).(func(common.Address, *types.Receipt, uint64) *stakinginfo.StakinginfoDelClaimRewards); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoDelClaimRewards)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DecodeNewHeaderBlockEvent provides a mock function with given fields: _a0, _a1, _a2
 func (_m *IContractCaller) DecodeNewHeaderBlockEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*rootchain.RootchainNewHeaderBlock, error) {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -161,6 +186,52 @@ func (_m *IContractCaller) DecodeNewHeaderBlockEvent(_a0 common.Address, _a1 *ty
 	return r0, r1
 }
 
+// DecodeShareBurnedEvent provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) DecodeShareBurnedEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*stakinginfo.StakinginfoShareBurned, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *stakinginfo.StakinginfoShareBurned
+	if rf, ok := ret.Get(0).(func(common.Address, *types.Receipt, uint64) *stakinginfo.StakinginfoShareBurned); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoShareBurned)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DecodeShareMintedEvent provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) DecodeShareMintedEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*stakinginfo.StakinginfoShareMinted, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *stakinginfo.StakinginfoShareMinted
+	if rf, ok := ret.Get(0).(func(common.Address, *types.Receipt, uint64) *stakinginfo.StakinginfoShareMinted); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoShareMinted)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DecodeSignerUpdateEvent provides a mock function with given fields: _a0, _a1, _a2
 func (_m *IContractCaller) DecodeSignerUpdateEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*stakinginfo.StakinginfoSignerChange, error) {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -253,6 +324,29 @@ func (_m *IContractCaller) DecodeUnJailedEvent(_a0 common.Address, _a1 *types.Re
 	return r0, r1
 }
 
+// DecodeUpdateCommissionRateEvent provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) DecodeUpdateCommissionRateEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*stakinginfo.StakinginfoUpdateCommissionRate, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *stakinginfo.StakinginfoUpdateCommissionRate
+	if rf, ok := ret.Get(0).(func(common.Address, *types.Receipt, uint64) *stakinginfo.StakinginfoUpdateCommissionRate); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoUpdateCommissionRate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DecodeValidatorExitEvent provides a mock function with given fields: _a0, _a1, _a2
 func (_m *IContractCaller) DecodeValidatorExitEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*stakinginfo.StakinginfoUnstakeInit, error) {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -391,6 +485,29 @@ func (_m *IContractCaller) GetBlockNumberFromTxHash(_a0 common.Hash) (*big.Int,
 	return r0, r1
 }
 
+// GetBorChainCaller provides a mock function with given fields: chainID
+func (_m *IContractCaller) GetBorChainCaller(chainID string) (helper.IContractCaller, error) {
+	ret := _m.Called(chainID)
+
+	var r0 helper.IContractCaller
+	if rf, ok := ret.Get(0).(func(string) helper.IContractCaller); ok {
+		r0 = rf(chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(helper.IContractCaller)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCheckpointSign provides a mock function with given fields: txHash
 func (_m *IContractCaller) GetCheckpointSign(txHash common.Hash) ([]byte, []byte, []byte, error) {
 	ret := _m.Called(txHash)
@@ -455,13 +572,13 @@ func (_m *IContractCaller) GetConfirmedTxReceipt(_a0 common.Hash, _a1 uint64) (*
 	return r0, r1
 }
 
-// GetHeaderInfo provides a mock function with given fields: headerID, rootChainInstance, childBlockInterval
-func (_m *IContractCaller) GetHeaderInfo(headerID uint64, rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (common.Hash, uint64, uint64, uint64, heimdalltypes.HeimdallAddress, error) {
-	ret := _m.Called(headerID, rootChainInstance, childBlockInterval)
+// GetHeaderInfo provides a mock function with given fields: headerID, rootChainAddress, childBlockInterval
+func (_m *IContractCaller) GetHeaderInfo(headerID uint64, rootChainAddress common.Address, childBlockInterval uint64) (common.Hash, uint64, uint64, uint64, heimdalltypes.HeimdallAddress, error) {
+	ret := _m.Called(headerID, rootChainAddress, childBlockInterval)
 
 	var r0 common.Hash
-	if rf, ok := ret.Get(0).(func(uint64, *rootchain.Rootchain, uint64) common.Hash); ok {
-		r0 = rf(headerID, rootChainInstance, childBlockInterval)
+	if rf, ok := ret.Get(0).(func(uint64, common.Address, uint64) common.Hash); ok {
+		r0 = rf(headerID, rootChainAddress, childBlockInterval)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(common.Hash)
@@ -469,29 +586,29 @@ func (_m *IContractCaller) GetHeaderInfo(headerID uint64, rootChainInstance *roo
 	}
 
 	var r1 uint64
-	if rf, ok := ret.Get(1).(func(uint64, *rootchain.Rootchain, uint64) uint64); ok {
-		r1 = rf(headerID, rootChainInstance, childBlockInterval)
+	if rf, ok := ret.Get(1).(func(uint64, common.Address, uint64) uint64); ok {
+		r1 = rf(headerID, rootChainAddress, childBlockInterval)
 	} else {
 		r1 = ret.Get(1).(uint64)
 	}
 
 	var r2 uint64
-	if rf, ok := ret.Get(2).(func(uint64, *rootchain.Rootchain, uint64) uint64); ok {
-		r2 = rf(headerID, rootChainInstance, childBlockInterval)
+	if rf, ok := ret.Get(2).(func(uint64, common.Address, uint64) uint64); ok {
+		r2 = rf(headerID, rootChainAddress, childBlockInterval)
 	} else {
 		r2 = ret.Get(2).(uint64)
 	}
 
 	var r3 uint64
-	if rf, ok := ret.Get(3).(func(uint64, *rootchain.Rootchain, uint64) uint64); ok {
-		r3 = rf(headerID, rootChainInstance, childBlockInterval)
+	if rf, ok := ret.Get(3).(func(uint64, common.Address, uint64) uint64); ok {
+		r3 = rf(headerID, rootChainAddress, childBlockInterval)
 	} else {
 		r3 = ret.Get(3).(uint64)
 	}
 
 	var r4 heimdalltypes.HeimdallAddress
-	if rf, ok := ret.Get(4).(func(uint64, *rootchain.Rootchain, uint64) heimdalltypes.HeimdallAddress); ok {
-		r4 = rf(headerID, rootChainInstance, childBlockInterval)
+	if rf, ok := ret.Get(4).(func(uint64, common.Address, uint64) heimdalltypes.HeimdallAddress); ok {
+		r4 = rf(headerID, rootChainAddress, childBlockInterval)
 	} else {
 		if ret.Get(4) != nil {
 			r4 = ret.Get(4).(heimdalltypes.HeimdallAddress)
@@ -499,8 +616,8 @@ func (_m *IContractCaller) GetHeaderInfo(headerID uint64, rootChainInstance *roo
 	}
 
 	var r5 error
-	if rf, ok := ret.Get(5).(func(uint64, *rootchain.Rootchain, uint64) error); ok {
-		r5 = rf(headerID, rootChainInstance, childBlockInterval)
+	if rf, ok := ret.Get(5).(func(uint64, common.Address, uint64) error); ok {
+		r5 = rf(headerID, rootChainAddress, childBlockInterval)
 	} else {
 		r5 = ret.Error(5)
 	}
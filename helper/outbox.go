@@ -0,0 +1,24 @@
+package helper
+
+import (
+	"github.com/maticnetwork/bor/common"
+)
+
+// TxOutbox persists rootchain submissions and drives them to completion across restarts, used by bridge
+type TxOutbox interface {
+	// Submit stores contract call of kind and sends it. Same call is sent only once, however often it is submitted.
+	Submit(kind string, to common.Address, data []byte) error
+}
+
+var txOutbox TxOutbox
+
+// SetTxOutbox makes contract caller submit checkpoint and tick transactions through outbox.
+// Passing nil makes it send them directly.
+func SetTxOutbox(outbox TxOutbox) {
+	txOutbox = outbox
+}
+
+// GetTxOutbox returns outbox of rootchain submissions, nil if transactions are sent directly
+func GetTxOutbox() TxOutbox {
+	return txOutbox
+}
@@ -0,0 +1,247 @@
+package helper
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/maticnetwork/bor/ethclient"
+	"github.com/maticnetwork/bor/rpc"
+)
+
+const (
+	// base and max time an endpoint is skipped after failing
+	rpcEndpointBackoff    = 10 * time.Second
+	rpcEndpointMaxBackoff = 5 * time.Minute
+)
+
+// ErrNoQuorum is returned when not enough endpoints agree on a result
+var ErrNoQuorum = errors.New("rpc endpoints did not reach quorum")
+
+// RPCEndpoint represents single RPC endpoint of a chain along with its health
+type RPCEndpoint struct {
+	URL    string
+	RPC    *rpc.Client
+	Client *ethclient.Client
+
+	failures       int
+	unhealthyUntil time.Time
+
+	// contract bindings created on this endpoint
+	bindings map[string]interface{}
+}
+
+// RPCPool holds all configured RPC endpoints of a chain.
+// Calls fail over to next healthy endpoint, reads which drive side-tx votes
+// can require N-of-M endpoints to agree.
+type RPCPool struct {
+	name      string
+	endpoints []*RPCEndpoint
+	quorum    int
+
+	mutex sync.Mutex
+}
+
+// NewRPCPool dials all urls. First url is the primary endpoint.
+func NewRPCPool(name string, urls []string, quorum int) (*RPCPool, error) {
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("no rpc url provided for %v", name)
+	}
+
+	if quorum > len(urls) {
+		return nil, fmt.Errorf("%v rpc quorum %v is more than number of endpoints %v", name, quorum, len(urls))
+	}
+
+	pool := &RPCPool{
+		name:   name,
+		quorum: quorum,
+	}
+
+	for _, url := range urls {
+		rpcClient, err := rpc.Dial(url)
+		if err != nil {
+			return nil, err
+		}
+
+		pool.endpoints = append(pool.endpoints, &RPCEndpoint{
+			URL:      url,
+			RPC:      rpcClient,
+			Client:   ethclient.NewClient(rpcClient),
+			bindings: make(map[string]interface{}),
+		})
+	}
+
+	return pool, nil
+}
+
+// SplitRPCUrls returns endpoints from comma separated url list
+func SplitRPCUrls(urls string) []string {
+	result := make([]string, 0)
+	for _, url := range strings.Split(urls, ",") {
+		if url = strings.TrimSpace(url); url != "" {
+			result = append(result, url)
+		}
+	}
+	return result
+}
+
+// Primary returns first configured endpoint
+func (p *RPCPool) Primary() *RPCEndpoint {
+	return p.endpoints[0]
+}
+
+// Endpoints returns all endpoints
+func (p *RPCPool) Endpoints() []*RPCEndpoint {
+	return p.endpoints
+}
+
+// Quorum returns number of endpoints which must agree on a read
+func (p *RPCPool) Quorum() int {
+	return p.quorum
+}
+
+// IsHealthy returns if endpoint is not in backoff after failures
+func (p *RPCPool) IsHealthy(endpoint *RPCEndpoint) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	return time.Now().After(endpoint.unhealthyUntil)
+}
+
+// Call runs fn against endpoints in order, skipping unhealthy ones, until one succeeds
+func (p *RPCPool) Call(fn func(*RPCEndpoint) error) (err error) {
+	for _, endpoint := range p.candidates() {
+		if err = fn(endpoint); err == nil {
+			p.markSuccess(endpoint)
+			return nil
+		}
+
+		p.markFailure(endpoint, err)
+	}
+
+	return err
+}
+
+// QuorumCall runs fn against all endpoints and returns a result at least `quorum` endpoints agree on.
+// Results are compared by their json encoding. Without quorum, it behaves like Call.
+func (p *RPCPool) QuorumCall(fn func(*RPCEndpoint) (interface{}, error)) (interface{}, error) {
+	if p.quorum <= 1 {
+		var result interface{}
+		err := p.Call(func(endpoint *RPCEndpoint) (err error) {
+			result, err = fn(endpoint)
+			return err
+		})
+		return result, err
+	}
+
+	type response struct {
+		result interface{}
+		key    string
+		err    error
+	}
+
+	responses := make([]response, len(p.endpoints))
+	var wg sync.WaitGroup
+	for i, endpoint := range p.endpoints {
+		wg.Add(1)
+		go func(i int, endpoint *RPCEndpoint) {
+			defer wg.Done()
+
+			result, err := fn(endpoint)
+			if err != nil {
+				p.markFailure(endpoint, err)
+				responses[i] = response{err: err}
+				return
+			}
+			p.markSuccess(endpoint)
+
+			key, err := json.Marshal(result)
+			responses[i] = response{result: result, key: string(key), err: err}
+		}(i, endpoint)
+	}
+	wg.Wait()
+
+	votes := make(map[string]int)
+	var lastErr error
+	for _, resp := range responses {
+		if resp.err != nil {
+			lastErr = resp.err
+			continue
+		}
+
+		votes[resp.key]++
+		if votes[resp.key] >= p.quorum {
+			return resp.result, nil
+		}
+	}
+
+	Logger.Error("RPC endpoints did not agree on result", "chain", p.name, "quorum", p.quorum, "endpoints", len(p.endpoints), "distinctResults", len(votes), "lastError", lastErr)
+	return nil, ErrNoQuorum
+}
+
+// candidates returns healthy endpoints first, unhealthy ones as last resort
+func (p *RPCPool) candidates() []*RPCEndpoint {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	now := time.Now()
+	healthy := make([]*RPCEndpoint, 0, len(p.endpoints))
+	unhealthy := make([]*RPCEndpoint, 0)
+	for _, endpoint := range p.endpoints {
+		if now.After(endpoint.unhealthyUntil) {
+			healthy = append(healthy, endpoint)
+		} else {
+			unhealthy = append(unhealthy, endpoint)
+		}
+	}
+
+	return append(healthy, unhealthy...)
+}
+
+func (p *RPCPool) markSuccess(endpoint *RPCEndpoint) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if endpoint.failures > 0 {
+		Logger.Info("RPC endpoint recovered", "chain", p.name, "url", endpoint.URL)
+	}
+	endpoint.failures = 0
+	endpoint.unhealthyUntil = time.Time{}
+}
+
+func (p *RPCPool) markFailure(endpoint *RPCEndpoint, err error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	endpoint.failures++
+	backoff := rpcEndpointBackoff * time.Duration(endpoint.failures)
+	if backoff > rpcEndpointMaxBackoff {
+		backoff = rpcEndpointMaxBackoff
+	}
+	endpoint.unhealthyUntil = time.Now().Add(backoff)
+
+	// only log if there is another endpoint to fail over to
+	if len(p.endpoints) > 1 {
+		Logger.Info("RPC endpoint failed, failing over", "chain", p.name, "url", endpoint.URL, "failures", endpoint.failures, "backoff", backoff, "error", err)
+	}
+}
+
+// binding returns cached contract binding on this endpoint or creates one
+func (p *RPCPool) binding(endpoint *RPCEndpoint, key string, create func() (interface{}, error)) (interface{}, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if b, ok := endpoint.bindings[key]; ok {
+		return b, nil
+	}
+
+	b, err := create()
+	if err != nil {
+		return nil, err
+	}
+	endpoint.bindings[key] = b
+	return b, nil
+}
@@ -0,0 +1,72 @@
+package helper
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRPCPool(quorum int, urls ...string) *RPCPool {
+	pool := &RPCPool{name: "test", quorum: quorum}
+	for _, url := range urls {
+		pool.endpoints = append(pool.endpoints, &RPCEndpoint{URL: url, bindings: make(map[string]interface{})})
+	}
+	return pool
+}
+
+func TestSplitRPCUrls(t *testing.T) {
+	require.Equal(t, []string{"http://a:8545", "http://b:8545"}, SplitRPCUrls(" http://a:8545, ,http://b:8545,"))
+	require.Equal(t, []string{}, SplitRPCUrls(""))
+}
+
+func TestRPCPoolCallFailover(t *testing.T) {
+	pool := newTestRPCPool(0, "a", "b", "c")
+
+	var called []string
+	err := pool.Call(func(endpoint *RPCEndpoint) error {
+		called = append(called, endpoint.URL)
+		if endpoint.URL == "a" {
+			return errors.New("down")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, called)
+	require.False(t, pool.IsHealthy(pool.Primary()))
+
+	// unhealthy primary is tried last
+	called = nil
+	err = pool.Call(func(endpoint *RPCEndpoint) error {
+		called = append(called, endpoint.URL)
+		return errors.New("down")
+	})
+	require.Error(t, err)
+	require.Equal(t, []string{"b", "c", "a"}, called)
+}
+
+func TestRPCPoolQuorumCall(t *testing.T) {
+	pool := newTestRPCPool(2, "a", "b", "c")
+
+	// two of three endpoints agree
+	result, err := pool.QuorumCall(func(endpoint *RPCEndpoint) (interface{}, error) {
+		if endpoint.URL == "a" {
+			return "0x02", nil
+		}
+		return "0x01", nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "0x01", result)
+
+	// no two endpoints agree
+	_, err = pool.QuorumCall(func(endpoint *RPCEndpoint) (interface{}, error) {
+		switch endpoint.URL {
+		case "a":
+			return "0x01", nil
+		case "b":
+			return "0x02", nil
+		}
+		return nil, errors.New("down")
+	})
+	require.Equal(t, ErrNoQuorum, err)
+}
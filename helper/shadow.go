@@ -0,0 +1,37 @@
+package helper
+
+import (
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
+)
+
+// chains of recorded transactions
+const (
+	HeimdallTxChain  = "heimdall"
+	BorTxChain       = "bor"
+	RootchainTxChain = "rootchain"
+)
+
+// TxRecorder records transactions instead of sending them, used by bridge shadow mode
+type TxRecorder interface {
+	Record(chain string, kind string, payload interface{}) error
+}
+
+// ContractTx is a contract call which would have been sent
+type ContractTx struct {
+	To   common.Address `json:"to"`
+	Data hexutil.Bytes  `json:"data"`
+}
+
+var txRecorder TxRecorder
+
+// SetTxRecorder makes contract caller record checkpoint and tick transactions instead of sending them.
+// Passing nil turns sending back on.
+func SetTxRecorder(recorder TxRecorder) {
+	txRecorder = recorder
+}
+
+// GetTxRecorder returns recorder of shadow mode, nil if transactions are sent
+func GetTxRecorder() TxRecorder {
+	return txRecorder
+}
@@ -0,0 +1,315 @@
+package helper
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/accounts/abi/bind"
+	"github.com/maticnetwork/bor/accounts/keystore"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	ethCrypto "github.com/maticnetwork/bor/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+)
+
+// signer backends
+const (
+	LocalSignerBackend    = "local"    // key of priv_validator_key.json
+	KeystoreSignerBackend = "keystore" // encrypted ethereum keystore file
+	RemoteSignerBackend   = "remote"   // http signing service
+)
+
+// Signer signs heimdall and rootchain transactions with validator key
+type Signer interface {
+	// PubKey returns uncompressed public key of signer
+	PubKey() secp256k1.PubKeySecp256k1
+
+	// Address returns ethereum address of signer
+	Address() common.Address
+
+	// Sign signs 32 byte hash and returns [R || S || V] signature
+	Sign(hash []byte) ([]byte, error)
+}
+
+var signer Signer
+
+// GetSigner returns signer of validator transactions
+func GetSigner() Signer {
+	return signer
+}
+
+// SetSigner sets signer of validator transactions
+func SetSigner(s Signer) {
+	signer = s
+	pubObject = s.PubKey()
+}
+
+// NewSigner creates signer of configured backend
+func NewSigner(config Configuration, privKey secp256k1.PrivKeySecp256k1) (Signer, error) {
+	switch config.SignerBackend {
+	case LocalSignerBackend:
+		return NewLocalSigner(privKey)
+	case KeystoreSignerBackend:
+		return NewKeystoreSigner(config.SignerKeystorePath, config.SignerKeystorePasswordFile)
+	case RemoteSignerBackend:
+		return NewRemoteSigner(config.SignerRemoteURL, config.SignerRemoteTimeout)
+	default:
+		return nil, fmt.Errorf("unknown signer backend %v", config.SignerBackend)
+	}
+}
+
+//
+// Local signer
+//
+
+// LocalSigner signs with private key held in memory
+type LocalSigner struct {
+	key *ecdsa.PrivateKey
+}
+
+// NewLocalSigner creates signer from secp256k1 private key
+func NewLocalSigner(privKey secp256k1.PrivKeySecp256k1) (*LocalSigner, error) {
+	key, err := ethCrypto.ToECDSA(privKey[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &LocalSigner{key: key}, nil
+}
+
+// NewKeystoreSigner creates signer from encrypted ethereum keystore file, like the one generated by `heimdallcli generate-keystore`.
+// Password is read from passwordFile.
+func NewKeystoreSigner(path string, passwordFile string) (*LocalSigner, error) {
+	keyJSON, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := ioutil.ReadFile(passwordFile)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := keystore.DecryptKey(keyJSON, strings.TrimRight(string(password), "\r\n"))
+	if err != nil {
+		return nil, fmt.Errorf("decrypting keystore %v: %v", path, err)
+	}
+
+	return &LocalSigner{key: key.PrivateKey}, nil
+}
+
+// PubKey returns public key of signer
+func (s *LocalSigner) PubKey() (pubKey secp256k1.PubKeySecp256k1) {
+	copy(pubKey[:], ethCrypto.FromECDSAPub(&s.key.PublicKey))
+	return pubKey
+}
+
+// Address returns address of signer
+func (s *LocalSigner) Address() common.Address {
+	return ethCrypto.PubkeyToAddress(s.key.PublicKey)
+}
+
+// Sign signs hash with private key
+func (s *LocalSigner) Sign(hash []byte) ([]byte, error) {
+	return ethCrypto.Sign(hash, s.key)
+}
+
+//
+// Remote signer
+//
+
+// RemotePubKeyResponse is response of `GET <url>/pubkey`
+type RemotePubKeyResponse struct {
+	PubKey hexutil.Bytes `json:"pubkey"`
+}
+
+// RemoteSignRequest is body of `POST <url>/sign`
+type RemoteSignRequest struct {
+	Hash hexutil.Bytes `json:"hash"`
+}
+
+// RemoteSignResponse is response of `POST <url>/sign`
+type RemoteSignResponse struct {
+	Signature hexutil.Bytes `json:"signature"`
+}
+
+// RemoteSigner signs through http signing service, the key never enters this process.
+//
+// Service must serve
+//
+//	GET  <url>/pubkey  -> {"pubkey": "0x04..."}       uncompressed public key
+//	POST <url>/sign    {"hash": "0x..."} -> {"signature": "0x..."}  [R || S || V] signature of hash
+type RemoteSigner struct {
+	url    string
+	client *http.Client
+	pubKey secp256k1.PubKeySecp256k1
+}
+
+// NewRemoteSigner creates signer of service at url and fetches its public key
+func NewRemoteSigner(url string, timeout time.Duration) (*RemoteSigner, error) {
+	if url == "" {
+		return nil, errors.New("remote signer url is not set")
+	}
+
+	s := &RemoteSigner{
+		url:    strings.TrimRight(url, "/"),
+		client: &http.Client{Timeout: timeout},
+	}
+
+	var response RemotePubKeyResponse
+	if err := s.call(http.MethodGet, "/pubkey", nil, &response); err != nil {
+		return nil, err
+	}
+
+	if len(response.PubKey) != secp256k1.PubKeySecp256k1Size {
+		return nil, fmt.Errorf("invalid remote signer public key length %v", len(response.PubKey))
+	}
+	copy(s.pubKey[:], response.PubKey)
+
+	return s, nil
+}
+
+// PubKey returns public key of signer
+func (s *RemoteSigner) PubKey() secp256k1.PubKeySecp256k1 {
+	return s.pubKey
+}
+
+// Address returns address of signer
+func (s *RemoteSigner) Address() common.Address {
+	return common.BytesToAddress(s.pubKey.Address().Bytes())
+}
+
+// Sign asks remote service to sign hash and checks signature is of signer key
+func (s *RemoteSigner) Sign(hash []byte) ([]byte, error) {
+	var response RemoteSignResponse
+	if err := s.call(http.MethodPost, "/sign", RemoteSignRequest{Hash: hash}, &response); err != nil {
+		return nil, err
+	}
+
+	pubKey, err := ethCrypto.Ecrecover(hash, response.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote signature: %v", err)
+	}
+
+	if !bytes.Equal(pubKey, s.pubKey[:]) {
+		return nil, errors.New("remote signature is not of signer key")
+	}
+
+	return response.Signature, nil
+}
+
+// call sends request to remote service and decodes its json response into result
+func (s *RemoteSigner) call(method string, path string, body interface{}, result interface{}) error {
+	var reqBody []byte
+	if body != nil {
+		var err error
+		if reqBody, err = json.Marshal(body); err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequest(method, s.url+path, bytes.NewReader(reqBody))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("remote signer %v %v failed with status %v: %s", method, path, resp.StatusCode, respBody)
+	}
+
+	return json.Unmarshal(respBody, result)
+}
+
+//
+// Signing helpers
+//
+
+// NewSignerTransactor creates rootchain transaction options signing with signer
+func NewSignerTransactor(s Signer) *bind.TransactOpts {
+	from := s.Address()
+	return &bind.TransactOpts{
+		From: from,
+		Signer: func(txSigner ethTypes.Signer, address common.Address, tx *ethTypes.Transaction) (*ethTypes.Transaction, error) {
+			if address != from {
+				return nil, errors.New("not authorized to sign this account")
+			}
+
+			return SignEthTx(s, txSigner, tx)
+		},
+	}
+}
+
+// SignEthTx signs ethereum transaction with signer
+func SignEthTx(s Signer, txSigner ethTypes.Signer, tx *ethTypes.Transaction) (*ethTypes.Transaction, error) {
+	signature, err := s.Sign(txSigner.Hash(tx).Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.WithSignature(txSigner, signature)
+}
+
+// SignStdSignMsg signs heimdall sign msg with signer, like authTypes.MakeSignature does with a private key
+func SignStdSignMsg(s Signer, msg authTypes.StdSignMsg) (authTypes.StdSignature, error) {
+	return s.Sign(ethCrypto.Keccak256(msg.Bytes()))
+}
+
+// BuildAndSignWithSigner builds heimdall tx of msgs, signs it with signer and returns encoded tx
+func BuildAndSignWithSigner(txBldr authTypes.TxBuilder, s Signer, msgs []sdk.Msg) ([]byte, error) {
+	stdSignMsg, err := txBldr.BuildSignMsg(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := SignStdSignMsg(s, stdSignMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	return txBldr.GetStdTxBytes(authTypes.NewStdTx(stdSignMsg.Msg, sig, stdSignMsg.Memo))
+}
+
+// SignStdTxWithSigner signs StdTx with signer, replacing its signature
+func SignStdTxWithSigner(txBldr authTypes.TxBuilder, s Signer, stdTx authTypes.StdTx) (authTypes.StdTx, error) {
+	if txBldr.ChainID() == "" {
+		return authTypes.StdTx{}, errors.New("chain ID required but not specified")
+	}
+
+	signMsg := authTypes.StdSignMsg{
+		ChainID:       txBldr.ChainID(),
+		AccountNumber: txBldr.AccountNumber(),
+		Sequence:      txBldr.Sequence(),
+		Memo:          stdTx.Memo,
+		Msg:           stdTx.Msg,
+	}
+
+	sig, err := SignStdSignMsg(s, signMsg)
+	if err != nil {
+		return authTypes.StdTx{}, err
+	}
+
+	return authTypes.NewStdTx(signMsg.Msg, sig, signMsg.Memo), nil
+}
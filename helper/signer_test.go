@@ -0,0 +1,139 @@
+package helper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/accounts/keystore"
+	"github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	ethCrypto "github.com/maticnetwork/bor/crypto"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+// mockRemoteSigner serves remote signer protocol signing with given key
+func mockRemoteSigner(key *LocalSigner) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/pubkey", func(w http.ResponseWriter, r *http.Request) {
+		pubKey := key.PubKey()
+		_ = json.NewEncoder(w).Encode(RemotePubKeyResponse{PubKey: pubKey[:]})
+	})
+	mux.HandleFunc("/sign", func(w http.ResponseWriter, r *http.Request) {
+		var request RemoteSignRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		signature, err := key.Sign(request.Hash)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(RemoteSignResponse{Signature: signature})
+	})
+	return mux
+}
+
+// requireSigns checks signatures of signer recover to its key, for heimdall and rootchain txs
+func requireSigns(t *testing.T, signer Signer, address common.Address) {
+	require.Equal(t, address, signer.Address())
+	require.Equal(t, address.Bytes(), signer.PubKey().Address().Bytes())
+
+	// heimdall
+	msg := authTypes.StdSignMsg{ChainID: "test", AccountNumber: 1, Sequence: 2, Msg: sdk.NewTestMsg(), Memo: "memo"}
+	sig, err := SignStdSignMsg(signer, msg)
+	require.NoError(t, err)
+	pubKey, err := authTypes.RecoverPubkey(msg.Bytes(), sig)
+	require.NoError(t, err)
+	require.Equal(t, address.Bytes(), types.NewPubKey(pubKey).Address().Bytes())
+
+	// rootchain
+	auth := NewSignerTransactor(signer)
+	tx, err := auth.Signer(ethTypes.HomesteadSigner{}, address, ethTypes.NewTransaction(0, common.Address{}, big.NewInt(0), 21000, big.NewInt(1), nil))
+	require.NoError(t, err)
+	from, err := ethTypes.Sender(ethTypes.HomesteadSigner{}, tx)
+	require.NoError(t, err)
+	require.Equal(t, address, from)
+
+	_, err = auth.Signer(ethTypes.HomesteadSigner{}, common.Address{}, tx)
+	require.Error(t, err)
+}
+
+func TestSigners(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	address := common.BytesToAddress(privKey.PubKey().Address().Bytes())
+
+	// local
+	localSigner, err := NewLocalSigner(privKey)
+	require.NoError(t, err)
+	requireSigns(t, localSigner, address)
+
+	// keystore
+	dir, err := ioutil.TempDir("", "heimdall-signer")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ecdsaKey, err := ethCrypto.ToECDSA(privKey[:])
+	require.NoError(t, err)
+	keyJSON, err := keystore.EncryptKey(&keystore.Key{Id: uuid.NewRandom(), Address: address, PrivateKey: ecdsaKey}, "secret", keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+
+	keystorePath := filepath.Join(dir, "keystore.json")
+	passwordFile := filepath.Join(dir, "password")
+	require.NoError(t, ioutil.WriteFile(keystorePath, keyJSON, 0600))
+	require.NoError(t, ioutil.WriteFile(passwordFile, []byte("secret\n"), 0600))
+
+	keystoreSigner, err := NewKeystoreSigner(keystorePath, passwordFile)
+	require.NoError(t, err)
+	requireSigns(t, keystoreSigner, address)
+
+	require.NoError(t, ioutil.WriteFile(passwordFile, []byte("wrong"), 0600))
+	_, err = NewKeystoreSigner(keystorePath, passwordFile)
+	require.Error(t, err)
+
+	// remote
+	server := httptest.NewServer(mockRemoteSigner(localSigner))
+	defer server.Close()
+
+	remoteSigner, err := NewRemoteSigner(server.URL, time.Second)
+	require.NoError(t, err)
+	requireSigns(t, remoteSigner, address)
+}
+
+func TestRemoteSignerRejectsForeignSignature(t *testing.T) {
+	signer, err := NewLocalSigner(secp256k1.GenPrivKey())
+	require.NoError(t, err)
+	other, err := NewLocalSigner(secp256k1.GenPrivKey())
+	require.NoError(t, err)
+
+	// service advertises one key but signs with another
+	mux := http.NewServeMux()
+	mux.Handle("/pubkey", mockRemoteSigner(signer))
+	mux.Handle("/sign", mockRemoteSigner(other))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	remoteSigner, err := NewRemoteSigner(server.URL, time.Second)
+	require.NoError(t, err)
+
+	_, err = remoteSigner.Sign(ethCrypto.Keccak256([]byte("data")))
+	require.Error(t, err)
+
+	// unreachable service
+	_, err = NewRemoteSigner("http://127.0.0.1:1", time.Second)
+	require.Error(t, err)
+}
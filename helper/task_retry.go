@@ -0,0 +1,99 @@
+package helper
+
+import (
+	"strings"
+	"time"
+)
+
+// backoff curves of task retry policies
+const (
+	FixedBackoff       = "fixed"       // initial delay before every retry
+	LinearBackoff      = "linear"      // initial delay times retry number
+	ExponentialBackoff = "exponential" // initial delay doubled on every retry
+	FibonacciBackoff   = "fibonacci"   // initial delay times fibonacci number of retry, like machinery
+)
+
+// TaskRetryPolicy defines how a failed bridge task is retried
+type TaskRetryPolicy struct {
+	RetryCount   int           `mapstructure:"retry_count"`   // retries after first attempt
+	Backoff      string        `mapstructure:"backoff"`       // curve of delay between retries (fixed, linear, exponential or fibonacci)
+	InitialDelay time.Duration `mapstructure:"initial_delay"` // delay before first retry
+	MaxDelay     time.Duration `mapstructure:"max_delay"`     // max delay between retries
+	MaxAge       time.Duration `mapstructure:"max_age"`       // task isn't retried once it is older (0 disables)
+	Jitter       float64       `mapstructure:"jitter"`        // random fraction of delay added or removed, so validators don't retry together
+}
+
+// DefaultTaskRetryPolicy is used for tasks without configured policy, it keeps machinery defaults
+var DefaultTaskRetryPolicy = TaskRetryPolicy{
+	RetryCount:   3,
+	Backoff:      FibonacciBackoff,
+	InitialDelay: 1 * time.Second,
+	MaxDelay:     1 * time.Minute,
+}
+
+// DefaultTaskRetryPolicies are policies of tasks which need to be retried longer than default
+var DefaultTaskRetryPolicies = map[string]TaskRetryPolicy{
+	// acks must get in, otherwise checkpoints stall until no-ack
+	"sendCheckpointAckToHeimdall": {
+		RetryCount:   100,
+		Backoff:      ExponentialBackoff,
+		InitialDelay: 12 * time.Second,
+		MaxDelay:     10 * time.Minute,
+		MaxAge:       6 * time.Hour,
+		Jitter:       0.2,
+	},
+	// topup is retried until its rootchain tx is confirmed
+	"sendTopUpFeeToHeimdall": {
+		RetryCount:   50,
+		Backoff:      ExponentialBackoff,
+		InitialDelay: 12 * time.Second,
+		MaxDelay:     5 * time.Minute,
+		MaxAge:       2 * time.Hour,
+		Jitter:       0.2,
+	},
+}
+
+// GetTaskRetryPolicy returns configured retry policy of task
+func GetTaskRetryPolicy(taskName string) TaskRetryPolicy {
+	// config keys are case insensitive
+	for name, policy := range conf.TaskRetryPolicies {
+		if strings.EqualFold(name, taskName) {
+			return policy
+		}
+	}
+
+	if policy, ok := DefaultTaskRetryPolicies[taskName]; ok {
+		return policy
+	}
+
+	return DefaultTaskRetryPolicy
+}
+
+// validateTaskRetryPolicies falls back to default values for invalid fields of configured policies
+func validateTaskRetryPolicies(policies map[string]TaskRetryPolicy) {
+	for name, policy := range policies {
+		switch policy.Backoff {
+		case FixedBackoff, LinearBackoff, ExponentialBackoff, FibonacciBackoff:
+		default:
+			Logger.Debug("Invalid task retry backoff provided, falling back to default value", "task", name, "backoff", DefaultTaskRetryPolicy.Backoff)
+			policy.Backoff = DefaultTaskRetryPolicy.Backoff
+		}
+
+		if policy.InitialDelay <= 0 {
+			Logger.Debug("Invalid task retry initial delay provided, falling back to default value", "task", name, "delay", DefaultTaskRetryPolicy.InitialDelay)
+			policy.InitialDelay = DefaultTaskRetryPolicy.InitialDelay
+		}
+
+		if policy.MaxDelay < policy.InitialDelay {
+			Logger.Debug("Invalid task retry max delay provided, falling back to initial delay", "task", name, "delay", policy.InitialDelay)
+			policy.MaxDelay = policy.InitialDelay
+		}
+
+		if policy.Jitter < 0 || policy.Jitter > 1 {
+			Logger.Debug("Invalid task retry jitter provided, falling back to default value", "task", name, "jitter", DefaultTaskRetryPolicy.Jitter)
+			policy.Jitter = DefaultTaskRetryPolicy.Jitter
+		}
+
+		policies[name] = policy
+	}
+}
@@ -0,0 +1,45 @@
+package helper
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskRetryPoliciesConfig(t *testing.T) {
+	defaultConfig := GetDefaultHeimdallConfig()
+
+	var buffer bytes.Buffer
+	require.NoError(t, configTemplate.Execute(&buffer, &defaultConfig))
+
+	// rendered default config reads back the same policies
+	configViper := viper.New()
+	configViper.SetConfigType("toml")
+	require.NoError(t, configViper.ReadConfig(&buffer))
+
+	var config Configuration
+	require.NoError(t, configViper.UnmarshalExact(&config))
+	require.Len(t, config.TaskRetryPolicies, len(DefaultTaskRetryPolicies))
+
+	prevConf := conf
+	defer func() { conf = prevConf }()
+
+	conf = config
+	require.Equal(t, DefaultTaskRetryPolicies["sendCheckpointAckToHeimdall"], GetTaskRetryPolicy("sendCheckpointAckToHeimdall"))
+	require.Equal(t, DefaultTaskRetryPolicy, GetTaskRetryPolicy("sendStakeUpdateToHeimdall"))
+
+	// invalid fields fall back to defaults
+	policies := map[string]TaskRetryPolicy{
+		"sendStakeUpdateToHeimdall": {RetryCount: 5, Backoff: "random", MaxDelay: time.Second, Jitter: 2},
+	}
+	validateTaskRetryPolicies(policies)
+	require.Equal(t, TaskRetryPolicy{
+		RetryCount:   5,
+		Backoff:      DefaultTaskRetryPolicy.Backoff,
+		InitialDelay: DefaultTaskRetryPolicy.InitialDelay,
+		MaxDelay:     time.Second,
+	}, policies["sendStakeUpdateToHeimdall"])
+}
@@ -16,11 +16,17 @@ const defaultConfigTemplate = `# This is a TOML config file.
 ##### RPC and REST configs #####
 
 # RPC endpoint for ethereum chain
+# Multiple comma separated endpoints can be given for failover, first one is primary
 eth_rpc_url = "{{ .EthRPCUrl }}"
 
 # RPC endpoint for bor chain
+# Multiple comma separated endpoints can be given for failover, first one is primary
 bor_rpc_url = "{{ .BorRPCUrl }}"
 
+# Number of endpoints which must agree on reads used for side-tx votes (0 or 1 disables quorum)
+eth_rpc_quorum = {{ .EthRPCQuorum }}
+bor_rpc_quorum = {{ .BorRPCQuorum }}
+
 # RPC endpoint for tendermint
 tendermint_rpc_url = "{{ .TendermintRPCUrl }}"
 
@@ -32,6 +38,9 @@ heimdall_rest_server = "{{ .HeimdallServerURL }}"
 # AMQP endpoint
 amqp_url = "{{ .AmqpURL }}"
 
+# Task queue backend for bridge tasks: "amqp" or "embedded" (stored in bridge db, no broker needed)
+task_queue_backend = "{{ .TaskQueueBackend }}"
+
 ## Poll intervals
 checkpoint_poll_interval = "{{ .CheckpointerPollInterval }}"
 syncer_poll_interval = "{{ .SyncerPollInterval }}"
@@ -45,9 +54,70 @@ main_chain_gas_limit = "{{ .MainchainGasLimit }}"
 #### gas price ####
 main_chain_max_gas_price = "{{ .MainchainMaxGasPrice }}"
 
+# Gas price strategy for mainchain transactions: "suggested" (by node), "fixed" (main_chain_gas_price) or "max" (main_chain_max_gas_price)
+main_chain_gas_price_strategy = "{{ .MainchainGasPriceStrategy }}"
+main_chain_gas_price = "{{ .MainchainGasPrice }}"
+
+# Pending checkpoint/tick transactions are replaced with same nonce and higher gas price
+main_chain_tx_resubmit_interval = "{{ .MainchainTxResubmitInterval }}"
+main_chain_gas_price_bump_percent = "{{ .MainchainGasPriceBumpPercent }}"
+
+#### log queries ####
+# max block range of a single log query on mainchain (shrinks automatically if provider rejects it)
+main_chain_log_chunk_size = "{{ .MainchainLogChunkSize }}"
+
+#### heimdall tx broadcaster ####
+# unconfirmed heimdall txs are re-signed and re-sent after this timeout
+heimdall_tx_confirm_timeout = "{{ .HeimdallTxConfirmTimeout }}"
+
+#### state sync submission ####
+# state syncs are sent to heimdall in state id order, one event record per tx,
+# with at most clerk_concurrency txs waiting for inclusion and clerk_txs_per_block txs sent per heimdall block
+clerk_concurrency = {{ .ClerkConcurrency }}
+clerk_txs_per_block = {{ .ClerkTxsPerBlock }}
+# state syncs waiting longer than this for a missing predecessor are sent without it
+clerk_gap_timeout = "{{ .ClerkGapTimeout }}"
+
+#### checkpoint standby ####
+# next proposers take over when proposer doesn't propose a due checkpoint or submit a confirmed one to rootchain,
+# each waiting one more grace period than the previous one (count 0 disables)
+checkpoint_standby_count = {{ .CheckpointStandbyCount }}
+checkpoint_standby_grace_period = "{{ .CheckpointStandbyGracePeriod }}"
+
+#### validator signer ####
+# signer of heimdall and rootchain txs: "local" (priv_validator_key.json), "keystore" (encrypted ethereum keystore) or "remote" (http signer)
+signer_backend = "{{ .SignerBackend }}"
+signer_keystore_path = "{{ .SignerKeystorePath }}"
+signer_keystore_password_file = "{{ .SignerKeystorePasswordFile }}"
+signer_remote_url = "{{ .SignerRemoteURL }}"
+signer_remote_timeout = "{{ .SignerRemoteTimeout }}"
+
 ##### Timeout Config #####
 no_ack_wait_time = "{{ .NoACKWaitTime }}"
 
+#### additional bor chains ####
+# RPC endpoints of additional bor chains served by heimdall, by bor chain id
+# Multiple comma separated endpoints can be given for failover, first one is primary
+[bor_chain_rpc_urls]
+{{- range $chainID, $urls := .BorChainRPCUrls }}
+"{{ $chainID }}" = "{{ $urls }}"
+{{- end }}
+
+#### task retry policies ####
+# failed bridge tasks are retried by policy of their task name, tasks without one retry 3 times with fibonacci backoff.
+# backoff is "fixed", "linear", "exponential" or "fibonacci" starting at initial_delay, capped at max_delay,
+# jitter randomizes each delay by given fraction and tasks older than max_age are not retried (0 disables)
+{{- range $task, $policy := .TaskRetryPolicies }}
+
+[task_retry_policies.{{ $task }}]
+retry_count = {{ $policy.RetryCount }}
+backoff = "{{ $policy.Backoff }}"
+initial_delay = "{{ $policy.InitialDelay }}"
+max_delay = "{{ $policy.MaxDelay }}"
+max_age = "{{ $policy.MaxAge }}"
+jitter = {{ $policy.Jitter }}
+{{- end }}
+
 `
 
 var configTemplate *template.Template
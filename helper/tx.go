@@ -10,7 +10,7 @@ import (
 	ethereum "github.com/maticnetwork/bor"
 	"github.com/maticnetwork/bor/accounts/abi/bind"
 	"github.com/maticnetwork/bor/common"
-	"github.com/maticnetwork/bor/crypto"
+	ethTypes "github.com/maticnetwork/bor/core/types"
 	"github.com/maticnetwork/bor/ethclient"
 	"github.com/maticnetwork/heimdall/contracts/erc20"
 	"github.com/maticnetwork/heimdall/contracts/rootchain"
@@ -25,34 +25,14 @@ func GenerateAuthObj(client *ethclient.Client, address common.Address, data []by
 		Data: data,
 	}
 
-	// get priv key
-	pkObject := GetPrivKey()
-
-	// create ecdsa private key
-	ecdsaPrivateKey, err := crypto.ToECDSA(pkObject[:])
-	if err != nil {
-		return
-	}
-
 	// from address
-	fromAddress := common.BytesToAddress(pkObject.PubKey().Address().Bytes())
+	fromAddress := GetSigner().Address()
 	// fetch gas price
-	gasprice, err := client.SuggestGasPrice(context.Background())
+	gasprice, err := getGasPrice(client)
 	if err != nil {
 		return
 	}
 
-	mainChainMaxGasPrice := GetConfig().MainchainMaxGasPrice
-	// Check if configured or not, Use default in case of invalid value
-	if mainChainMaxGasPrice <= 0 {
-		mainChainMaxGasPrice = DefaultMainchainMaxGasPrice
-	}
-	if gasprice.Cmp(big.NewInt(mainChainMaxGasPrice)) == 1 {
-		Logger.Error("Gas price is more than max gas price", "gasprice", gasprice)
-		err = fmt.Errorf("gas price is more than max_gas_price, gasprice = %v, maxGasPrice = %d", gasprice, mainChainMaxGasPrice)
-		return
-	}
-
 	// fetch nonce
 	nonce, err := client.PendingNonceAt(context.Background(), fromAddress)
 	if err != nil {
@@ -64,7 +44,7 @@ func GenerateAuthObj(client *ethclient.Client, address common.Address, data []by
 	gasLimit, err := client.EstimateGas(context.Background(), callMsg)
 
 	// create auth
-	auth = bind.NewKeyedTransactor(ecdsaPrivateKey)
+	auth = NewSignerTransactor(GetSigner())
 	auth.GasPrice = gasprice
 	auth.Nonce = big.NewInt(int64(nonce))
 	auth.GasLimit = uint64(gasLimit) // uint64(gasLimit)
@@ -72,6 +52,17 @@ func GenerateAuthObj(client *ethclient.Client, address common.Address, data []by
 	return
 }
 
+// SignContractTx signs contract call to mainchain with next nonce of signer, it doesn't send the transaction
+func SignContractTx(to common.Address, data []byte) (*ethTypes.Transaction, error) {
+	auth, err := GenerateAuthObj(GetMainClient(), to, data)
+	if err != nil {
+		return nil, err
+	}
+
+	rawTx := ethTypes.NewTransaction(auth.Nonce.Uint64(), to, big.NewInt(0), auth.GasLimit, auth.GasPrice, data)
+	return auth.Signer(ethTypes.HomesteadSigner{}, auth.From, rawTx)
+}
+
 // SendCheckpoint sends checkpoint to rootchain contract
 // todo return err
 func (c *ContractCaller) SendCheckpoint(signedData []byte, sigs [][3]*big.Int, rootChainAddress common.Address, rootChainInstance *rootchain.Rootchain) (er error) {
@@ -81,6 +72,17 @@ func (c *ContractCaller) SendCheckpoint(signedData []byte, sigs [][3]*big.Int, r
 		return err
 	}
 
+	// shadow mode, record checkpoint instead of sending it
+	if txRecorder != nil {
+		Logger.Info("Recording checkpoint instead of submitting it to rootchain")
+		return txRecorder.Record(RootchainTxChain, CheckpointTxKind, ContractTx{To: rootChainAddress, Data: data})
+	}
+
+	// persisted submission, outbox sends it and follows it until confirmed
+	if txOutbox != nil {
+		return txOutbox.Submit(CheckpointTxKind, rootChainAddress, data)
+	}
+
 	auth, err := GenerateAuthObj(GetMainClient(), rootChainAddress, data)
 	if err != nil {
 		Logger.Error("Unable to create auth object", "error", err)
@@ -103,6 +105,9 @@ func (c *ContractCaller) SendCheckpoint(signedData []byte, sigs [][3]*big.Int, r
 		return err
 	}
 	Logger.Info("Submitted new checkpoint to rootchain successfully", "txHash", tx.Hash().String())
+
+	// replace tx with higher gas price if it gets stuck
+	GetMainTxTracker().Track(CheckpointTxKind, tx)
 	return
 }
 
@@ -114,6 +119,17 @@ func (c *ContractCaller) SendTick(signedData []byte, sigs []byte, slashManagerAd
 		return err
 	}
 
+	// shadow mode, record tick instead of sending it
+	if txRecorder != nil {
+		Logger.Info("Recording tick instead of submitting it to slashmanager")
+		return txRecorder.Record(RootchainTxChain, TickTxKind, ContractTx{To: slashManagerAddress, Data: data})
+	}
+
+	// persisted submission, outbox sends it and follows it until confirmed
+	if txOutbox != nil {
+		return txOutbox.Submit(TickTxKind, slashManagerAddress, data)
+	}
+
 	auth, err := GenerateAuthObj(GetMainClient(), slashManagerAddress, data)
 	if err != nil {
 		Logger.Error("Unable to create auth object", "error", err)
@@ -131,6 +147,9 @@ func (c *ContractCaller) SendTick(signedData []byte, sigs []byte, slashManagerAd
 		return err
 	}
 	Logger.Info("Submitted new tick to slashmanager successfully", "txHash", tx.Hash().String())
+
+	// replace tx with higher gas price if it gets stuck
+	GetMainTxTracker().Track(TickTxKind, tx)
 	return
 }
 
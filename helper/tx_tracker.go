@@ -0,0 +1,191 @@
+package helper
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+)
+
+const (
+	// kinds of tracked mainchain transactions
+	CheckpointTxKind = "checkpoint"
+	TickTxKind       = "tick"
+
+	txTrackerPollInterval = 15 * time.Second
+)
+
+// PendingTx is a submitted mainchain transaction which is not mined yet
+type PendingTx struct {
+	Kind   string
+	Tx     *ethTypes.Transaction // latest sent version
+	Hashes []common.Hash         // hashes of all sent versions
+	SentAt time.Time
+}
+
+// MainChainTxClient sends and watches transactions of bridge signer on mainchain
+type MainChainTxClient interface {
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+	SendTransaction(ctx context.Context, tx *ethTypes.Transaction) error
+}
+
+// TxTracker watches pending checkpoint and tick transactions and replaces them
+// with the same nonce and higher gas price until they are mined or superseded
+type TxTracker struct {
+	client MainChainTxClient
+
+	pending map[uint64]*PendingTx // by nonce
+	mutex   sync.Mutex
+
+	startOnce sync.Once
+}
+
+var mainTxTracker *TxTracker
+var mainTxTrackerOnce sync.Once
+
+// GetMainTxTracker returns tracker of mainchain transactions
+func GetMainTxTracker() *TxTracker {
+	mainTxTrackerOnce.Do(func() {
+		mainTxTracker = NewTxTracker(GetMainClient())
+	})
+	return mainTxTracker
+}
+
+// NewTxTracker creates tracker for transactions sent via client
+func NewTxTracker(client MainChainTxClient) *TxTracker {
+	return &TxTracker{
+		client:  client,
+		pending: make(map[uint64]*PendingTx),
+	}
+}
+
+// Track starts watching sent transaction
+func (t *TxTracker) Track(kind string, tx *ethTypes.Transaction) {
+	t.mutex.Lock()
+	t.pending[tx.Nonce()] = &PendingTx{
+		Kind:   kind,
+		Tx:     tx,
+		Hashes: []common.Hash{tx.Hash()},
+		SentAt: time.Now(),
+	}
+	t.mutex.Unlock()
+
+	t.startOnce.Do(func() {
+		go t.run()
+	})
+}
+
+// Pending returns transactions which are not mined yet, ordered by nonce
+func (t *TxTracker) Pending() []PendingTx {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	result := make([]PendingTx, 0, len(t.pending))
+	for _, ptx := range t.pending {
+		result = append(result, *ptx)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Tx.Nonce() < result[j].Tx.Nonce()
+	})
+	return result
+}
+
+func (t *TxTracker) run() {
+	ticker := time.NewTicker(txTrackerPollInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		t.check()
+	}
+}
+
+// check forgets mined transactions and replaces the ones pending longer than resubmit interval
+func (t *TxTracker) check() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if len(t.pending) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), GetConfig().EthRPCTimeout)
+	defer cancel()
+
+	from := common.BytesToAddress(GetAddress())
+	minedNonce, err := t.client.NonceAt(ctx, from, nil)
+	if err != nil {
+		Logger.Error("Error while fetching account nonce for pending txs", "error", err)
+		return
+	}
+
+	for nonce, ptx := range t.pending {
+		// nonce is used, either by one of our versions or by another tx
+		if nonce < minedNonce {
+			Logger.Info("Pending mainchain tx is mined or superseded", "kind", ptx.Kind, "nonce", nonce, "txHash", ptx.Tx.Hash().Hex(), "versions", len(ptx.Hashes))
+			delete(t.pending, nonce)
+			continue
+		}
+
+		if time.Since(ptx.SentAt) >= GetConfig().MainchainTxResubmitInterval {
+			t.replace(ctx, ptx)
+		}
+	}
+}
+
+// replace re-sends pending transaction with same nonce and higher gas price
+func (t *TxTracker) replace(ctx context.Context, ptx *PendingTx) {
+	signedTx, err := ReplaceTx(ctx, t.client, ptx.Tx)
+	if err != nil {
+		Logger.Error("Error while replacing stuck mainchain tx", "kind", ptx.Kind, "nonce", ptx.Tx.Nonce(), "error", err)
+		return
+	}
+
+	if signedTx == nil {
+		Logger.Info("Pending mainchain tx is already at max gas price, waiting", "kind", ptx.Kind, "nonce", ptx.Tx.Nonce(), "txHash", ptx.Tx.Hash().Hex(), "gasPrice", ptx.Tx.GasPrice())
+		ptx.SentAt = time.Now()
+		return
+	}
+
+	Logger.Info("Replaced stuck mainchain tx", "kind", ptx.Kind, "nonce", ptx.Tx.Nonce(), "oldTxHash", ptx.Tx.Hash().Hex(), "txHash", signedTx.Hash().Hex(), "oldGasPrice", ptx.Tx.GasPrice(), "gasPrice", signedTx.GasPrice())
+	ptx.Tx = signedTx
+	ptx.Hashes = append(ptx.Hashes, signedTx.Hash())
+	ptx.SentAt = time.Now()
+}
+
+// ReplaceTx signs and sends tx again with same nonce and higher gas price.
+// It returns nil transaction if tx is already at max gas price.
+func ReplaceTx(ctx context.Context, client MainChainTxClient, tx *ethTypes.Transaction) (*ethTypes.Transaction, error) {
+	signedTx, err := SignReplacementTx(ctx, client, tx)
+	if err != nil || signedTx == nil {
+		return nil, err
+	}
+
+	if err := client.SendTransaction(ctx, signedTx); err != nil {
+		return nil, err
+	}
+
+	return signedTx, nil
+}
+
+// SignReplacementTx signs tx again with same nonce and higher gas price, it doesn't send the transaction.
+// It returns nil transaction if tx is already at max gas price.
+func SignReplacementTx(ctx context.Context, client MainChainTxClient, tx *ethTypes.Transaction) (*ethTypes.Transaction, error) {
+	suggested, err := client.SuggestGasPrice(ctx)
+	if err != nil {
+		Logger.Error("Error while fetching suggested gas price", "error", err)
+		suggested = nil
+	}
+
+	gasPrice, ok := replacementGasPrice(tx.GasPrice(), suggested, getMainchainMaxGasPrice(), GetConfig().MainchainGasPriceBumpPercent)
+	if !ok {
+		return nil, nil
+	}
+
+	rawTx := ethTypes.NewTransaction(tx.Nonce(), *tx.To(), tx.Value(), tx.Gas(), gasPrice, tx.Data())
+	return SignEthTx(GetSigner(), ethTypes.HomesteadSigner{}, rawTx)
+}
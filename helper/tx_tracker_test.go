@@ -0,0 +1,104 @@
+package helper
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+// mockTxClient is a mainchain with a single pending nonce
+type mockTxClient struct {
+	minedNonce uint64
+	suggested  *big.Int
+	sent       []*ethTypes.Transaction
+}
+
+func (m *mockTxClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	return m.minedNonce, nil
+}
+
+func (m *mockTxClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return m.suggested, nil
+}
+
+func (m *mockTxClient) SendTransaction(ctx context.Context, tx *ethTypes.Transaction) error {
+	m.sent = append(m.sent, tx)
+	return nil
+}
+
+// setTestTxTracker sets signer and config of stuck tx replacement, restored after test
+func setTestTxTracker(t *testing.T, maxGasPrice int64) {
+	prevConf, prevSigner, prevPubObject := GetConfig(), signer, pubObject
+	t.Cleanup(func() {
+		SetTestConfig(prevConf)
+		signer, pubObject = prevSigner, prevPubObject
+	})
+
+	localSigner, err := NewLocalSigner(secp256k1.GenPrivKey())
+	require.NoError(t, err)
+	SetSigner(localSigner)
+
+	conf := prevConf
+	conf.EthRPCTimeout = DefaultEthRPCTimeout
+	conf.MainchainMaxGasPrice = maxGasPrice
+	conf.MainchainGasPriceBumpPercent = 20
+	conf.MainchainTxResubmitInterval = 0 // replace on every check
+	SetTestConfig(conf)
+}
+
+func TestTxTrackerReplacesStuckTx(t *testing.T) {
+	setTestTxTracker(t, 150)
+
+	client := &mockTxClient{minedNonce: 5, suggested: big.NewInt(90)}
+	tracker := NewTxTracker(client)
+
+	to := common.HexToAddress("0x1")
+	tx := ethTypes.NewTransaction(5, to, big.NewInt(0), 100000, big.NewInt(100), []byte{1, 2, 3})
+	tracker.pending[tx.Nonce()] = &PendingTx{Kind: CheckpointTxKind, Tx: tx, Hashes: []common.Hash{tx.Hash()}}
+
+	// bumped on every check until capped by max gas price, then waits at max price
+	for i := 0; i < 4; i++ {
+		tracker.check()
+	}
+
+	require.Len(t, client.sent, 3)
+	for i, gasPrice := range []int64{120, 144, 150} {
+		sent := client.sent[i]
+		require.Equal(t, tx.Nonce(), sent.Nonce(), "replacement should reuse nonce")
+		require.Equal(t, big.NewInt(gasPrice), sent.GasPrice())
+		require.Equal(t, tx.Data(), sent.Data())
+		require.Equal(t, to, *sent.To())
+	}
+
+	pending := tracker.Pending()
+	require.Len(t, pending, 1)
+	require.Equal(t, client.sent[2].Hash(), pending[0].Tx.Hash())
+	require.Len(t, pending[0].Hashes, 4)
+
+	// nonce is used, tx is forgotten
+	client.minedNonce = 6
+	tracker.check()
+	require.Empty(t, tracker.Pending())
+	require.Len(t, client.sent, 3)
+}
+
+func TestTxTrackerSuggestedPriceCapped(t *testing.T) {
+	setTestTxTracker(t, 150)
+
+	// suggested price above max
+	client := &mockTxClient{minedNonce: 7, suggested: big.NewInt(1000)}
+	tracker := NewTxTracker(client)
+
+	tx := ethTypes.NewTransaction(7, common.HexToAddress("0x1"), big.NewInt(0), 100000, big.NewInt(100), nil)
+	tracker.pending[tx.Nonce()] = &PendingTx{Kind: TickTxKind, Tx: tx, Hashes: []common.Hash{tx.Hash()}}
+
+	tracker.check()
+	require.Len(t, client.sent, 1)
+	require.Equal(t, uint64(7), client.sent[0].Nonce())
+	require.Equal(t, big.NewInt(150), client.sent[0].GasPrice())
+}
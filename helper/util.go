@@ -352,7 +352,7 @@ func GetSignedTxBytes(cliCtx context.CLIContext, txBldr authTypes.TxBuilder, msg
 
 	fromName := cliCtx.GetFromName()
 	if fromName == "" {
-		return txBldr.BuildAndSign(GetPrivKey(), msgs)
+		return BuildAndSignWithSigner(txBldr, GetSigner(), msgs)
 	}
 
 	if cliCtx.Simulate {
@@ -402,7 +402,7 @@ func GetSignedTxBytesWithCLI(cliCtx context.CLIContext, txBldr authTypes.TxBuild
 
 	fromName := cliCtx.GetFromName()
 	if fromName == "" {
-		return txBldr.BuildAndSign(GetPrivKey(), msgs)
+		return BuildAndSignWithSigner(txBldr, GetSigner(), msgs)
 	}
 
 	if cliCtx.Simulate {
@@ -535,7 +535,7 @@ func SignStdTx(
 		return txBldr.SignStdTxWithPassphrase(fromName, passphrase, stdTx, appendSig)
 	}
 
-	return txBldr.SignStdTx(GetPrivKey(), stdTx, appendSig)
+	return SignStdTxWithSigner(txBldr, GetSigner(), stdTx)
 }
 
 // ReadStdTxFromFile and decode a StdTx from the given filename.  Can pass "-" to read from stdin.
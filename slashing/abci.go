@@ -2,6 +2,7 @@ package slashing
 
 import (
 	"fmt"
+	"strconv"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -36,4 +37,15 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
 	for _, voteInfo := range req.LastCommitInfo.GetVotes() {
 		k.HandleValidatorSignature(ctx, voteInfo.Validator.Address, voteInfo.Validator.Power, voteInfo.SignedLastBlock)
 	}
+
+	// announce scheduled tick, ticks on slash limit are announced while slashing
+	if k.IsTickScheduled(ctx) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTickDue,
+				sdk.NewAttribute(types.AttributeKeyTickID, strconv.FormatUint(k.GetTickCount(ctx)+1, 10)),
+				sdk.NewAttribute(types.AttributeKeySlashedAmount, strconv.FormatUint(k.GetTotalSlashedAmount(ctx), 10)),
+			),
+		)
+	}
 }
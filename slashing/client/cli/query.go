@@ -1,13 +1,16 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/maticnetwork/heimdall/slashing/types"
 )
@@ -28,6 +31,9 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		client.GetCommands(
 			// GetCmdQuerySigningInfo(cdc),
 			GetCmdQueryParams(cdc),
+			GetCmdQueryTick(cdc),
+			GetCmdQueryTicks(cdc),
+			GetCmdQueryTickReconciliation(cdc),
 		)...,
 	)
 	return slashingQueryCmd
@@ -99,3 +105,100 @@ $ <appcli> query slashing params
 		},
 	}
 }
+
+// GetCmdQueryTick implements a command to fetch tick by id along with its rootchain status.
+func GetCmdQueryTick(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tick [id]",
+		Short: "Query slashing infos of a tick and its rootchain status",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(`Query slashing infos of a tick and its rootchain status:
+
+$ <appcli> query slashing tick 1
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryTickParams(id))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTick)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var tick types.Tick
+			if err := json.Unmarshal(res, &tick); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(tick)
+		},
+	}
+}
+
+// GetCmdQueryTicks implements a command to fetch all ticks.
+func GetCmdQueryTicks(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ticks",
+		Short: "Query all ticks and their rootchain status",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(`Query all ticks and their rootchain status:
+
+$ <appcli> query slashing ticks --page=1 --limit=10
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryTicksParams(viper.GetInt(FlagPage), viper.GetInt(FlagLimit)))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTicks)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(FlagPage, 1, "pagination page of ticks to query")
+	cmd.Flags().Int(FlagLimit, 100, "pagination limit of ticks to query")
+	return cmd
+}
+
+// GetCmdQueryTickReconciliation implements a command to compare slashed amounts of ticks with rootchain.
+func GetCmdQueryTickReconciliation(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tick-reconciliation",
+		Short: "Compare slashed amounts of ticks with slashed events on rootchain",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(`Compare slashed amounts of ticks with slashed events on rootchain:
+
+$ <appcli> query slashing tick-reconciliation
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTickReconciliation)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
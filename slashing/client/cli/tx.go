@@ -165,7 +165,7 @@ func GetCmdTickAck(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(FlagTxHash, "", "--tx-hash=<transaction-hash>")
 	cmd.Flags().Uint64(FlagBlockNumber, 0, "--block-number=<block-number>")
 	cmd.Flags().String(FlagLogIndex, "", "--log-index=<log-index>")
-	cmd.Flags().Uint64(FlagAmount, 0, "--amount=<amount>")
+	cmd.Flags().Uint64(FlagAmount, 0, "--slashed-amount=<slashed-amount-in-power>")
 	cmd.Flags().Uint64(FlagTickID, 1, "--tick-id=<tick-id>")
 
 	if err := cmd.MarkFlagRequired(FlagBlockNumber); err != nil {
@@ -31,6 +31,11 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 
 	for _, valSlashInfo := range data.BufferValSlashingInfo {
 		keeper.SetBufferValSlashingInfo(ctx, valSlashInfo.ID, *valSlashInfo)
+
+		// total slashed amount is sum of buffered slashing infos, once ticks are activated from first block
+		if data.Params.IsTickActivated(ctx.BlockHeight() + 1) {
+			keeper.UpdateTotalSlashedAmount(ctx, valSlashInfo.SlashedAmount)
+		}
 	}
 
 	for _, tickValSlashInfo := range data.TickValSlashingInfo {
@@ -42,6 +47,10 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	// Set initial tick count
 	keeper.UpdateTickCountWithValue(ctx, data.TickCount)
 
+	for _, tick := range data.Ticks {
+		keeper.SetTick(ctx, tick)
+	}
+
 }
 
 // ExportGenesis writes the current store values
@@ -65,11 +74,14 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) (data types.GenesisState) {
 
 	bufSlashInfos, _ := keeper.GetBufferValSlashingInfos(ctx)
 	tickSlashInfos, _ := keeper.GetTickValSlashingInfos(ctx)
-	return types.NewGenesisState(
+	genesisState := types.NewGenesisState(
 		params,
 		signingInfos,
 		missedBlocks,
 		bufSlashInfos,
 		tickSlashInfos,
 		keeper.GetTickCount(ctx))
+	genesisState.Ticks = keeper.GetTicks(ctx)
+
+	return genesisState
 }
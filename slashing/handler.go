@@ -34,7 +34,7 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 }
 
 // handlerMsgTick  - handles slashing of validators
-// 0. check if slashLimit is exceeded or not.
+// 0. check if slashLimit is exceeded or scheduled tick is due, once ticks are activated.
 // 1. Validate input slashing info hash data
 // 2. If hash matches, copy slashBuffer into latestTickData
 // 3. flushes slashBuffer, totalSlashedAmount
@@ -55,6 +55,12 @@ func handlerMsgTick(ctx sdk.Context, msg types.MsgTick, k Keeper, contractCaller
 		return hmCommon.ErrInvalidMsg(k.Codespace(), "Slashed amount is zero").Result()
 	}
 
+	// check if slash limit is exceeded or scheduled tick height is reached, once ticks are activated
+	if k.IsTickActivated(ctx) && !k.IsTickDue(ctx) {
+		k.Logger(ctx).Error("Tick is not due", "totalSlashedAmount", totalSlashedAmount, "nextTickHeight", k.GetNextTickHeight(ctx))
+		return hmCommon.ErrInvalidMsg(k.Codespace(), "Tick is not due").Result()
+	}
+
 	// check if tick msgs are in continuity
 	tickCount := k.GetTickCount(ctx)
 	if msg.ID != tickCount+1 {
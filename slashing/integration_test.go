@@ -0,0 +1,22 @@
+package slashing_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+)
+
+//
+// Create test app
+//
+
+// returns context and app with params set on slashing keeper
+func createTestApp(isCheckTx bool) (*app.HeimdallApp, sdk.Context, context.CLIContext) {
+	app := app.Setup(isCheckTx)
+	ctx := app.BaseApp.NewContext(isCheckTx, abci.Header{})
+	cliCtx := context.NewCLIContext().WithCodec(app.Codec())
+
+	return app, ctx, cliCtx
+}
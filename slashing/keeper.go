@@ -1,6 +1,7 @@
 package slashing
 
 import (
+	"bytes"
 	"fmt"
 	"strconv"
 
@@ -174,8 +175,15 @@ func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) {
 }
 
 // GetParams gets the slashing module's parameters.
+// TickInterval and TickActivationHeight are read only if they exist, chains started before they were added keep 0.
 func (k *Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	for _, pair := range params.ParamSetPairs() {
+		if bytes.Equal(pair.Key, types.KeyTickInterval) || bytes.Equal(pair.Key, types.KeyTickActivationHeight) {
+			k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+			continue
+		}
+		k.paramSpace.Get(ctx, pair.Key, pair.Value)
+	}
 	return
 }
 
@@ -225,6 +233,133 @@ func (k Keeper) IncrementTickCount(ctx sdk.Context) {
 	store.Set(types.TickCountKey, tickCounts)
 }
 
+// IsTickActivated returns whether tick activation height is reached, see types.Params.IsTickActivated
+func (k Keeper) IsTickActivated(ctx sdk.Context) bool {
+	return k.GetParams(ctx).IsTickActivated(ctx.BlockHeight())
+}
+
+//
+// Ticks
+//
+
+// SetTick sets tick by id
+func (k *Keeper) SetTick(ctx sdk.Context, tick types.Tick) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(tick)
+	store.Set(types.GetTickKey(tick.ID), bz)
+}
+
+// GetTick returns tick by id
+func (k *Keeper) GetTick(ctx sdk.Context, id uint64) (tick types.Tick, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTickKey(id))
+	if bz == nil {
+		return tick, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &tick)
+	return tick, true
+}
+
+// IterateTicks iterates over stored ticks in order of id
+func (k *Keeper) IterateTicks(ctx sdk.Context, handler func(tick types.Tick) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TickKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var tick types.Tick
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &tick)
+		if handler(tick) {
+			break
+		}
+	}
+}
+
+// GetTicks returns all ticks
+func (k *Keeper) GetTicks(ctx sdk.Context) (ticks []types.Tick) {
+	k.IterateTicks(ctx, func(tick types.Tick) bool {
+		ticks = append(ticks, tick)
+		return false
+	})
+	return
+}
+
+// GetTickReconciliation compares slashed amounts of ticks on heimdall with slashed events on rootchain
+func (k *Keeper) GetTickReconciliation(ctx sdk.Context) types.TickReconciliation {
+	reconciliation := types.TickReconciliation{
+		TickCount:             k.GetTickCount(ctx),
+		PendingTicks:          []uint64{},
+		MismatchedTicks:       []uint64{},
+		BufferedSlashedAmount: k.GetTotalSlashedAmount(ctx),
+	}
+
+	k.IterateTicks(ctx, func(tick types.Tick) bool {
+		switch tick.Status {
+		case types.TickStatusPending:
+			reconciliation.PendingTicks = append(reconciliation.PendingTicks, tick.ID)
+			return false
+		case types.TickStatusMismatched:
+			reconciliation.MismatchedTicks = append(reconciliation.MismatchedTicks, tick.ID)
+		}
+
+		reconciliation.TotalSlashedAmount += tick.TotalSlashedAmount
+		reconciliation.RootchainSlashedAmount += tick.RootchainSlashedAmount
+		return false
+	})
+
+	return reconciliation
+}
+
+// GetNextTickHeight returns height from which next tick is due by schedule, zero if ticks are not scheduled
+func (k *Keeper) GetNextTickHeight(ctx sdk.Context) int64 {
+	tickInterval := k.GetParams(ctx).TickInterval
+	if tickInterval <= 0 {
+		return 0
+	}
+
+	var lastTickHeight int64
+	if tick, found := k.GetTick(ctx, k.GetTickCount(ctx)); found {
+		lastTickHeight = tick.Height
+	}
+
+	// first interval boundary after last tick
+	return (lastTickHeight/tickInterval + 1) * tickInterval
+}
+
+// IsTickDue - if buffered slashing infos can be pushed with new tick,
+// either slash limit is exceeded or next scheduled tick height is reached
+func (k *Keeper) IsTickDue(ctx sdk.Context) bool {
+	if k.GetTotalSlashedAmount(ctx) == 0 {
+		return false
+	}
+
+	if k.IsSlashedLimitExceeded(ctx) {
+		return true
+	}
+
+	nextTickHeight := k.GetNextTickHeight(ctx)
+	return nextTickHeight > 0 && ctx.BlockHeight() >= nextTickHeight
+}
+
+// IsTickScheduled - if tick-due event should be emitted at current height.
+// Scheduled ticks are announced on interval boundaries only, once ticks are activated and previous tick is acked.
+func (k *Keeper) IsTickScheduled(ctx sdk.Context) bool {
+	params := k.GetParams(ctx)
+	tickInterval := params.TickInterval
+	if !params.IsTickActivated(ctx.BlockHeight()) || tickInterval <= 0 || ctx.BlockHeight()%tickInterval != 0 {
+		return false
+	}
+
+	nextTickHeight := k.GetNextTickHeight(ctx)
+	if ctx.BlockHeight() < nextTickHeight || k.GetTotalSlashedAmount(ctx) == 0 {
+		return false
+	}
+
+	// previous tick is not yet slashed on rootchain
+	tickSlashingInfos, err := k.GetTickValSlashingInfos(ctx)
+	return err == nil && len(tickSlashingInfos) == 0
+}
+
 // Slashing Info api's
 
 // SlashInterim - Add slash amounts to a buffer and emit <slash-limit> event if exceeded
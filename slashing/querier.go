@@ -25,6 +25,15 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryTickCount:
 			return handleQueryTickCount(ctx, req, k)
 
+		case types.QueryTick:
+			return queryTick(ctx, req, k)
+
+		case types.QueryTicks:
+			return queryTicks(ctx, req, k)
+
+		case types.QueryTickReconciliation:
+			return queryTickReconciliation(ctx, req, k)
+
 		case types.QuerySigningInfo:
 			return querySigningInfo(ctx, req, k)
 
@@ -249,3 +258,55 @@ func querySlashingSequence(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 
 	return bz, nil
 }
+
+func queryTick(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTickParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	tick, found := k.GetTick(ctx, params.ID)
+	if !found {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("tick %d not found", params.ID))
+	}
+
+	// json record
+	bz, err := json.Marshal(tick)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryTicks(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTicksParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	ticks := k.GetTicks(ctx)
+
+	start, end := client.Paginate(len(ticks), params.Page, params.Limit, len(ticks))
+	if start < 0 || end < 0 {
+		ticks = []types.Tick{}
+	} else {
+		ticks = ticks[start:end]
+	}
+
+	// json record
+	bz, err := json.Marshal(ticks)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryTickReconciliation(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(k.GetTickReconciliation(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"encoding/hex"
 	"math/big"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/common"
 	hmCommon "github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/helper"
@@ -71,7 +73,6 @@ func SideHandleMsgTickAck(ctx sdk.Context, k Keeper, msg types.MsgTickAck, contr
 
 	// chainManager params
 	params := k.chainKeeper.GetParams(ctx)
-	chainParams := params.ChainParams
 
 	// get main tx receipt
 	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), params.MainchainTxConfirmations)
@@ -80,7 +81,8 @@ func SideHandleMsgTickAck(ctx sdk.Context, k Keeper, msg types.MsgTickAck, contr
 	}
 
 	// get event log for slashed event
-	eventLog, err := contractCaller.DecodeSlashedEvent(chainParams.StakingInfoAddress.EthAddress(), receipt, msg.LogIndex)
+	stakingInfoAddress := k.chainKeeper.GetLogContractAddress(ctx, chainmanagerTypes.ContractStakingInfo, receipt, msg.LogIndex)
+	eventLog, err := contractCaller.DecodeSlashedEvent(stakingInfoAddress, receipt, msg.LogIndex)
 	if err != nil || eventLog == nil {
 		k.Logger(ctx).Error("Error fetching log from txhash")
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeErrDecodeEvent)
@@ -91,9 +93,23 @@ func SideHandleMsgTickAck(ctx sdk.Context, k Keeper, msg types.MsgTickAck, contr
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
 	}
 
-	if eventLog.Amount.Uint64() != msg.SlashedAmount {
-		k.Logger(ctx).Error("SlashedAmount in message doesn't match SlashedAmount in event logs", "MsgSlashedAmount", msg.SlashedAmount, "SlashedAmountFromEvent", eventLog.Amount)
-		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	if !k.IsTickActivated(ctx) {
+		if eventLog.Amount.Uint64() != msg.SlashedAmount {
+			k.Logger(ctx).Error("SlashedAmount in message doesn't match SlashedAmount in event logs", "MsgSlashedAmount", msg.SlashedAmount, "SlashedAmountFromEvent", eventLog.Amount)
+			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+		}
+	} else {
+		if eventLog.Nonce.Uint64() != msg.ID {
+			k.Logger(ctx).Error("ID in message doesn't match nonce in event logs", "MsgID", msg.ID, "NonceFromEvent", eventLog.Nonce)
+			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+		}
+
+		// slashed amount in message is in power, same as heimdall slashing infos
+		slashedPower, err := helper.GetPowerFromAmount(new(big.Int).Set(eventLog.Amount))
+		if err != nil || slashedPower.Uint64() != msg.SlashedAmount {
+			k.Logger(ctx).Error("SlashedAmount in message doesn't match SlashedAmount in event logs", "MsgSlashedAmount", msg.SlashedAmount, "SlashedAmountFromEvent", eventLog.Amount)
+			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+		}
 	}
 
 	k.Logger(ctx).Debug("✅ Succesfully validated External call for tick-ack msg")
@@ -112,7 +128,6 @@ func SideHandleMsgUnjail(ctx sdk.Context, k Keeper, msg types.MsgUnjail, contrac
 
 	// chainManager params
 	params := k.chainKeeper.GetParams(ctx)
-	chainParams := params.ChainParams
 
 	// get main tx receipt
 	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), params.MainchainTxConfirmations)
@@ -121,7 +136,8 @@ func SideHandleMsgUnjail(ctx sdk.Context, k Keeper, msg types.MsgUnjail, contrac
 	}
 
 	// get unjail event
-	eventLog, err := contractCaller.DecodeUnJailedEvent(chainParams.StakingInfoAddress.EthAddress(), receipt, msg.LogIndex)
+	stakingInfoAddress := k.chainKeeper.GetLogContractAddress(ctx, chainmanagerTypes.ContractStakingInfo, receipt, msg.LogIndex)
+	eventLog, err := contractCaller.DecodeUnJailedEvent(stakingInfoAddress, receipt, msg.LogIndex)
 	if err != nil || eventLog == nil {
 		k.Logger(ctx).Error("Error fetching log from txhash")
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeErrDecodeEvent)
@@ -184,6 +200,12 @@ func PostHandleMsgTick(ctx sdk.Context, k Keeper, msg types.MsgTick, sideTxResul
 
 	k.Logger(ctx).Debug("Persisting tick state", "sideTxResult", sideTxResult)
 
+	// record tick once ticks are activated, to be reconciled with slashed event on rootchain
+	totalSlashedAmount := k.GetTotalSlashedAmount(ctx)
+	if k.IsTickActivated(ctx) {
+		k.SetTick(ctx, types.NewTick(msg.ID, ctx.BlockHeight(), msg.Proposer, valSlashingInfos, totalSlashedAmount))
+	}
+
 	// copy slashBuffer into latestTickData
 	if err := k.CopyBufferValSlashingInfosToTickData(ctx); err != nil {
 		k.Logger(ctx).Error("Error copying bufferSlashInfo to tickSlashInfo", "error", err)
@@ -216,6 +238,8 @@ func PostHandleMsgTick(ctx sdk.Context, k Keeper, msg types.MsgTick, sideTxResul
 			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
 			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer.String()),
 			sdk.NewAttribute(types.AttributeKeySlashInfoBytes, msg.SlashingInfoBytes.String()),
+			sdk.NewAttribute(types.AttributeKeyTickID, strconv.FormatUint(msg.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeySlashedAmount, strconv.FormatUint(totalSlashedAmount, 10)),
 		),
 	})
 
@@ -280,6 +304,30 @@ func PostHandleMsgTickAck(ctx sdk.Context, k Keeper, msg types.MsgTickAck, sideT
 
 	k.Logger(ctx).Debug("Successfully flushed tick slash info in tick-ack handler")
 
+	// reconcile slashed amount of tick with rootchain once ticks are activated, tick-acks carry it in power since then
+	var tickAttributes []sdk.Attribute
+	if k.IsTickActivated(ctx) {
+		tick, found := k.GetTick(ctx, msg.ID)
+		if !found {
+			// tick confirmed before ticks were recorded
+			tick = types.NewTick(msg.ID, 0, hmTypes.ZeroHeimdallAddress, tickSlashInfos, msg.SlashedAmount)
+		}
+
+		tick.Ack(msg.SlashedAmount, msg.TxHash, msg.BlockNumber)
+		k.SetTick(ctx, tick)
+
+		if tick.Status == types.TickStatusMismatched {
+			k.Logger(ctx).Error("Slashed amount on rootchain doesn't match tick", "tickID", tick.ID,
+				"totalSlashedAmount", tick.TotalSlashedAmount, "rootchainSlashedAmount", tick.RootchainSlashedAmount)
+		}
+
+		tickAttributes = []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyTickID, strconv.FormatUint(tick.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyTickStatus, tick.Status),
+			sdk.NewAttribute(types.AttributeKeyRootchainSlashedAmount, strconv.FormatUint(tick.RootchainSlashedAmount, 10)),
+		}
+	}
+
 	// save staking sequence
 	k.SetSlashingSequence(ctx, sequence.String())
 
@@ -290,11 +338,13 @@ func PostHandleMsgTickAck(ctx sdk.Context, k Keeper, msg types.MsgTickAck, sideT
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeTickAck,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                // module name
-			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
-			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
+			append([]sdk.Attribute{
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                // module name
+				sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+				sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
+			}, tickAttributes...)...,
 		),
 	)
 
@@ -26,6 +26,7 @@ const (
 	SlashFractionLimit      = "slash_fraction_limit"
 	JailFractionLimit       = "jail_fraction_limit"
 	MaxEvidenceAge          = "max_evidence_age"
+	TickInterval            = "tick_interval"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -73,6 +74,11 @@ func GenEnableslashing(r *rand.Rand) bool {
 	return (r.Intn(200)%10 == 0)
 }
 
+// GenTickInterval randomized TickInterval
+func GenTickInterval(r *rand.Rand) int64 {
+	return int64(simulation.RandIntBetween(r, 0, 1000))
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -129,9 +135,16 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { enableSlashing = GenEnableslashing(r) },
 	)
 
+	var tickInterval int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TickInterval, &tickInterval, simState.Rand,
+		func(r *rand.Rand) { tickInterval = GenTickInterval(r) },
+	)
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
 		slashFractionDoubleSign, slashFractionDowntime, slashFractionLimit, jailFractionLimit, maxEvidenceAge, enableSlashing,
+		tickInterval, types.DefaultTickActivationHeight,
 	)
 
 	slashingGenesis := types.NewGenesisState(params, nil, nil, nil, nil, uint64(0))
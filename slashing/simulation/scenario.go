@@ -0,0 +1,128 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+	"sort"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Scenario generates begin block requests for a validator set in which
+// offline validators miss every block and equivocating validators double sign at given heights
+type Scenario struct {
+	Validators   []*hmTypes.Validator
+	Offline      map[hmTypes.ValidatorID]bool
+	Equivocation map[int64][]hmTypes.ValidatorID
+}
+
+// NewScenario creates scenario in which all validators sign every block
+func NewScenario(validators []*hmTypes.Validator) *Scenario {
+	return &Scenario{
+		Validators:   validators,
+		Offline:      make(map[hmTypes.ValidatorID]bool),
+		Equivocation: make(map[int64][]hmTypes.ValidatorID),
+	}
+}
+
+// RandomScenario creates scenario in which up to a third of validators go offline
+// and one of the remaining validators double signs at a random height
+func RandomScenario(r *rand.Rand, validators []*hmTypes.Validator, blocks int64) *Scenario {
+	s := NewScenario(validators)
+	if len(validators) == 0 || blocks <= 0 {
+		return s
+	}
+
+	perm := r.Perm(len(validators))
+	offline := r.Intn(len(validators)/3 + 1)
+	for _, i := range perm[:offline] {
+		s.WithOffline(validators[i].ID)
+	}
+
+	if offline < len(perm) {
+		s.WithEquivocation(r.Int63n(blocks)+1, validators[perm[offline]].ID)
+	}
+
+	return s
+}
+
+// WithOffline marks validator to miss every block
+func (s *Scenario) WithOffline(valID hmTypes.ValidatorID) *Scenario {
+	s.Offline[valID] = true
+	return s
+}
+
+// WithEquivocation makes validator double sign at height
+func (s *Scenario) WithEquivocation(height int64, valID hmTypes.ValidatorID) *Scenario {
+	s.Equivocation[height] = append(s.Equivocation[height], valID)
+	return s
+}
+
+// Equivocators returns validators which double sign in scenario, sorted by id
+func (s *Scenario) Equivocators() (valIDs []hmTypes.ValidatorID) {
+	seen := make(map[hmTypes.ValidatorID]bool)
+	for _, ids := range s.Equivocation {
+		for _, id := range ids {
+			if !seen[id] {
+				seen[id] = true
+				valIDs = append(valIDs, id)
+			}
+		}
+	}
+
+	sort.Slice(valIDs, func(i, j int) bool { return valIDs[i] < valIDs[j] })
+	return valIDs
+}
+
+// RequestBeginBlock returns begin block request for header with votes and evidence of scenario
+func (s *Scenario) RequestBeginBlock(header abci.Header) abci.RequestBeginBlock {
+	var totalVotingPower int64
+	for _, validator := range s.Validators {
+		totalVotingPower += validator.VotingPower
+	}
+
+	voteInfos := make([]abci.VoteInfo, 0, len(s.Validators))
+	for _, validator := range s.Validators {
+		voteInfos = append(voteInfos, abci.VoteInfo{
+			Validator:       abciValidator(validator),
+			SignedLastBlock: !s.Offline[validator.ID],
+		})
+	}
+
+	evidence := make([]abci.Evidence, 0)
+	for _, valID := range s.Equivocation[header.Height] {
+		for _, validator := range s.Validators {
+			if validator.ID != valID {
+				continue
+			}
+
+			evidence = append(evidence, abci.Evidence{
+				Type:             tmtypes.ABCIEvidenceTypeDuplicateVote,
+				Validator:        abciValidator(validator),
+				Height:           header.Height,
+				Time:             header.Time,
+				TotalVotingPower: totalVotingPower,
+			})
+		}
+	}
+
+	return abci.RequestBeginBlock{
+		Header: header,
+		LastCommitInfo: abci.LastCommitInfo{
+			Votes: voteInfos,
+		},
+		ByzantineValidators: evidence,
+	}
+}
+
+// abciValidator returns validator as seen by tendermint, addressed by signer
+func abciValidator(validator *hmTypes.Validator) abci.Validator {
+	return abci.Validator{
+		Address: validator.Signer.Bytes(),
+		Power:   validator.VotingPower,
+	}
+}
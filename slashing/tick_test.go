@@ -0,0 +1,383 @@
+package slashing_test
+
+import (
+	"bytes"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/helper/mocks"
+	paramsTypes "github.com/maticnetwork/heimdall/params/types"
+	"github.com/maticnetwork/heimdall/slashing"
+	slashingSim "github.com/maticnetwork/heimdall/slashing/simulation"
+	"github.com/maticnetwork/heimdall/slashing/types"
+	stakingSim "github.com/maticnetwork/heimdall/staking/simulation"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	tickValidators   = 4
+	tickValPower     = 1000
+	tickInterval     = 20
+	tickSignedWindow = 10
+)
+
+//
+// Create test suite
+//
+
+// TickTestSuite runs slashing scenarios through ticks and reconciles them with rootchain
+type TickTestSuite struct {
+	suite.Suite
+
+	app            *app.HeimdallApp
+	ctx            sdk.Context
+	handler        sdk.Handler
+	sideHandler    hmTypes.SideTxHandler
+	postHandler    hmTypes.PostTxHandler
+	contractCaller mocks.IContractCaller
+	scenario       *slashingSim.Scenario
+}
+
+func (suite *TickTestSuite) SetupTest() {
+	suite.app, suite.ctx, _ = createTestApp(false)
+
+	suite.contractCaller = mocks.IContractCaller{}
+	suite.handler = slashing.NewHandler(suite.app.SlashingKeeper, &suite.contractCaller)
+	suite.sideHandler = slashing.NewSideTxHandler(suite.app.SlashingKeeper, &suite.contractCaller)
+	suite.postHandler = slashing.NewPostTxHandler(suite.app.SlashingKeeper, &suite.contractCaller)
+
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	// validators with signing infos
+	var validators []*hmTypes.Validator
+	var valSet hmTypes.ValidatorSet
+	for _, validator := range stakingSim.GenRandomVal(tickValidators, 0, tickValPower, 100, false, 1) {
+		validator := validator
+		require.NoError(t, app.StakingKeeper.AddValidator(ctx, validator))
+		app.SlashingKeeper.SetValidatorSigningInfo(ctx, validator.ID, hmTypes.NewValidatorSigningInfo(validator.ID, 0, 0, 0))
+		valSet.UpdateWithChangeSet([]*hmTypes.Validator{&validator})
+		validators = append(validators, &validator)
+	}
+	require.NoError(t, app.StakingKeeper.UpdateValidatorSetInStore(ctx, valSet))
+
+	// ticks are activated from first block, tick-acks are in power
+	params := app.SlashingKeeper.GetParams(ctx)
+	params.EnableSlashing = true
+	params.SignedBlocksWindow = tickSignedWindow
+	params.TickInterval = tickInterval
+	params.TickActivationHeight = 1
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	// first validator goes offline, second double signs
+	suite.scenario = slashingSim.NewScenario(validators).
+		WithOffline(validators[0].ID).
+		WithEquivocation(5, validators[1].ID)
+}
+
+func TestTickTestSuite(t *testing.T) {
+	suite.Run(t, new(TickTestSuite))
+}
+
+//
+// Test cases
+//
+
+func (suite *TickTestSuite) TestParamsWithoutTickInterval() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	// chain started before tick params were added
+	paramStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramsTypes.StoreKey)), []byte(types.DefaultParamspace+"/"))
+	paramStore.Delete(types.KeyTickInterval)
+	paramStore.Delete(types.KeyTickActivationHeight)
+
+	var params types.Params
+	require.NotPanics(t, func() { params = app.SlashingKeeper.GetParams(ctx) })
+	require.Equal(t, types.DefaultTickInterval, params.TickInterval)
+	require.Zero(t, params.TickActivationHeight)
+	require.Equal(t, int64(tickSignedWindow), params.SignedBlocksWindow)
+	require.Zero(t, app.SlashingKeeper.GetNextTickHeight(ctx))
+	require.False(t, app.SlashingKeeper.IsTickActivated(ctx.WithBlockHeight(1000)))
+}
+
+func (suite *TickTestSuite) TestScenario() {
+	t := suite.T()
+	validators := suite.scenario.Validators
+
+	req := suite.scenario.RequestBeginBlock(abci.Header{Height: 5, Time: time.Now()})
+	require.Len(t, req.LastCommitInfo.Votes, tickValidators)
+	require.False(t, req.LastCommitInfo.Votes[0].SignedLastBlock, "Offline validator should miss block")
+	require.True(t, req.LastCommitInfo.Votes[1].SignedLastBlock)
+	require.Len(t, req.ByzantineValidators, 1)
+	require.Equal(t, validators[1].Signer.Bytes(), req.ByzantineValidators[0].Validator.Address)
+	require.Equal(t, int64(tickValidators*tickValPower), req.ByzantineValidators[0].TotalVotingPower)
+
+	req = suite.scenario.RequestBeginBlock(abci.Header{Height: 6, Time: time.Now()})
+	require.Empty(t, req.ByzantineValidators)
+
+	require.Equal(t, []hmTypes.ValidatorID{validators[1].ID}, suite.scenario.Equivocators())
+
+	r := rand.New(rand.NewSource(1))
+	random := slashingSim.RandomScenario(r, validators, tickInterval)
+	require.Len(t, random.Equivocators(), 1)
+	require.False(t, random.Offline[random.Equivocators()[0]], "Equivocating validator should not be offline")
+}
+
+func (suite *TickTestSuite) TestTickAcked() {
+	t, app := suite.T(), suite.app
+	ctx := suite.runScenario(tickInterval - 1)
+
+	// tick is not due before scheduled height
+	msgTick := suite.msgTick(ctx)
+	result := suite.handler(ctx, msgTick)
+	require.False(t, result.IsOK(), "Tick should not be due before scheduled height")
+
+	ctx = suite.beginBlock(ctx, tickInterval)
+	require.True(t, hasEvent(ctx.EventManager().Events(), types.EventTypeTickDue), "Tick-due event should be emitted at scheduled height")
+
+	totalSlashedAmount := app.SlashingKeeper.GetTotalSlashedAmount(ctx)
+	require.NotZero(t, totalSlashedAmount)
+
+	// buffer holds offline and equivocating validators
+	bufferInfos, err := app.SlashingKeeper.GetBufferValSlashingInfos(ctx)
+	require.NoError(t, err)
+	require.Len(t, bufferInfos, 2)
+
+	suite.confirmTick(ctx)
+
+	tick, found := app.SlashingKeeper.GetTick(ctx, 1)
+	require.True(t, found, "Tick should be recorded")
+	require.Equal(t, types.TickStatusPending, tick.Status)
+	require.Equal(t, int64(tickInterval), tick.Height)
+	require.Equal(t, totalSlashedAmount, tick.TotalSlashedAmount)
+	require.Len(t, tick.SlashingInfos, 2)
+	require.Zero(t, app.SlashingKeeper.GetTotalSlashedAmount(ctx))
+	require.Equal(t, int64(2*tickInterval), app.SlashingKeeper.GetNextTickHeight(ctx))
+
+	reconciliation := app.SlashingKeeper.GetTickReconciliation(ctx)
+	require.Equal(t, []uint64{1}, reconciliation.PendingTicks)
+
+	// rootchain slashed same amount
+	suite.ackTick(ctx, 1, totalSlashedAmount)
+
+	tick, _ = app.SlashingKeeper.GetTick(ctx, 1)
+	require.Equal(t, types.TickStatusAcked, tick.Status)
+	require.Equal(t, totalSlashedAmount, tick.RootchainSlashedAmount)
+
+	tickInfos, err := app.SlashingKeeper.GetTickValSlashingInfos(ctx)
+	require.NoError(t, err)
+	require.Empty(t, tickInfos)
+
+	reconciliation = app.SlashingKeeper.GetTickReconciliation(ctx)
+	require.True(t, reconciliation.IsReconciled())
+	require.Empty(t, reconciliation.PendingTicks)
+	require.Equal(t, totalSlashedAmount, reconciliation.TotalSlashedAmount)
+}
+
+func (suite *TickTestSuite) TestTickMismatched() {
+	t, app := suite.T(), suite.app
+	ctx := suite.runScenario(tickInterval)
+
+	totalSlashedAmount := app.SlashingKeeper.GetTotalSlashedAmount(ctx)
+	suite.confirmTick(ctx)
+
+	// rootchain slashed less than heimdall
+	suite.ackTick(ctx, 1, totalSlashedAmount-1)
+
+	tick, _ := app.SlashingKeeper.GetTick(ctx, 1)
+	require.Equal(t, types.TickStatusMismatched, tick.Status)
+
+	reconciliation := app.SlashingKeeper.GetTickReconciliation(ctx)
+	require.False(t, reconciliation.IsReconciled())
+	require.Equal(t, []uint64{1}, reconciliation.MismatchedTicks)
+	require.Equal(t, totalSlashedAmount-1, reconciliation.RootchainSlashedAmount)
+}
+
+func (suite *TickTestSuite) TestTickAckInvalidAmount() {
+	t, app := suite.T(), suite.app
+	ctx := suite.runScenario(tickInterval)
+
+	totalSlashedAmount := app.SlashingKeeper.GetTotalSlashedAmount(ctx)
+	suite.confirmTick(ctx)
+
+	msg := suite.mockTickAck(ctx, 1, totalSlashedAmount)
+	msg.SlashedAmount = totalSlashedAmount + 1
+
+	result := suite.sideHandler(ctx, msg)
+	require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
+	require.Equal(t, abci.SideTxResultType_Skip, result.Result)
+}
+
+func (suite *TickTestSuite) TestTicksBeforeActivation() {
+	t, app := suite.T(), suite.app
+
+	// chain which didn't reach tick activation height yet
+	params := app.SlashingKeeper.GetParams(suite.ctx)
+	params.TickActivationHeight = 1000
+	app.SlashingKeeper.SetParams(suite.ctx, params)
+
+	ctx := suite.runScenario(tickInterval - 1)
+	require.False(t, app.SlashingKeeper.IsTickActivated(ctx))
+
+	// tick is sent before scheduled height, as on slash-limit
+	suite.confirmTick(ctx)
+
+	// slashed amount is in wei and nonce isn't checked
+	amount, _ := helper.GetAmountFromPower(5)
+	msg := suite.mockTickAckEvent(ctx, 1, 7, amount)
+	msg.SlashedAmount = 5
+
+	sideResult := suite.sideHandler(ctx, msg)
+	require.Equal(t, abci.SideTxResultType_Skip, sideResult.Result, "Slashed amount in power should be rejected before activation")
+
+	msg.SlashedAmount = amount.Uint64()
+	sideResult = suite.sideHandler(ctx, msg)
+	require.Equal(t, abci.SideTxResultType_Yes, sideResult.Result)
+
+	result := suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "Tick-ack post handler should succeed, got %v", result.Log)
+
+	// nothing is stored besides keys slashing had before ticks
+	iter := ctx.KVStore(app.GetKey(types.StoreKey)).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		require.True(t, bytes.Compare(iter.Key()[:1], types.TickKey) < 0, "Key %X written before tick activation", iter.Key())
+	}
+
+	_, found := app.SlashingKeeper.GetTick(ctx, 1)
+	require.False(t, found)
+	require.Equal(t, uint64(1), app.SlashingKeeper.GetTickCount(ctx))
+}
+
+func (suite *TickTestSuite) TestTickAckInvalidNonce() {
+	t, app := suite.T(), suite.app
+	ctx := suite.runScenario(tickInterval)
+
+	totalSlashedAmount := app.SlashingKeeper.GetTotalSlashedAmount(ctx)
+	suite.confirmTick(ctx)
+
+	amount, _ := helper.GetAmountFromPower(int64(totalSlashedAmount))
+	msg := suite.mockTickAckEvent(ctx, 1, 2, amount)
+	msg.SlashedAmount = totalSlashedAmount
+
+	result := suite.sideHandler(ctx, msg)
+	require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Tick-ack of other slashed event should be rejected")
+}
+
+//
+// Helpers
+//
+
+// runScenario runs begin blockers of scenario up to height
+func (suite *TickTestSuite) runScenario(height int64) sdk.Context {
+	ctx := suite.ctx
+	for h := int64(1); h <= height; h++ {
+		ctx = suite.beginBlock(ctx, h)
+		if h < tickInterval {
+			require.False(suite.T(), hasEvent(ctx.EventManager().Events(), types.EventTypeTickDue), "Tick-due event emitted at height %v", h)
+		}
+	}
+
+	return ctx
+}
+
+// beginBlock runs slashing begin blocker at height with fresh event manager
+func (suite *TickTestSuite) beginBlock(ctx sdk.Context, height int64) sdk.Context {
+	header := abci.Header{Height: height, Time: time.Unix(height, 0)}
+	ctx = ctx.WithBlockHeader(header).WithEventManager(sdk.NewEventManager())
+	slashing.BeginBlocker(ctx, suite.scenario.RequestBeginBlock(header), suite.app.SlashingKeeper)
+
+	return ctx
+}
+
+// msgTick returns tick msg for current buffer
+func (suite *TickTestSuite) msgTick(ctx sdk.Context) types.MsgTick {
+	infos, err := suite.app.SlashingKeeper.GetBufferValSlashingInfos(ctx)
+	require.NoError(suite.T(), err)
+
+	slashingInfoBytes, err := types.SortAndRLPEncodeSlashInfos(infos)
+	require.NoError(suite.T(), err)
+
+	return types.NewMsgTick(suite.app.SlashingKeeper.GetTickCount(ctx)+1, suite.scenario.Validators[2].Signer, slashingInfoBytes)
+}
+
+// confirmTick handles tick msg and confirms it with yes votes
+func (suite *TickTestSuite) confirmTick(ctx sdk.Context) {
+	t := suite.T()
+	msg := suite.msgTick(ctx)
+
+	result := suite.handler(ctx, msg)
+	require.True(t, result.IsOK(), "Tick handler should succeed, got %v", result.Log)
+
+	sideResult := suite.sideHandler(ctx, msg)
+	require.Equal(t, abci.SideTxResultType_Yes, sideResult.Result)
+
+	result = suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "Tick post handler should succeed, got %v", result.Log)
+	require.True(t, hasEvent(result.Events, types.EventTypeTickConfirm))
+}
+
+// mockTickAck returns tick-ack msg for slashed event of rootchainSlashedAmount (in power)
+func (suite *TickTestSuite) mockTickAck(ctx sdk.Context, id uint64, rootchainSlashedAmount uint64) types.MsgTickAck {
+	amount, _ := helper.GetAmountFromPower(int64(rootchainSlashedAmount))
+	msg := suite.mockTickAckEvent(ctx, id, id, amount)
+	msg.SlashedAmount = rootchainSlashedAmount
+	return msg
+}
+
+// mockTickAckEvent mocks rootchain slashed event with nonce and amount (in wei), and returns tick-ack msg with id for it
+func (suite *TickTestSuite) mockTickAckEvent(ctx sdk.Context, id uint64, nonce uint64, amount *big.Int) types.MsgTickAck {
+	chainParams := suite.app.ChainKeeper.GetParams(ctx)
+
+	txHash := hmTypes.HexToHeimdallHash("123")
+	logIndex := uint64(0)
+	blockNumber := uint64(100)
+	txReceipt := &ethTypes.Receipt{BlockNumber: new(big.Int).SetUint64(blockNumber)}
+
+	slashed := &stakinginfo.StakinginfoSlashed{
+		Nonce:  new(big.Int).SetUint64(nonce),
+		Amount: amount,
+	}
+
+	suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txReceipt, nil)
+	suite.contractCaller.On("DecodeSlashedEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txReceipt, logIndex).Return(slashed, nil)
+
+	return types.NewMsgTickAck(suite.scenario.Validators[2].Signer, id, 0, txHash, logIndex, blockNumber)
+}
+
+// ackTick validates tick-ack against rootchain and confirms it with yes votes
+func (suite *TickTestSuite) ackTick(ctx sdk.Context, id uint64, rootchainSlashedAmount uint64) {
+	t := suite.T()
+	msg := suite.mockTickAck(ctx, id, rootchainSlashedAmount)
+
+	result := suite.handler(ctx, msg)
+	require.True(t, result.IsOK(), "Tick-ack handler should succeed, got %v", result.Log)
+
+	sideResult := suite.sideHandler(ctx, msg)
+	require.Equal(t, abci.SideTxResultType_Yes, sideResult.Result)
+
+	result = suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "Tick-ack post handler should succeed, got %v", result.Log)
+	require.True(t, hasEvent(result.Events, types.EventTypeTickAck))
+}
+
+func hasEvent(events sdk.Events, eventType string) bool {
+	for _, event := range events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+
+	return false
+}
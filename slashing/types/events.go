@@ -5,6 +5,7 @@ package types
 const (
 	EventTypeSlash       = "slash"
 	EventTypeSlashLimit  = "slash-limit"
+	EventTypeTickDue     = "tick-due"
 	EventTypeTickConfirm = "tick-confirm"
 	EventTypeTickAck     = "tick-ack"
 	EventTypeUnjail      = "unjail"
@@ -21,6 +22,10 @@ const (
 	AttributeKeyJailed         = "jailed"
 	AttributeKeyMissedBlocks   = "missed_blocks"
 
+	AttributeKeyTickID                 = "tick-id"
+	AttributeKeyTickStatus             = "tick-status"
+	AttributeKeyRootchainSlashedAmount = "rootchain-slashed-amount"
+
 	AttributeValueDoubleSign       = "double_sign"
 	AttributeValueMissingSignature = "missing_signature"
 	AttributeValueCategory         = ModuleName
@@ -18,6 +18,7 @@ type GenesisState struct {
 	BufferValSlashingInfo []*hmTypes.ValidatorSlashingInfo        `json:"buffer_val_slash_info" yaml:"buffer_val_slash_info"`
 	TickValSlashingInfo   []*hmTypes.ValidatorSlashingInfo        `json:"tick_val_slash_info" yaml:"tick_val_slash_info"`
 	TickCount             uint64                                  `json:"tick_count" yaml:"tick_count"`
+	Ticks                 []Tick                                  `json:"ticks,omitempty" yaml:"ticks"`
 }
 
 // NewGenesisState creates a new GenesisState object
@@ -90,6 +91,21 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	tickInterval := data.Params.TickInterval
+	if tickInterval < 0 {
+		return fmt.Errorf("tick interval cannot be negative, is %d", tickInterval)
+	}
+
+	if tickActivationHeight := data.Params.TickActivationHeight; tickActivationHeight < 0 {
+		return fmt.Errorf("tick activation height cannot be negative, is %d", tickActivationHeight)
+	}
+
+	for _, tick := range data.Ticks {
+		if tick.ID == 0 || tick.ID > data.TickCount {
+			return fmt.Errorf("tick id must be between 1 and tick count %d, is %d", data.TickCount, tick.ID)
+		}
+	}
+
 	return nil
 }
 
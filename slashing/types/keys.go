@@ -2,6 +2,8 @@ package types
 
 import (
 	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -37,6 +39,7 @@ var (
 	TickValSlashingInfoKey          = []byte{0x06} // Prefix for Slashing Info stored after tick tx
 	SlashingSequenceKey             = []byte{0x07} // prefix for each key for slashing sequence map
 	TickCountKey                    = []byte{0x08} // key to store Tick counts
+	TickKey                         = []byte{0x09} // prefix for ticks by id
 )
 
 // GetValidatorSigningInfoKey - stored by *valID*
@@ -69,3 +72,8 @@ func GetTickValSlashingInfoKey(id []byte) []byte {
 func GetSlashingSequenceKey(sequence string) []byte {
 	return append(SlashingSequenceKey, []byte(sequence)...)
 }
+
+// GetTickKey returns tick key, ids are big endian to iterate ticks in order
+func GetTickKey(id uint64) []byte {
+	return append(TickKey, sdk.Uint64ToBigEndian(id)...)
+}
@@ -142,7 +142,7 @@ var _ sdk.Msg = &MsgTickAck{}
 type MsgTickAck struct {
 	From          types.HeimdallAddress `json:"from"`
 	ID            uint64                `json:"tick_id"`
-	SlashedAmount uint64                `json:"slashed_amount"`
+	SlashedAmount uint64                `json:"slashed_amount"` // in power, converted from slashed event on rootchain
 	TxHash        types.HeimdallHash    `json:"tx_hash"`
 	LogIndex      uint64                `json:"log_index"`
 	BlockNumber   uint64                `json:"block_number"`
@@ -13,6 +13,8 @@ const (
 	DefaultParamspace           = ModuleName
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+	DefaultTickInterval         = int64(0)
+	DefaultTickActivationHeight = int64(1)
 )
 
 var (
@@ -36,6 +38,8 @@ var (
 	KeyJailFractionLimit       = []byte("JailFractionLimit")
 	KeyMaxEvidenceAge          = []byte("MaxEvidenceAge")
 	KeyEnableSlashing          = []byte("EnableSlashing")
+	KeyTickInterval            = []byte("TickInterval")
+	KeyTickActivationHeight    = []byte("TickActivationHeight")
 )
 
 var _ subspace.ParamSet = &Params{}
@@ -51,12 +55,15 @@ type Params struct {
 	JailFractionLimit       sdk.Dec       `json:"jail_fraction_limit" yaml:"jail_fraction_limit"`               // if slashedAmount crossed JailFraction of validatorPower, Jail him
 	MaxEvidenceAge          time.Duration `json:"max_evidence_age" yaml:"max_evidence_age"`
 	EnableSlashing          bool          `json:"enable_slashing" yaml:"enable_slashing"`
+	TickInterval            int64         `json:"tick_interval" yaml:"tick_interval"`                   // blocks between scheduled ticks, 0 sends ticks only on slash-limit
+	TickActivationHeight    int64         `json:"tick_activation_height" yaml:"tick_activation_height"` // height from which ticks are recorded and tick-acks carry slashed amount in power, 0 never
 }
 
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
 	slashFractionDoubleSign, slashFractionDowntime sdk.Dec, slashFractionLimit sdk.Dec, jailFractionLimit sdk.Dec, maxEvidenceAge time.Duration, enableSlashing bool,
+	tickInterval int64, tickActivationHeight int64,
 ) Params {
 
 	return Params{
@@ -69,6 +76,8 @@ func NewParams(
 		SlashFractionLimit:      slashFractionLimit,
 		JailFractionLimit:       jailFractionLimit,
 		EnableSlashing:          enableSlashing,
+		TickInterval:            tickInterval,
+		TickActivationHeight:    tickActivationHeight,
 	}
 }
 
@@ -88,10 +97,12 @@ func (p Params) String() string {
   SlashFractionDowntime:   %s
   SlashFractionLimit:   %s
   JailFractionDowntime:   %s
-  EnableSlashing:   %s`,
+  EnableSlashing:   %t
+  TickInterval:   %d
+  TickActivationHeight:   %d`,
 		p.SignedBlocksWindow, p.MinSignedPerWindow,
 		p.DowntimeJailDuration, p.SlashFractionDoubleSign, p.MaxEvidenceAge,
-		p.SlashFractionDowntime, p.SlashFractionLimit, p.JailFractionLimit, p.EnableSlashing)
+		p.SlashFractionDowntime, p.SlashFractionLimit, p.JailFractionLimit, p.EnableSlashing, p.TickInterval, p.TickActivationHeight)
 }
 
 // ParamSetPairs - Implements params.ParamSet
@@ -106,6 +117,8 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 		{KeyJailFractionLimit, &p.JailFractionLimit},
 		{KeyMaxEvidenceAge, &p.MaxEvidenceAge},
 		{KeyEnableSlashing, &p.EnableSlashing},
+		{KeyTickInterval, &p.TickInterval},
+		{KeyTickActivationHeight, &p.TickActivationHeight},
 	}
 }
 
@@ -114,9 +127,16 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime, DefaultSlashFractionLimit, DefaultJailFractionLimit, DefaultMaxEvidenceAge, DefaultEnableSlashing,
+		DefaultTickInterval, DefaultTickActivationHeight,
 	)
 }
 
+// IsTickActivated returns whether ticks are recorded and tick-acks carry slashed amount in power at given height.
+// Before activation tick-acks carry slashed amount in wei and ticks are sent only on slash-limit.
+func (p Params) IsTickActivated(height int64) bool {
+	return p.TickActivationHeight > 0 && height >= p.TickActivationHeight
+}
+
 func validateSignedBlocksWindow(i interface{}) error {
 	v, ok := i.(int64)
 	if !ok {
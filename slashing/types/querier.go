@@ -8,15 +8,18 @@ import (
 
 // Query endpoints supported by the slashing querier
 const (
-	QueryParameters        = "parameters"
-	QuerySigningInfo       = "signingInfo"
-	QuerySigningInfos      = "signingInfos"
-	QuerySlashingInfo      = "slashingInfo"
-	QuerySlashingInfos     = "slashingInfos"
-	QuerySlashingInfoBytes = "slashingInfoBytes"
-	QueryTickSlashingInfos = "tickSlashingInfos"
-	QuerySlashingSequence  = "slashing-sequence"
-	QueryTickCount         = "tick-count"
+	QueryParameters         = "parameters"
+	QuerySigningInfo        = "signingInfo"
+	QuerySigningInfos       = "signingInfos"
+	QuerySlashingInfo       = "slashingInfo"
+	QuerySlashingInfos      = "slashingInfos"
+	QuerySlashingInfoBytes  = "slashingInfoBytes"
+	QueryTickSlashingInfos  = "tickSlashingInfos"
+	QuerySlashingSequence   = "slashing-sequence"
+	QueryTickCount          = "tick-count"
+	QueryTick               = "tick"
+	QueryTicks              = "ticks"
+	QueryTickReconciliation = "tick-reconciliation"
 )
 
 // QuerySigningInfoParams defines the params for the following queries:
@@ -74,6 +77,28 @@ func NewQueryTickSlashingInfosParams(page, limit int) QueryTickSlashingInfosPara
 	return QueryTickSlashingInfosParams{page, limit}
 }
 
+// QueryTickParams defines the params for the following queries:
+// - 'custom/slashing/tick'
+type QueryTickParams struct {
+	ID uint64
+}
+
+// NewQueryTickParams creates a new QueryTickParams instance
+func NewQueryTickParams(id uint64) QueryTickParams {
+	return QueryTickParams{id}
+}
+
+// QueryTicksParams defines the params for the following queries:
+// - 'custom/slashing/ticks'
+type QueryTicksParams struct {
+	Page, Limit int
+}
+
+// NewQueryTicksParams creates a new QueryTicksParams instance
+func NewQueryTicksParams(page, limit int) QueryTicksParams {
+	return QueryTicksParams{page, limit}
+}
+
 // QuerySlashingSequenceParams defines the params for querying an account Sequence.
 type QuerySlashingSequenceParams struct {
 	TxHash   string
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Tick statuses on rootchain
+const (
+	TickStatusPending    = "pending"    // confirmed on heimdall, waiting for slashed event on rootchain
+	TickStatusAcked      = "acked"      // slashed on rootchain with same amount as heimdall
+	TickStatusMismatched = "mismatched" // slashed on rootchain with different amount than heimdall
+)
+
+// Tick is slashing infos pushed to rootchain in one tick tx
+type Tick struct {
+	ID                 uint64                           `json:"id" yaml:"id"`
+	Height             int64                            `json:"height" yaml:"height"`
+	Proposer           hmTypes.HeimdallAddress          `json:"proposer" yaml:"proposer"`
+	SlashingInfos      []*hmTypes.ValidatorSlashingInfo `json:"slashing_infos" yaml:"slashing_infos"`
+	TotalSlashedAmount uint64                           `json:"total_slashed_amount" yaml:"total_slashed_amount"` // in power, as buffered on heimdall
+
+	// rootchain status, updated by tick-ack
+	Status                 string               `json:"status" yaml:"status"`
+	RootchainSlashedAmount uint64               `json:"rootchain_slashed_amount" yaml:"rootchain_slashed_amount"` // in power, from slashed event
+	AckTxHash              hmTypes.HeimdallHash `json:"ack_tx_hash" yaml:"ack_tx_hash"`
+	AckBlockNumber         uint64               `json:"ack_block_number" yaml:"ack_block_number"`
+}
+
+// NewTick creates pending tick
+func NewTick(id uint64, height int64, proposer hmTypes.HeimdallAddress, slashingInfos []*hmTypes.ValidatorSlashingInfo, totalSlashedAmount uint64) Tick {
+	return Tick{
+		ID:                 id,
+		Height:             height,
+		Proposer:           proposer,
+		SlashingInfos:      slashingInfos,
+		TotalSlashedAmount: totalSlashedAmount,
+		Status:             TickStatusPending,
+	}
+}
+
+// Ack records slashed event of tick on rootchain and reconciles slashed amounts
+func (t *Tick) Ack(rootchainSlashedAmount uint64, txHash hmTypes.HeimdallHash, blockNumber uint64) {
+	t.RootchainSlashedAmount = rootchainSlashedAmount
+	t.AckTxHash = txHash
+	t.AckBlockNumber = blockNumber
+
+	if rootchainSlashedAmount == t.TotalSlashedAmount {
+		t.Status = TickStatusAcked
+	} else {
+		t.Status = TickStatusMismatched
+	}
+}
+
+// String returns human readable tick
+func (t Tick) String() string {
+	return fmt.Sprintf("Tick{%v height:%v proposer:%v slashingInfos:%v totalSlashedAmount:%v status:%v rootchainSlashedAmount:%v ackTxHash:%v}",
+		t.ID, t.Height, t.Proposer.String(), len(t.SlashingInfos), t.TotalSlashedAmount, t.Status, t.RootchainSlashedAmount, t.AckTxHash.Hex())
+}
+
+// TickReconciliation compares slashed amounts of all ticks on heimdall and rootchain
+type TickReconciliation struct {
+	TickCount              uint64   `json:"tick_count" yaml:"tick_count"`
+	PendingTicks           []uint64 `json:"pending_ticks" yaml:"pending_ticks"`
+	MismatchedTicks        []uint64 `json:"mismatched_ticks" yaml:"mismatched_ticks"`
+	TotalSlashedAmount     uint64   `json:"total_slashed_amount" yaml:"total_slashed_amount"`         // slashed in acked ticks on heimdall
+	RootchainSlashedAmount uint64   `json:"rootchain_slashed_amount" yaml:"rootchain_slashed_amount"` // slashed in acked ticks on rootchain
+	BufferedSlashedAmount  uint64   `json:"buffered_slashed_amount" yaml:"buffered_slashed_amount"`   // slashed on heimdall, waiting for next tick
+}
+
+// IsReconciled returns true if every acked tick slashed same amount on heimdall and rootchain
+func (r TickReconciliation) IsReconciled() bool {
+	return len(r.MismatchedTicks) == 0 && r.TotalSlashedAmount == r.RootchainSlashedAmount
+}
@@ -32,6 +32,18 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/staking/validator/{id}",
 		validatorByIDHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/staking/validator/{id}/delegations",
+		validatorDelegationsHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/staking/validator/{id}/delegation/{address}",
+		delegationHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/staking/validator/{id}/commission",
+		validatorCommissionHandlerFn(cliCtx),
+	).Methods("GET")
 	r.HandleFunc(
 		"/staking/validator-set",
 		validatorSetHandlerFn(cliCtx),
@@ -219,6 +231,136 @@ func validatorByIDHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// Returns delegations to validator indexed from rootchain
+func validatorDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get id
+		id, ok := rest.ParseUint64OrReturnBadRequest(w, vars["id"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(hmTypes.ValidatorID(id)))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorDelegations), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching delegations", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// error if no delegations found
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No delegations found"); !ok {
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// Returns delegation of delegator to validator
+func delegationHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get id
+		id, ok := rest.ParseUint64OrReturnBadRequest(w, vars["id"])
+		if !ok {
+			return
+		}
+
+		// get delegator address
+		delegator := hmTypes.HexToHeimdallAddress(vars["address"])
+		if delegator.Empty() {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid delegator address")
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryDelegationParams(hmTypes.ValidatorID(id), delegator))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegation), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching delegation", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// error if no delegation found
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No delegation found"); !ok {
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// Returns commission rate of validator
+func validatorCommissionHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get id
+		id, ok := rest.ParseUint64OrReturnBadRequest(w, vars["id"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(hmTypes.ValidatorID(id)))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorCommission), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching commission", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// error if no commission found
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No commission found"); !ok {
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // get current validator set
 func validatorSetHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -41,14 +41,31 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	for _, sequence := range data.StakingSequences {
 		keeper.SetStakingSequence(ctx, sequence)
 	}
+
+	// add delegations and commissions indexed from rootchain
+	for _, delegation := range data.Delegations {
+		if err := keeper.SetDelegation(ctx, delegation); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, commission := range data.Commissions {
+		if err := keeper.SetValidatorCommission(ctx, commission); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	// return new genesis state
-	return types.NewGenesisState(
+	genesisState := types.NewGenesisState(
 		keeper.GetAllValidators(ctx),
 		keeper.GetValidatorSet(ctx),
 		keeper.GetStakingSequences(ctx),
 	)
+	genesisState.Delegations = keeper.GetAllDelegations(ctx)
+	genesisState.Commissions = keeper.GetAllValidatorCommissions(ctx)
+
+	return genesisState
 }
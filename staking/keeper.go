@@ -23,6 +23,8 @@ var (
 	ValidatorMapKey        = []byte{0x22} // prefix for each key for validator map
 	CurrentValidatorSetKey = []byte{0x23} // Key to store current validator set
 	StakingSequenceKey     = []byte{0x24} // prefix for each key for staking sequence map
+	DelegationKey          = []byte{0x25} // prefix for each key to a delegation
+	ValidatorCommissionKey = []byte{0x26} // prefix for each key to a validator commission
 )
 
 // ModuleCommunicator manages different module interaction
@@ -94,6 +96,21 @@ func GetStakingSequenceKey(sequence string) []byte {
 	return append(StakingSequenceKey, []byte(sequence)...)
 }
 
+// GetValidatorDelegationsKey returns prefix of delegations to validator
+func GetValidatorDelegationsKey(valID hmTypes.ValidatorID) []byte {
+	return append(append(DelegationKey, valID.Bytes()...), '/')
+}
+
+// GetDelegationKey returns delegation key of delegator to validator
+func GetDelegationKey(valID hmTypes.ValidatorID, delegator hmTypes.HeimdallAddress) []byte {
+	return append(GetValidatorDelegationsKey(valID), delegator.Bytes()...)
+}
+
+// GetValidatorCommissionKey returns validator commission key
+func GetValidatorCommissionKey(valID hmTypes.ValidatorID) []byte {
+	return append(ValidatorCommissionKey, valID.Bytes()...)
+}
+
 // AddValidator adds validator indexed with address
 func (k *Keeper) AddValidator(ctx sdk.Context, validator hmTypes.Validator) error {
 	// TODO uncomment
@@ -440,6 +457,128 @@ func (k *Keeper) IterateStakingSequencesAndApplyFn(ctx sdk.Context, f func(seque
 	}
 }
 
+//
+// Delegations
+//
+
+// SetDelegation sets delegation of delegator to validator
+func (k *Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) error {
+	if err := delegation.ValidateBasic(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := k.cdc.MarshalBinaryBare(delegation)
+	if err != nil {
+		return err
+	}
+
+	store.Set(GetDelegationKey(delegation.ValidatorID, delegation.Delegator), bz)
+	return nil
+}
+
+// GetDelegation returns delegation of delegator to validator
+func (k *Keeper) GetDelegation(ctx sdk.Context, valID hmTypes.ValidatorID, delegator hmTypes.HeimdallAddress) (delegation types.Delegation, ok bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(GetDelegationKey(valID, delegator))
+	if bz == nil {
+		return delegation, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &delegation)
+	return delegation, true
+}
+
+// GetValidatorDelegations returns all delegations to validator
+func (k *Keeper) GetValidatorDelegations(ctx sdk.Context, valID hmTypes.ValidatorID) (delegations []types.Delegation) {
+	k.iterateDelegationsAndApplyFn(ctx, GetValidatorDelegationsKey(valID), func(delegation types.Delegation) error {
+		delegations = append(delegations, delegation)
+		return nil
+	})
+	return
+}
+
+// GetAllDelegations returns all delegations
+func (k *Keeper) GetAllDelegations(ctx sdk.Context) (delegations []types.Delegation) {
+	k.IterateDelegationsAndApplyFn(ctx, func(delegation types.Delegation) error {
+		delegations = append(delegations, delegation)
+		return nil
+	})
+	return
+}
+
+// IterateDelegationsAndApplyFn interate delegations and apply the given function.
+func (k *Keeper) IterateDelegationsAndApplyFn(ctx sdk.Context, f func(delegation types.Delegation) error) {
+	k.iterateDelegationsAndApplyFn(ctx, DelegationKey, f)
+}
+
+func (k *Keeper) iterateDelegationsAndApplyFn(ctx sdk.Context, prefix []byte, f func(delegation types.Delegation) error) {
+	store := ctx.KVStore(k.storeKey)
+
+	// get delegation iterator
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	// loop through delegations
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.Delegation
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &delegation)
+
+		// call function and return if required
+		if err := f(delegation); err != nil {
+			return
+		}
+	}
+}
+
+// SetValidatorCommission sets commission rate of validator
+func (k *Keeper) SetValidatorCommission(ctx sdk.Context, commission types.ValidatorCommission) error {
+	if err := commission.ValidateBasic(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := k.cdc.MarshalBinaryBare(commission)
+	if err != nil {
+		return err
+	}
+
+	store.Set(GetValidatorCommissionKey(commission.ValidatorID), bz)
+	return nil
+}
+
+// GetValidatorCommission returns commission rate of validator
+func (k *Keeper) GetValidatorCommission(ctx sdk.Context, valID hmTypes.ValidatorID) (commission types.ValidatorCommission, ok bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(GetValidatorCommissionKey(valID))
+	if bz == nil {
+		return commission, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &commission)
+	return commission, true
+}
+
+// GetAllValidatorCommissions returns commission rates of all validators
+func (k *Keeper) GetAllValidatorCommissions(ctx sdk.Context) (commissions []types.ValidatorCommission) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorCommissionKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var commission types.ValidatorCommission
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &commission)
+		commissions = append(commissions, commission)
+	}
+
+	return
+}
+
 // Slashing api's
 // AddValidatorSigningInfo creates a signing info for validator
 func (k *Keeper) AddValidatorSigningInfo(ctx sdk.Context, valID hmTypes.ValidatorID, valSigningInfo hmTypes.ValidatorSigningInfo) error {
@@ -12,6 +12,7 @@ import (
 
 	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
 	stakingSim "github.com/maticnetwork/heimdall/staking/simulation"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 
 	"github.com/maticnetwork/heimdall/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -368,3 +369,53 @@ func (suite *KeeperTestSuite) TestGetSpanEligibleValidators() {
 	validators := keeper.GetSpanEligibleValidators(ctx)
 	require.LessOrEqual(t, len(validators), 4)
 }
+
+func (suite *KeeperTestSuite) TestDelegation() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+
+	delegator1 := hmTypes.HexToHeimdallAddress("0x1000000000000000000000000000000000000001")
+	delegator2 := hmTypes.HexToHeimdallAddress("0x1000000000000000000000000000000000000002")
+
+	_, ok := keeper.GetDelegation(ctx, hmTypes.NewValidatorID(1), delegator1)
+	require.False(t, ok)
+
+	delegation := stakingTypes.NewDelegation(hmTypes.NewValidatorID(1), delegator1)
+	delegation.Shares = sdk.NewInt(100)
+	delegation.Amount = sdk.NewInt(200)
+	require.NoError(t, keeper.SetDelegation(ctx, delegation))
+	require.NoError(t, keeper.SetDelegation(ctx, stakingTypes.NewDelegation(hmTypes.NewValidatorID(1), delegator2)))
+	require.NoError(t, keeper.SetDelegation(ctx, stakingTypes.NewDelegation(hmTypes.NewValidatorID(10), delegator1)))
+
+	result, ok := keeper.GetDelegation(ctx, hmTypes.NewValidatorID(1), delegator1)
+	require.True(t, ok)
+	require.Equal(t, delegation.Shares, result.Shares)
+	require.Equal(t, delegation.Amount, result.Amount)
+
+	require.Len(t, keeper.GetValidatorDelegations(ctx, hmTypes.NewValidatorID(1)), 2)
+	require.Len(t, keeper.GetValidatorDelegations(ctx, hmTypes.NewValidatorID(10)), 1)
+	require.Len(t, keeper.GetAllDelegations(ctx), 3)
+
+	// invalid delegation
+	require.Error(t, keeper.SetDelegation(ctx, stakingTypes.NewDelegation(hmTypes.NewValidatorID(1), hmTypes.ZeroHeimdallAddress)))
+}
+
+func (suite *KeeperTestSuite) TestValidatorCommission() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+
+	_, ok := keeper.GetValidatorCommission(ctx, hmTypes.NewValidatorID(1))
+	require.False(t, ok)
+
+	commission := stakingTypes.ValidatorCommission{ValidatorID: hmTypes.NewValidatorID(1), CommissionRate: 10}
+	require.NoError(t, keeper.SetValidatorCommission(ctx, commission))
+
+	result, ok := keeper.GetValidatorCommission(ctx, hmTypes.NewValidatorID(1))
+	require.True(t, ok)
+	require.Equal(t, commission, result)
+	require.Len(t, keeper.GetAllValidatorCommissions(ctx), 1)
+
+	// commission rate above max
+	commission.CommissionRate = stakingTypes.MaxCommissionRate + 1
+	require.Error(t, keeper.SetValidatorCommission(ctx, commission))
+}
@@ -34,6 +34,12 @@ func NewQuerier(keeper Keeper, contractCaller helper.IContractCaller) sdk.Querie
 			return handleQueryStakingSequence(ctx, req, keeper, contractCaller)
 		case types.QueryTotalValidatorPower:
 			return handleQueryTotalValidatorPower(ctx, req, keeper)
+		case types.QueryValidatorDelegations:
+			return handleQueryValidatorDelegations(ctx, req, keeper)
+		case types.QueryDelegation:
+			return handleQueryDelegation(ctx, req, keeper)
+		case types.QueryValidatorCommission:
+			return handleQueryValidatorCommission(ctx, req, keeper)
 
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
@@ -193,3 +199,63 @@ func handleQueryStakingSequence(ctx sdk.Context, req abci.RequestQuery, keeper K
 
 	return bz, nil
 }
+
+func handleQueryValidatorDelegations(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	// get delegations of validator
+	delegations := keeper.GetValidatorDelegations(ctx, params.ValidatorID)
+	if delegations == nil {
+		delegations = []types.Delegation{}
+	}
+
+	// json record
+	bz, err := json.Marshal(delegations)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryDelegation(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDelegationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	// get delegation
+	delegation, ok := keeper.GetDelegation(ctx, params.ValidatorID, params.Delegator)
+	if !ok {
+		return nil, sdk.ErrUnknownRequest("No delegation found")
+	}
+
+	// json record
+	bz, err := json.Marshal(delegation)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryValidatorCommission(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	// get commission
+	commission, ok := keeper.GetValidatorCommission(ctx, params.ValidatorID)
+	if !ok {
+		return nil, sdk.ErrUnknownRequest("No commission found")
+	}
+
+	// json record
+	bz, err := json.Marshal(commission)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
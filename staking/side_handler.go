@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/common"
 	hmCommon "github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/helper"
@@ -32,6 +33,14 @@ func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.S
 			return SideHandleMsgSignerUpdate(ctx, msg, k, contractCaller)
 		case types.MsgStakeUpdate:
 			return SideHandleMsgStakeUpdate(ctx, msg, k, contractCaller)
+		case types.MsgShareMinted:
+			return SideHandleMsgShareMinted(ctx, msg, k, contractCaller)
+		case types.MsgShareBurned:
+			return SideHandleMsgShareBurned(ctx, msg, k, contractCaller)
+		case types.MsgDelegatorClaimedRewards:
+			return SideHandleMsgDelegatorClaimedRewards(ctx, msg, k, contractCaller)
+		case types.MsgUpdateCommissionRate:
+			return SideHandleMsgUpdateCommissionRate(ctx, msg, k, contractCaller)
 		default:
 			return abci.ResponseDeliverSideTx{
 				Code: uint32(sdk.CodeUnknownRequest),
@@ -54,6 +63,14 @@ func NewPostTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.P
 			return PostHandleMsgSignerUpdate(ctx, k, msg, sideTxResult)
 		case types.MsgStakeUpdate:
 			return PostHandleMsgStakeUpdate(ctx, k, msg, sideTxResult)
+		case types.MsgShareMinted:
+			return PostHandleMsgShareMinted(ctx, k, msg, sideTxResult)
+		case types.MsgShareBurned:
+			return PostHandleMsgShareBurned(ctx, k, msg, sideTxResult)
+		case types.MsgDelegatorClaimedRewards:
+			return PostHandleMsgDelegatorClaimedRewards(ctx, k, msg, sideTxResult)
+		case types.MsgUpdateCommissionRate:
+			return PostHandleMsgUpdateCommissionRate(ctx, k, msg, sideTxResult)
 		default:
 			return sdk.ErrUnknownRequest("Unrecognized Staking Msg type").Result()
 		}
@@ -71,7 +88,6 @@ func SideHandleMsgValidatorJoin(ctx sdk.Context, msg types.MsgValidatorJoin, k K
 
 	// chainManager params
 	params := k.chainKeeper.GetParams(ctx)
-	chainParams := params.ChainParams
 
 	// get main tx receipt
 	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), params.MainchainTxConfirmations)
@@ -80,7 +96,8 @@ func SideHandleMsgValidatorJoin(ctx sdk.Context, msg types.MsgValidatorJoin, k K
 	}
 
 	// decode validator join event
-	eventLog, err := contractCaller.DecodeValidatorJoinEvent(chainParams.StakingInfoAddress.EthAddress(), receipt, msg.LogIndex)
+	stakingInfoAddress := k.chainKeeper.GetLogContractAddress(ctx, chainmanagerTypes.ContractStakingInfo, receipt, msg.LogIndex)
+	eventLog, err := contractCaller.DecodeValidatorJoinEvent(stakingInfoAddress, receipt, msg.LogIndex)
 	if err != nil || eventLog == nil {
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeErrDecodeEvent)
 	}
@@ -154,7 +171,6 @@ func SideHandleMsgStakeUpdate(ctx sdk.Context, msg types.MsgStakeUpdate, k Keepe
 
 	// chainManager params
 	params := k.chainKeeper.GetParams(ctx)
-	chainParams := params.ChainParams
 
 	// get main tx receipt
 	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), params.MainchainTxConfirmations)
@@ -162,7 +178,8 @@ func SideHandleMsgStakeUpdate(ctx sdk.Context, msg types.MsgStakeUpdate, k Keepe
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeErrDecodeEvent)
 	}
 
-	eventLog, err := contractCaller.DecodeValidatorStakeUpdateEvent(chainParams.StakingInfoAddress.EthAddress(), receipt, msg.LogIndex)
+	stakingInfoAddress := k.chainKeeper.GetLogContractAddress(ctx, chainmanagerTypes.ContractStakingInfo, receipt, msg.LogIndex)
+	eventLog, err := contractCaller.DecodeValidatorStakeUpdateEvent(stakingInfoAddress, receipt, msg.LogIndex)
 	if err != nil || eventLog == nil {
 		k.Logger(ctx).Error("Error fetching log from txhash")
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
@@ -205,7 +222,6 @@ func SideHandleMsgSignerUpdate(ctx sdk.Context, msg types.MsgSignerUpdate, k Kee
 
 	// chainManager params
 	params := k.chainKeeper.GetParams(ctx)
-	chainParams := params.ChainParams
 
 	// get main tx receipt
 	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), params.MainchainTxConfirmations)
@@ -216,7 +232,8 @@ func SideHandleMsgSignerUpdate(ctx sdk.Context, msg types.MsgSignerUpdate, k Kee
 	newPubKey := msg.NewSignerPubKey
 	newSigner := newPubKey.Address()
 
-	eventLog, err := contractCaller.DecodeSignerUpdateEvent(chainParams.StakingInfoAddress.EthAddress(), receipt, msg.LogIndex)
+	stakingInfoAddress := k.chainKeeper.GetLogContractAddress(ctx, chainmanagerTypes.ContractStakingInfo, receipt, msg.LogIndex)
+	eventLog, err := contractCaller.DecodeSignerUpdateEvent(stakingInfoAddress, receipt, msg.LogIndex)
 	if err != nil || eventLog == nil {
 		k.Logger(ctx).Error("Error fetching log from txhash")
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeErrDecodeEvent)
@@ -264,7 +281,6 @@ func SideHandleMsgValidatorExit(ctx sdk.Context, msg types.MsgValidatorExit, k K
 
 	// chainManager params
 	params := k.chainKeeper.GetParams(ctx)
-	chainParams := params.ChainParams
 
 	// get main tx receipt
 	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), params.MainchainTxConfirmations)
@@ -273,7 +289,8 @@ func SideHandleMsgValidatorExit(ctx sdk.Context, msg types.MsgValidatorExit, k K
 	}
 
 	// decode validator exit
-	eventLog, err := contractCaller.DecodeValidatorExitEvent(chainParams.StakingInfoAddress.EthAddress(), receipt, msg.LogIndex)
+	stakingInfoAddress := k.chainKeeper.GetLogContractAddress(ctx, chainmanagerTypes.ContractStakingInfo, receipt, msg.LogIndex)
+	eventLog, err := contractCaller.DecodeValidatorExitEvent(stakingInfoAddress, receipt, msg.LogIndex)
 	if err != nil || eventLog == nil {
 		k.Logger(ctx).Error("Error fetching log from txhash")
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeErrDecodeEvent)
@@ -305,6 +322,219 @@ func SideHandleMsgValidatorExit(ctx sdk.Context, msg types.MsgValidatorExit, k K
 	return
 }
 
+// SideHandleMsgShareMinted handles shares minted message
+func SideHandleMsgShareMinted(ctx sdk.Context, msg types.MsgShareMinted, k Keeper, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	k.Logger(ctx).Debug("✅ Validating External call for shares minted msg",
+		"txHash", hmTypes.BytesToHeimdallHash(msg.TxHash.Bytes()),
+		"logIndex", uint64(msg.LogIndex),
+		"blockNumber", msg.BlockNumber,
+	)
+
+	// chainManager params
+	params := k.chainKeeper.GetParams(ctx)
+
+	// get main tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), params.MainchainTxConfirmations)
+	if err != nil || receipt == nil {
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
+	}
+
+	stakingInfoAddress := k.chainKeeper.GetLogContractAddress(ctx, chainmanagerTypes.ContractStakingInfo, receipt, msg.LogIndex)
+	eventLog, err := contractCaller.DecodeShareMintedEvent(stakingInfoAddress, receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash")
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeErrDecodeEvent)
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesn't match blocknumber in receipt", "MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
+		k.Logger(ctx).Error("ID in message doesn't match with id in log", "msgId", msg.ID, "validatorIdFromTx", eventLog.ValidatorId)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	if !bytes.Equal(eventLog.User.Bytes(), msg.Delegator.Bytes()) {
+		k.Logger(ctx).Error("Delegator in message doesn't match with user in log", "msgDelegator", msg.Delegator.String(), "userFromTx", eventLog.User.Hex())
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	// check amount
+	if eventLog.Amount.Cmp(msg.Amount.BigInt()) != 0 {
+		k.Logger(ctx).Error("Amount in message doesn't match Amount in event logs", "MsgAmount", msg.Amount, "AmountFromEvent", eventLog.Amount)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	// check tokens
+	if eventLog.Tokens.Cmp(msg.Tokens.BigInt()) != 0 {
+		k.Logger(ctx).Error("Tokens in message doesn't match Tokens in event logs", "MsgTokens", msg.Tokens, "TokensFromEvent", eventLog.Tokens)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	k.Logger(ctx).Debug("✅ Succesfully validated External call for shares minted msg")
+	result.Result = abci.SideTxResultType_Yes
+	return
+}
+
+// SideHandleMsgShareBurned handles shares burned message
+func SideHandleMsgShareBurned(ctx sdk.Context, msg types.MsgShareBurned, k Keeper, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	k.Logger(ctx).Debug("✅ Validating External call for shares burned msg",
+		"txHash", hmTypes.BytesToHeimdallHash(msg.TxHash.Bytes()),
+		"logIndex", uint64(msg.LogIndex),
+		"blockNumber", msg.BlockNumber,
+	)
+
+	// chainManager params
+	params := k.chainKeeper.GetParams(ctx)
+
+	// get main tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), params.MainchainTxConfirmations)
+	if err != nil || receipt == nil {
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
+	}
+
+	stakingInfoAddress := k.chainKeeper.GetLogContractAddress(ctx, chainmanagerTypes.ContractStakingInfo, receipt, msg.LogIndex)
+	eventLog, err := contractCaller.DecodeShareBurnedEvent(stakingInfoAddress, receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash")
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeErrDecodeEvent)
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesn't match blocknumber in receipt", "MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
+		k.Logger(ctx).Error("ID in message doesn't match with id in log", "msgId", msg.ID, "validatorIdFromTx", eventLog.ValidatorId)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	if !bytes.Equal(eventLog.User.Bytes(), msg.Delegator.Bytes()) {
+		k.Logger(ctx).Error("Delegator in message doesn't match with user in log", "msgDelegator", msg.Delegator.String(), "userFromTx", eventLog.User.Hex())
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	// check amount
+	if eventLog.Amount.Cmp(msg.Amount.BigInt()) != 0 {
+		k.Logger(ctx).Error("Amount in message doesn't match Amount in event logs", "MsgAmount", msg.Amount, "AmountFromEvent", eventLog.Amount)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	// check tokens
+	if eventLog.Tokens.Cmp(msg.Tokens.BigInt()) != 0 {
+		k.Logger(ctx).Error("Tokens in message doesn't match Tokens in event logs", "MsgTokens", msg.Tokens, "TokensFromEvent", eventLog.Tokens)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	k.Logger(ctx).Debug("✅ Succesfully validated External call for shares burned msg")
+	result.Result = abci.SideTxResultType_Yes
+	return
+}
+
+// SideHandleMsgDelegatorClaimedRewards handles delegator claimed rewards message
+func SideHandleMsgDelegatorClaimedRewards(ctx sdk.Context, msg types.MsgDelegatorClaimedRewards, k Keeper, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	k.Logger(ctx).Debug("✅ Validating External call for delegator claimed rewards msg",
+		"txHash", hmTypes.BytesToHeimdallHash(msg.TxHash.Bytes()),
+		"logIndex", uint64(msg.LogIndex),
+		"blockNumber", msg.BlockNumber,
+	)
+
+	// chainManager params
+	params := k.chainKeeper.GetParams(ctx)
+
+	// get main tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), params.MainchainTxConfirmations)
+	if err != nil || receipt == nil {
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
+	}
+
+	stakingInfoAddress := k.chainKeeper.GetLogContractAddress(ctx, chainmanagerTypes.ContractStakingInfo, receipt, msg.LogIndex)
+	eventLog, err := contractCaller.DecodeDelegatorClaimedRewardsEvent(stakingInfoAddress, receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash")
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeErrDecodeEvent)
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesn't match blocknumber in receipt", "MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
+		k.Logger(ctx).Error("ID in message doesn't match with id in log", "msgId", msg.ID, "validatorIdFromTx", eventLog.ValidatorId)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	if !bytes.Equal(eventLog.User.Bytes(), msg.Delegator.Bytes()) {
+		k.Logger(ctx).Error("Delegator in message doesn't match with user in log", "msgDelegator", msg.Delegator.String(), "userFromTx", eventLog.User.Hex())
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	// check rewards
+	if eventLog.Rewards.Cmp(msg.Rewards.BigInt()) != 0 {
+		k.Logger(ctx).Error("Rewards in message doesn't match Rewards in event logs", "MsgRewards", msg.Rewards, "RewardsFromEvent", eventLog.Rewards)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	// check tokens
+	if eventLog.Tokens.Cmp(msg.Tokens.BigInt()) != 0 {
+		k.Logger(ctx).Error("Tokens in message doesn't match Tokens in event logs", "MsgTokens", msg.Tokens, "TokensFromEvent", eventLog.Tokens)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	k.Logger(ctx).Debug("✅ Succesfully validated External call for delegator claimed rewards msg")
+	result.Result = abci.SideTxResultType_Yes
+	return
+}
+
+// SideHandleMsgUpdateCommissionRate handles commission rate update message
+func SideHandleMsgUpdateCommissionRate(ctx sdk.Context, msg types.MsgUpdateCommissionRate, k Keeper, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	k.Logger(ctx).Debug("✅ Validating External call for commission rate update msg",
+		"txHash", hmTypes.BytesToHeimdallHash(msg.TxHash.Bytes()),
+		"logIndex", uint64(msg.LogIndex),
+		"blockNumber", msg.BlockNumber,
+	)
+
+	// chainManager params
+	params := k.chainKeeper.GetParams(ctx)
+
+	// get main tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), params.MainchainTxConfirmations)
+	if err != nil || receipt == nil {
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
+	}
+
+	stakingInfoAddress := k.chainKeeper.GetLogContractAddress(ctx, chainmanagerTypes.ContractStakingInfo, receipt, msg.LogIndex)
+	eventLog, err := contractCaller.DecodeUpdateCommissionRateEvent(stakingInfoAddress, receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash")
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeErrDecodeEvent)
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesn't match blocknumber in receipt", "MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
+		k.Logger(ctx).Error("ID in message doesn't match with id in log", "msgId", msg.ID, "validatorIdFromTx", eventLog.ValidatorId)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	// check commission rate
+	if !eventLog.NewCommissionRate.IsUint64() || eventLog.NewCommissionRate.Uint64() != msg.NewCommissionRate {
+		k.Logger(ctx).Error("Commission rate in message doesn't match with commission rate in log", "msgCommissionRate", msg.NewCommissionRate, "commissionRateFromTx", eventLog.NewCommissionRate)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	k.Logger(ctx).Debug("✅ Succesfully validated External call for commission rate update msg")
+	result.Result = abci.SideTxResultType_Yes
+	return
+}
+
 /*
 	Post Handlers - update the state of the tx
 **/
@@ -654,3 +884,263 @@ func PostHandleMsgValidatorExit(ctx sdk.Context, k Keeper, msg types.MsgValidato
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+// PostHandleMsgShareMinted handles shares minted message
+func PostHandleMsgShareMinted(ctx sdk.Context, k Keeper, msg types.MsgShareMinted, sideTxResult abci.SideTxResultType) sdk.Result {
+	// Skip handler if shares minted is not approved
+	if sideTxResult != abci.SideTxResultType_Yes {
+		k.Logger(ctx).Debug("Skipping shares minted since side-tx didn't get yes votes")
+		return common.ErrSideTxValidation(k.Codespace()).Result()
+	}
+
+	// Check for replay attack
+	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
+	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
+
+	// check if incoming tx is older
+	if k.HasStakingSequence(ctx, sequence.String()) {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return hmCommon.ErrOldTx(k.Codespace()).Result()
+	}
+
+	k.Logger(ctx).Debug("Persisting shares minted", "sideTxResult", sideTxResult)
+
+	// pull delegation from store, first event of delegator creates it
+	delegation, ok := k.GetDelegation(ctx, msg.ID, msg.Delegator)
+	if !ok {
+		delegation = types.NewDelegation(msg.ID, msg.Delegator)
+	}
+
+	// add minted shares and staked amount
+	delegation.Shares = delegation.Shares.Add(msg.Tokens)
+	delegation.Amount = delegation.Amount.Add(msg.Amount)
+
+	// update last updated
+	delegation.LastUpdated = sequence.String()
+
+	// save delegation
+	if err := k.SetDelegation(ctx, delegation); err != nil {
+		k.Logger(ctx).Error("Unable to save delegation", "error", err, "delegation", delegation.String())
+		return hmCommon.ErrInvalidMsg(k.Codespace(), fmt.Sprintf("Unable to save delegation of %v to validator %v", msg.Delegator, msg.ID)).Result()
+	}
+
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence.String())
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeShareMinted,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                // module name
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
+			sdk.NewAttribute(types.AttributeKeyValidatorID, msg.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.Delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, delegation.Shares.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// PostHandleMsgShareBurned handles shares burned message
+func PostHandleMsgShareBurned(ctx sdk.Context, k Keeper, msg types.MsgShareBurned, sideTxResult abci.SideTxResultType) sdk.Result {
+	// Skip handler if shares burned is not approved
+	if sideTxResult != abci.SideTxResultType_Yes {
+		k.Logger(ctx).Debug("Skipping shares burned since side-tx didn't get yes votes")
+		return common.ErrSideTxValidation(k.Codespace()).Result()
+	}
+
+	// Check for replay attack
+	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
+	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
+
+	// check if incoming tx is older
+	if k.HasStakingSequence(ctx, sequence.String()) {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return hmCommon.ErrOldTx(k.Codespace()).Result()
+	}
+
+	k.Logger(ctx).Debug("Persisting shares burned", "sideTxResult", sideTxResult)
+
+	// pull delegation from store, first event of delegator creates it
+	delegation, ok := k.GetDelegation(ctx, msg.ID, msg.Delegator)
+	if !ok {
+		delegation = types.NewDelegation(msg.ID, msg.Delegator)
+	}
+
+	// remove burned shares and unstaked amount, delegation may predate indexed events
+	delegation.Shares = subFloorZero(delegation.Shares, msg.Tokens)
+	delegation.Amount = subFloorZero(delegation.Amount, msg.Amount)
+
+	// update last updated
+	delegation.LastUpdated = sequence.String()
+
+	// save delegation
+	if err := k.SetDelegation(ctx, delegation); err != nil {
+		k.Logger(ctx).Error("Unable to save delegation", "error", err, "delegation", delegation.String())
+		return hmCommon.ErrInvalidMsg(k.Codespace(), fmt.Sprintf("Unable to save delegation of %v to validator %v", msg.Delegator, msg.ID)).Result()
+	}
+
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence.String())
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeShareBurned,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                // module name
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
+			sdk.NewAttribute(types.AttributeKeyValidatorID, msg.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.Delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, delegation.Shares.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// PostHandleMsgDelegatorClaimedRewards handles delegator claimed rewards message
+func PostHandleMsgDelegatorClaimedRewards(ctx sdk.Context, k Keeper, msg types.MsgDelegatorClaimedRewards, sideTxResult abci.SideTxResultType) sdk.Result {
+	// Skip handler if delegator claimed rewards is not approved
+	if sideTxResult != abci.SideTxResultType_Yes {
+		k.Logger(ctx).Debug("Skipping delegator claimed rewards since side-tx didn't get yes votes")
+		return common.ErrSideTxValidation(k.Codespace()).Result()
+	}
+
+	// Check for replay attack
+	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
+	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
+
+	// check if incoming tx is older
+	if k.HasStakingSequence(ctx, sequence.String()) {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return hmCommon.ErrOldTx(k.Codespace()).Result()
+	}
+
+	k.Logger(ctx).Debug("Persisting delegator claimed rewards", "sideTxResult", sideTxResult)
+
+	// pull delegation from store, first event of delegator creates it
+	delegation, ok := k.GetDelegation(ctx, msg.ID, msg.Delegator)
+	if !ok {
+		delegation = types.NewDelegation(msg.ID, msg.Delegator)
+	}
+
+	// add claimed rewards
+	delegation.ClaimedRewards = delegation.ClaimedRewards.Add(msg.Rewards)
+
+	// update last updated
+	delegation.LastUpdated = sequence.String()
+
+	// save delegation
+	if err := k.SetDelegation(ctx, delegation); err != nil {
+		k.Logger(ctx).Error("Unable to save delegation", "error", err, "delegation", delegation.String())
+		return hmCommon.ErrInvalidMsg(k.Codespace(), fmt.Sprintf("Unable to save delegation of %v to validator %v", msg.Delegator, msg.ID)).Result()
+	}
+
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence.String())
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDelegatorClaimedRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                // module name
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
+			sdk.NewAttribute(types.AttributeKeyValidatorID, msg.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.Delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyRewards, msg.Rewards.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// PostHandleMsgUpdateCommissionRate handles commission rate update message
+func PostHandleMsgUpdateCommissionRate(ctx sdk.Context, k Keeper, msg types.MsgUpdateCommissionRate, sideTxResult abci.SideTxResultType) sdk.Result {
+	// Skip handler if commission rate update is not approved
+	if sideTxResult != abci.SideTxResultType_Yes {
+		k.Logger(ctx).Debug("Skipping commission rate update since side-tx didn't get yes votes")
+		return common.ErrSideTxValidation(k.Codespace()).Result()
+	}
+
+	// Check for replay attack
+	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
+	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
+
+	// check if incoming tx is older
+	if k.HasStakingSequence(ctx, sequence.String()) {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return hmCommon.ErrOldTx(k.Codespace()).Result()
+	}
+
+	k.Logger(ctx).Debug("Persisting commission rate update", "sideTxResult", sideTxResult)
+
+	commission := types.ValidatorCommission{
+		ValidatorID:    msg.ID,
+		CommissionRate: msg.NewCommissionRate,
+		LastUpdated:    sequence.String(),
+	}
+
+	// save commission
+	if err := k.SetValidatorCommission(ctx, commission); err != nil {
+		k.Logger(ctx).Error("Unable to save validator commission", "error", err, "commission", commission.String())
+		return hmCommon.ErrInvalidMsg(k.Codespace(), fmt.Sprintf("Unable to save commission rate of validator %v", msg.ID)).Result()
+	}
+
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence.String())
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateCommissionRate,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                // module name
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
+			sdk.NewAttribute(types.AttributeKeyValidatorID, msg.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyCommissionRate, strconv.FormatUint(msg.NewCommissionRate, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// subFloorZero subtracts b from a, result is never negative
+func subFloorZero(a sdk.Int, b sdk.Int) sdk.Int {
+	if a.LT(b) {
+		return sdk.ZeroInt()
+	}
+
+	return a.Sub(b)
+}
@@ -1349,3 +1349,177 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgStakeUpdate() {
 		require.Equal(t, acctualPower.Int64(), updatedVal.VotingPower, "Validator VotingPower should be updated to %v", newAmount.Uint64())
 	})
 }
+
+func (suite *SideHandlerTestSuite) TestSideHandleMsgShareMinted() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+
+	// pass 0 as time alive to generate non de-activated validators
+	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+	oldVal := keeper.GetValidatorSet(ctx).Validators[0]
+
+	chainParams := app.ChainKeeper.GetParams(ctx)
+
+	msgTxHash := hmTypes.HexToHeimdallHash("123")
+	blockNumber := big.NewInt(10)
+	delegator := hmTypes.HexToHeimdallAddress("0x1000000000000000000000000000000000000001")
+
+	msg := types.NewMsgShareMinted(
+		oldVal.Signer,
+		oldVal.ID.Uint64(),
+		delegator,
+		sdk.NewInt(2000000000000000000),
+		sdk.NewInt(1000),
+		msgTxHash,
+		0,
+		blockNumber.Uint64(),
+	)
+
+	suite.Run("Success", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		txreceipt := &ethTypes.Receipt{BlockNumber: blockNumber}
+		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+
+		stakinginfoShareMinted := &stakinginfo.StakinginfoShareMinted{
+			ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
+			User:        delegator.EthAddress(),
+			Amount:      new(big.Int).SetInt64(2000000000000000000),
+			Tokens:      big.NewInt(1000),
+		}
+		suite.contractCaller.On("DecodeShareMintedEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txreceipt, uint64(0)).Return(stakinginfoShareMinted, nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should be success")
+		require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
+	})
+
+	suite.Run("Invalid delegator", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		txreceipt := &ethTypes.Receipt{BlockNumber: blockNumber}
+		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+
+		stakinginfoShareMinted := &stakinginfo.StakinginfoShareMinted{
+			ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
+			User:        hmTypes.HexToHeimdallAddress("0x1000000000000000000000000000000000000002").EthAddress(),
+			Amount:      new(big.Int).SetInt64(2000000000000000000),
+			Tokens:      big.NewInt(1000),
+		}
+		suite.contractCaller.On("DecodeShareMintedEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txreceipt, uint64(0)).Return(stakinginfoShareMinted, nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should skip")
+	})
+
+	suite.Run("Invalid tokens", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		txreceipt := &ethTypes.Receipt{BlockNumber: blockNumber}
+		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+
+		stakinginfoShareMinted := &stakinginfo.StakinginfoShareMinted{
+			ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
+			User:        delegator.EthAddress(),
+			Amount:      new(big.Int).SetInt64(2000000000000000000),
+			Tokens:      big.NewInt(999),
+		}
+		suite.contractCaller.On("DecodeShareMintedEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txreceipt, uint64(0)).Return(stakinginfoShareMinted, nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should skip")
+	})
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgShareMintedAndBurned() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+
+	// pass 0 as time alive to generate non de-activated validators
+	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+	oldVal := keeper.GetValidatorSet(ctx).Validators[0]
+
+	msgTxHash := hmTypes.HexToHeimdallHash("123")
+	delegator := hmTypes.HexToHeimdallAddress("0x1000000000000000000000000000000000000001")
+
+	minted := types.NewMsgShareMinted(oldVal.Signer, oldVal.ID.Uint64(), delegator, sdk.NewInt(2000), sdk.NewInt(1000), msgTxHash, 0, 10)
+	burned := types.NewMsgShareBurned(oldVal.Signer, oldVal.ID.Uint64(), delegator, sdk.NewInt(500), sdk.NewInt(250), msgTxHash, 1, 10)
+
+	suite.Run("No result", func() {
+		result := suite.postHandler(ctx, minted, abci.SideTxResultType_No)
+		require.False(t, result.IsOK(), errs.CodeToDefaultMsg(result.Code))
+
+		_, ok := keeper.GetDelegation(ctx, oldVal.ID, delegator)
+		require.False(t, ok, "Delegation should not be created")
+	})
+
+	suite.Run("Minted", func() {
+		result := suite.postHandler(ctx, minted, abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "expected share minted to be ok, got %v", result)
+
+		delegation, ok := keeper.GetDelegation(ctx, oldVal.ID, delegator)
+		require.True(t, ok)
+		require.Equal(t, sdk.NewInt(1000), delegation.Shares)
+		require.Equal(t, sdk.NewInt(2000), delegation.Amount)
+	})
+
+	suite.Run("Replay", func() {
+		result := suite.postHandler(ctx, minted, abci.SideTxResultType_Yes)
+		require.False(t, result.IsOK(), "expected replayed share minted to fail")
+	})
+
+	suite.Run("Burned", func() {
+		result := suite.postHandler(ctx, burned, abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "expected share burned to be ok, got %v", result)
+
+		delegation, ok := keeper.GetDelegation(ctx, oldVal.ID, delegator)
+		require.True(t, ok)
+		require.Equal(t, sdk.NewInt(750), delegation.Shares)
+		require.Equal(t, sdk.NewInt(1500), delegation.Amount)
+		require.Len(t, keeper.GetValidatorDelegations(ctx, oldVal.ID), 1)
+	})
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgDelegatorClaimedRewards() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+
+	// pass 0 as time alive to generate non de-activated validators
+	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+	oldVal := keeper.GetValidatorSet(ctx).Validators[0]
+
+	msgTxHash := hmTypes.HexToHeimdallHash("123")
+	delegator := hmTypes.HexToHeimdallAddress("0x1000000000000000000000000000000000000001")
+
+	for i := uint64(0); i < 2; i++ {
+		msg := types.NewMsgDelegatorClaimedRewards(oldVal.Signer, oldVal.ID.Uint64(), delegator, sdk.NewInt(100), sdk.NewInt(0), msgTxHash, i, 10)
+		result := suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "expected claimed rewards to be ok, got %v", result)
+	}
+
+	delegation, ok := keeper.GetDelegation(ctx, oldVal.ID, delegator)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt(200), delegation.ClaimedRewards)
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgUpdateCommissionRate() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+
+	// pass 0 as time alive to generate non de-activated validators
+	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+	oldVal := keeper.GetValidatorSet(ctx).Validators[0]
+
+	msgTxHash := hmTypes.HexToHeimdallHash("123")
+	msg := types.NewMsgUpdateCommissionRate(oldVal.Signer, oldVal.ID.Uint64(), 15, msgTxHash, 0, 10)
+
+	result := suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "expected commission rate update to be ok, got %v", result)
+
+	commission, ok := keeper.GetValidatorCommission(ctx, oldVal.ID)
+	require.True(t, ok)
+	require.Equal(t, uint64(15), commission.CommissionRate)
+	require.Equal(t, "1000000", commission.LastUpdated)
+}
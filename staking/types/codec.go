@@ -11,6 +11,10 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSignerUpdate{}, "staking/MsgSignerUpdate", nil)
 	cdc.RegisterConcrete(MsgValidatorExit{}, "staking/MsgValidatorExit", nil)
 	cdc.RegisterConcrete(MsgStakeUpdate{}, "staking/MsgStakeUpdate", nil)
+	cdc.RegisterConcrete(MsgShareMinted{}, "staking/MsgShareMinted", nil)
+	cdc.RegisterConcrete(MsgShareBurned{}, "staking/MsgShareBurned", nil)
+	cdc.RegisterConcrete(MsgDelegatorClaimedRewards{}, "staking/MsgDelegatorClaimedRewards", nil)
+	cdc.RegisterConcrete(MsgUpdateCommissionRate{}, "staking/MsgUpdateCommissionRate", nil)
 }
 
 // ModuleCdc generic sealed codec to be used throughout module
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// MaxCommissionRate is max commission rate (in percent) a validator can charge its delegators
+const MaxCommissionRate = 100
+
+// Delegation is stake of a delegator in validator share contract, indexed from rootchain events
+type Delegation struct {
+	ValidatorID hmTypes.ValidatorID     `json:"validator_id" yaml:"validator_id"`
+	Delegator   hmTypes.HeimdallAddress `json:"delegator" yaml:"delegator"`
+
+	// validator shares held by delegator
+	Shares sdk.Int `json:"shares" yaml:"shares"`
+	// staked amount, as minted and burned with shares
+	Amount sdk.Int `json:"amount" yaml:"amount"`
+	// total rewards claimed by delegator
+	ClaimedRewards sdk.Int `json:"claimed_rewards" yaml:"claimed_rewards"`
+
+	// staking sequence of last indexed event
+	LastUpdated string `json:"last_updated" yaml:"last_updated"`
+}
+
+// NewDelegation creates empty delegation of delegator to validator
+func NewDelegation(validatorID hmTypes.ValidatorID, delegator hmTypes.HeimdallAddress) Delegation {
+	return Delegation{
+		ValidatorID:    validatorID,
+		Delegator:      delegator,
+		Shares:         sdk.ZeroInt(),
+		Amount:         sdk.ZeroInt(),
+		ClaimedRewards: sdk.ZeroInt(),
+	}
+}
+
+// String returns human readable delegation
+func (d Delegation) String() string {
+	return fmt.Sprintf("Delegation{%v %v shares:%v amount:%v claimedRewards:%v lastUpdated:%v}",
+		d.ValidatorID, d.Delegator.String(), d.Shares, d.Amount, d.ClaimedRewards, d.LastUpdated)
+}
+
+// ValidateBasic validates delegation
+func (d Delegation) ValidateBasic() error {
+	if d.ValidatorID == 0 || d.Delegator.Empty() {
+		return fmt.Errorf("Invalid delegation of %v to validator %v", d.Delegator.String(), d.ValidatorID)
+	}
+
+	return nil
+}
+
+// ValidatorCommission is commission rate (in percent) validator charges on rewards of its delegators
+type ValidatorCommission struct {
+	ValidatorID    hmTypes.ValidatorID `json:"validator_id" yaml:"validator_id"`
+	CommissionRate uint64              `json:"commission_rate" yaml:"commission_rate"`
+
+	// staking sequence of last indexed event
+	LastUpdated string `json:"last_updated" yaml:"last_updated"`
+}
+
+// String returns human readable commission
+func (c ValidatorCommission) String() string {
+	return fmt.Sprintf("ValidatorCommission{%v rate:%v lastUpdated:%v}", c.ValidatorID, c.CommissionRate, c.LastUpdated)
+}
+
+// ValidateBasic validates commission
+func (c ValidatorCommission) ValidateBasic() error {
+	if c.ValidatorID == 0 || c.CommissionRate > MaxCommissionRate {
+		return fmt.Errorf("Invalid commission rate %v of validator %v", c.CommissionRate, c.ValidatorID)
+	}
+
+	return nil
+}
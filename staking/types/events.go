@@ -8,12 +8,21 @@ var (
 	EventTypeStakeUpdate   = "stake-update"
 	EventTypeValidatorExit = "validator-exit"
 
+	EventTypeShareMinted             = "share-minted"
+	EventTypeShareBurned             = "share-burned"
+	EventTypeDelegatorClaimedRewards = "delegator-claimed-rewards"
+	EventTypeUpdateCommissionRate    = "update-commission-rate"
+
 	AttributeKeySigner            = "signer"
 	AttributeKeyDeactivationEpoch = "deactivation-epoch"
 	AttributeKeyActivationEpoch   = "activation-epoch"
 	AttributeKeyValidatorID       = "validator-id"
 	AttributeKeyValidatorNonce    = "validator-nonce"
 	AttributeKeyUpdatedAt         = "updated-at"
+	AttributeKeyDelegator         = "delegator"
+	AttributeKeyShares            = "shares"
+	AttributeKeyRewards           = "rewards"
+	AttributeKeyCommissionRate    = "commission-rate"
 
 	AttributeValueCategory = ModuleName
 )
@@ -37,6 +37,9 @@ type GenesisState struct {
 	Validators       []*hmTypes.Validator `json:"validators" yaml:"validators"`
 	CurrentValSet    hmTypes.ValidatorSet `json:"current_val_set" yaml:"current_val_set"`
 	StakingSequences []string             `json:"staking_sequences" yaml:"staking_sequences"`
+
+	Delegations []Delegation          `json:"delegations,omitempty" yaml:"delegations"`
+	Commissions []ValidatorCommission `json:"commissions,omitempty" yaml:"commissions"`
 }
 
 // NewGenesisState creates a new genesis state.
@@ -70,6 +73,16 @@ func ValidateGenesis(data GenesisState) error {
 			return errors.New("Invalid Sequence")
 		}
 	}
+	for _, delegation := range data.Delegations {
+		if err := delegation.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	for _, commission := range data.Commissions {
+		if err := commission.ValidateBasic(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -372,3 +372,331 @@ func (msg MsgValidatorExit) GetSideSignBytes() []byte {
 func (msg MsgValidatorExit) GetNonce() uint64 {
 	return msg.Nonce
 }
+
+//
+// delegator shares minted
+//
+
+var _ sdk.Msg = &MsgShareMinted{}
+
+// MsgShareMinted represents shares of validator minted to delegator
+type MsgShareMinted struct {
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ID          hmTypes.ValidatorID     `json:"id"`
+	Delegator   hmTypes.HeimdallAddress `json:"delegator"`
+	Amount      sdk.Int                 `json:"amount"`
+	Tokens      sdk.Int                 `json:"tokens"`
+	TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex    uint64                  `json:"log_index"`
+	BlockNumber uint64                  `json:"block_number"`
+}
+
+// NewMsgShareMinted creates new shares minted msg
+func NewMsgShareMinted(from hmTypes.HeimdallAddress, id uint64, delegator hmTypes.HeimdallAddress, amount sdk.Int, tokens sdk.Int, txhash hmTypes.HeimdallHash, logIndex uint64, blockNumber uint64) MsgShareMinted {
+	return MsgShareMinted{
+		From:        from,
+		ID:          hmTypes.NewValidatorID(id),
+		Delegator:   delegator,
+		Amount:      amount,
+		Tokens:      tokens,
+		TxHash:      txhash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
+	}
+}
+
+func (msg MsgShareMinted) Type() string {
+	return "share-minted"
+}
+
+func (msg MsgShareMinted) Route() string {
+	return RouterKey
+}
+
+func (msg MsgShareMinted) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgShareMinted) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgShareMinted) ValidateBasic() sdk.Error {
+	if msg.ID == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
+	}
+
+	if msg.Delegator.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid delegator %v", msg.Delegator.String())
+	}
+
+	return nil
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgShareMinted) GetTxHash() types.HeimdallHash {
+	return msg.TxHash
+}
+
+// GetLogIndex Returns log index
+func (msg MsgShareMinted) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+// GetSideSignBytes returns side sign bytes
+func (msg MsgShareMinted) GetSideSignBytes() []byte {
+	return nil
+}
+
+//
+// delegator shares burned
+//
+
+var _ sdk.Msg = &MsgShareBurned{}
+
+// MsgShareBurned represents shares of validator burned from delegator
+type MsgShareBurned struct {
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ID          hmTypes.ValidatorID     `json:"id"`
+	Delegator   hmTypes.HeimdallAddress `json:"delegator"`
+	Amount      sdk.Int                 `json:"amount"`
+	Tokens      sdk.Int                 `json:"tokens"`
+	TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex    uint64                  `json:"log_index"`
+	BlockNumber uint64                  `json:"block_number"`
+}
+
+// NewMsgShareBurned creates new shares burned msg
+func NewMsgShareBurned(from hmTypes.HeimdallAddress, id uint64, delegator hmTypes.HeimdallAddress, amount sdk.Int, tokens sdk.Int, txhash hmTypes.HeimdallHash, logIndex uint64, blockNumber uint64) MsgShareBurned {
+	return MsgShareBurned{
+		From:        from,
+		ID:          hmTypes.NewValidatorID(id),
+		Delegator:   delegator,
+		Amount:      amount,
+		Tokens:      tokens,
+		TxHash:      txhash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
+	}
+}
+
+func (msg MsgShareBurned) Type() string {
+	return "share-burned"
+}
+
+func (msg MsgShareBurned) Route() string {
+	return RouterKey
+}
+
+func (msg MsgShareBurned) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgShareBurned) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgShareBurned) ValidateBasic() sdk.Error {
+	if msg.ID == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
+	}
+
+	if msg.Delegator.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid delegator %v", msg.Delegator.String())
+	}
+
+	return nil
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgShareBurned) GetTxHash() types.HeimdallHash {
+	return msg.TxHash
+}
+
+// GetLogIndex Returns log index
+func (msg MsgShareBurned) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+// GetSideSignBytes returns side sign bytes
+func (msg MsgShareBurned) GetSideSignBytes() []byte {
+	return nil
+}
+
+//
+// delegator claimed rewards
+//
+
+var _ sdk.Msg = &MsgDelegatorClaimedRewards{}
+
+// MsgDelegatorClaimedRewards represents rewards claimed by delegator from validator
+type MsgDelegatorClaimedRewards struct {
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ID          hmTypes.ValidatorID     `json:"id"`
+	Delegator   hmTypes.HeimdallAddress `json:"delegator"`
+	Rewards     sdk.Int                 `json:"rewards"`
+	Tokens      sdk.Int                 `json:"tokens"`
+	TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex    uint64                  `json:"log_index"`
+	BlockNumber uint64                  `json:"block_number"`
+}
+
+// NewMsgDelegatorClaimedRewards creates new delegator claimed rewards msg
+func NewMsgDelegatorClaimedRewards(from hmTypes.HeimdallAddress, id uint64, delegator hmTypes.HeimdallAddress, rewards sdk.Int, tokens sdk.Int, txhash hmTypes.HeimdallHash, logIndex uint64, blockNumber uint64) MsgDelegatorClaimedRewards {
+	return MsgDelegatorClaimedRewards{
+		From:        from,
+		ID:          hmTypes.NewValidatorID(id),
+		Delegator:   delegator,
+		Rewards:     rewards,
+		Tokens:      tokens,
+		TxHash:      txhash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
+	}
+}
+
+func (msg MsgDelegatorClaimedRewards) Type() string {
+	return "delegator-claimed-rewards"
+}
+
+func (msg MsgDelegatorClaimedRewards) Route() string {
+	return RouterKey
+}
+
+func (msg MsgDelegatorClaimedRewards) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgDelegatorClaimedRewards) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgDelegatorClaimedRewards) ValidateBasic() sdk.Error {
+	if msg.ID == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
+	}
+
+	if msg.Delegator.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid delegator %v", msg.Delegator.String())
+	}
+
+	return nil
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgDelegatorClaimedRewards) GetTxHash() types.HeimdallHash {
+	return msg.TxHash
+}
+
+// GetLogIndex Returns log index
+func (msg MsgDelegatorClaimedRewards) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+// GetSideSignBytes returns side sign bytes
+func (msg MsgDelegatorClaimedRewards) GetSideSignBytes() []byte {
+	return nil
+}
+
+//
+// validator commission rate update
+//
+
+var _ sdk.Msg = &MsgUpdateCommissionRate{}
+
+// MsgUpdateCommissionRate represents commission rate update of validator
+type MsgUpdateCommissionRate struct {
+	From              hmTypes.HeimdallAddress `json:"from"`
+	ID                hmTypes.ValidatorID     `json:"id"`
+	NewCommissionRate uint64                  `json:"new_commission_rate"`
+	TxHash            hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex          uint64                  `json:"log_index"`
+	BlockNumber       uint64                  `json:"block_number"`
+}
+
+// NewMsgUpdateCommissionRate creates new commission rate update msg
+func NewMsgUpdateCommissionRate(from hmTypes.HeimdallAddress, id uint64, newCommissionRate uint64, txhash hmTypes.HeimdallHash, logIndex uint64, blockNumber uint64) MsgUpdateCommissionRate {
+	return MsgUpdateCommissionRate{
+		From:              from,
+		ID:                hmTypes.NewValidatorID(id),
+		NewCommissionRate: newCommissionRate,
+		TxHash:            txhash,
+		LogIndex:          logIndex,
+		BlockNumber:       blockNumber,
+	}
+}
+
+func (msg MsgUpdateCommissionRate) Type() string {
+	return "update-commission-rate"
+}
+
+func (msg MsgUpdateCommissionRate) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUpdateCommissionRate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgUpdateCommissionRate) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgUpdateCommissionRate) ValidateBasic() sdk.Error {
+	if msg.ID == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
+	}
+
+	if msg.NewCommissionRate > MaxCommissionRate {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid commission rate %v", msg.NewCommissionRate)
+	}
+
+	return nil
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgUpdateCommissionRate) GetTxHash() types.HeimdallHash {
+	return msg.TxHash
+}
+
+// GetLogIndex Returns log index
+func (msg MsgUpdateCommissionRate) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+// GetSideSignBytes returns side sign bytes
+func (msg MsgUpdateCommissionRate) GetSideSignBytes() []byte {
+	return nil
+}
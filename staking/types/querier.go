@@ -15,6 +15,9 @@ const (
 	QueryCurrentProposer      = "current-proposer"
 	QueryProposerBonusPercent = "proposer-bonus-percent"
 	QueryStakingSequence      = "staking-sequence"
+	QueryValidatorDelegations = "validator-delegations"
+	QueryDelegation           = "delegation"
+	QueryValidatorCommission  = "validator-commission"
 )
 
 // QuerySignerParams defines the params for querying by address
@@ -37,6 +40,17 @@ func NewQueryValidatorParams(validatorID types.ValidatorID) QueryValidatorParams
 	return QueryValidatorParams{ValidatorID: validatorID}
 }
 
+// QueryDelegationParams defines the params for querying delegation of delegator to validator.
+type QueryDelegationParams struct {
+	ValidatorID types.ValidatorID     `json:"validator_id"`
+	Delegator   types.HeimdallAddress `json:"delegator"`
+}
+
+// NewQueryDelegationParams creates a new instance of QueryDelegationParams.
+func NewQueryDelegationParams(validatorID types.ValidatorID, delegator types.HeimdallAddress) QueryDelegationParams {
+	return QueryDelegationParams{ValidatorID: validatorID, Delegator: delegator}
+}
+
 // QueryProposerParams defines the params for querying val status.
 type QueryProposerParams struct {
 	Times uint64 `json:"times"`
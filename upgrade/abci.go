@@ -0,0 +1,52 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+// BeginBlocker halts the node at the height of current upgrade plan, unless the binary
+// has handler of the plan. Binary with the handler applies the upgrade at that height instead.
+//
+// Binary with the handler halts too if it is started before plan height, so validators
+// don't switch binaries early.
+func BeginBlocker(ctx sdk.Context, _ abci.RequestBeginBlock, k Keeper) {
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return
+	}
+
+	if plan.ShouldExecute(ctx) {
+		if !k.HasHandler(plan.Name) {
+			msg := fmt.Sprintf("UPGRADE \"%s\" NEEDED at %s: %s", plan.Name, plan.DueAt(), plan.Info)
+			k.Logger(ctx).Error(msg)
+
+			// halt the node, operator needs to restart with the binary which knows the plan
+			panic(msg)
+		}
+
+		k.Logger(ctx).Info(fmt.Sprintf("applying upgrade \"%s\" at %s", plan.Name, plan.DueAt()))
+		ctx = ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter())
+		k.ApplyUpgrade(ctx, plan)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUpgrade,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyName, plan.Name),
+				sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", plan.Height)),
+			),
+		)
+		return
+	}
+
+	if k.HasHandler(plan.Name) {
+		msg := fmt.Sprintf("BINARY UPDATED BEFORE TRIGGER! UPGRADE \"%s\" - in binary but not executed on chain", plan.Name)
+		k.Logger(ctx).Error(msg)
+		panic(msg)
+	}
+}
@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+
+	"github.com/maticnetwork/heimdall/upgrade/types"
+	"github.com/maticnetwork/heimdall/version"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the upgrade module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryPlan(cdc),
+			GetCmdQueryApplied(cdc),
+		)...,
+	)
+	return queryCmd
+}
+
+// GetCmdQueryPlan implements the query upgrade plan command.
+func GetCmdQueryPlan(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "plan",
+		Args:  cobra.NoArgs,
+		Short: "get upgrade plan (if one exists)",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the upgrade plan voted by governance, node halts at its height.
+
+Example:
+$ %s query upgrade plan
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCurrent)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			if len(bz) == 0 {
+				return fmt.Errorf("no upgrade scheduled")
+			}
+
+			var plan types.Plan
+			if err = json.Unmarshal(bz, &plan); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(plan)
+		},
+	}
+}
+
+// GetCmdQueryApplied implements the query applied upgrade command.
+func GetCmdQueryApplied(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "applied [upgrade-name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "height at which a completed upgrade was applied",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`If upgrade-name was previously executed on the chain, this returns the height at which it was applied.
+This helps a client determine which binary was valid over a given range of blocks.
+
+Example:
+$ %s query upgrade applied v0.2.0
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryAppliedParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryApplied)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return fmt.Errorf("no upgrade found")
+			}
+
+			var height int64
+			if err = json.Unmarshal(res, &height); err != nil {
+				return err
+			}
+
+			fmt.Println(height)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	govCli "github.com/maticnetwork/heimdall/gov/client/cli"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+var logger = helper.Logger.With("module", "upgrade/client/cli")
+
+// GetCmdSubmitUpgradeProposal implements a command handler for submitting a software upgrade proposal transaction.
+func GetCmdSubmitUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "software-upgrade [name] (--upgrade-height [height]) (--info [info])",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a software upgrade proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a software upgrade proposal along with an initial deposit.
+Once passed, all nodes halt at the upgrade height until they are restarted
+with a binary which has the handler registered for the upgrade name.
+
+Example:
+$ %s tx gov submit-proposal software-upgrade v0.2.0 --upgrade-height=1000000 --info="<git commit>" --title="Upgrade v0.2.0" --description="..." --deposit="1000000000000000000matic" --validator-id=1 --from=<key_or_address>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			deposit, err := sdk.ParseCoins(viper.GetString(govCli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			validatorID := viper.GetUint64(govCli.FlagValidatorID)
+			if validatorID == 0 {
+				return fmt.Errorf("Valid validator ID required")
+			}
+
+			plan := types.NewPlan(args[0], viper.GetInt64(FlagUpgradeHeight), viper.GetString(FlagUpgradeInfo))
+			content := types.NewSoftwareUpgradeProposal(viper.GetString(govCli.FlagTitle), viper.GetString(govCli.FlagDescription), plan)
+
+			from := helper.GetFromAddress(cliCtx)
+			msg := govTypes.NewMsgSubmitProposal(content, deposit, from, hmTypes.NewValidatorID(validatorID))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(govCli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govCli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govCli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Int64(FlagUpgradeHeight, 0, "The height at which the upgrade must happen")
+	cmd.Flags().String(FlagUpgradeInfo, "", "Optional info for the planned upgrade such as commit hash, etc.")
+	cmd.Flags().Int(govCli.FlagValidatorID, 0, "--validator-id=<validator ID here>")
+	if err := cmd.MarkFlagRequired(govCli.FlagValidatorID); err != nil {
+		logger.Error("GetCmdSubmitUpgradeProposal | MarkFlagRequired | FlagValidatorID", "Error", err)
+	}
+	if err := cmd.MarkFlagRequired(FlagUpgradeHeight); err != nil {
+		logger.Error("GetCmdSubmitUpgradeProposal | MarkFlagRequired | FlagUpgradeHeight", "Error", err)
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitCancelUpgradeProposal implements a command handler for submitting a software upgrade cancel proposal transaction.
+func GetCmdSubmitCancelUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-software-upgrade",
+		Args:  cobra.NoArgs,
+		Short: "Submit a proposal to cancel the current software upgrade plan",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to cancel the current software upgrade plan along with an initial deposit.
+
+Example:
+$ %s tx gov submit-proposal cancel-software-upgrade --title="Cancel v0.2.0" --description="..." --deposit="1000000000000000000matic" --validator-id=1 --from=<key_or_address>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			deposit, err := sdk.ParseCoins(viper.GetString(govCli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			validatorID := viper.GetUint64(govCli.FlagValidatorID)
+			if validatorID == 0 {
+				return fmt.Errorf("Valid validator ID required")
+			}
+
+			content := types.NewCancelSoftwareUpgradeProposal(viper.GetString(govCli.FlagTitle), viper.GetString(govCli.FlagDescription))
+
+			from := helper.GetFromAddress(cliCtx)
+			msg := govTypes.NewMsgSubmitProposal(content, deposit, from, hmTypes.NewValidatorID(validatorID))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(govCli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govCli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govCli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Int(govCli.FlagValidatorID, 0, "--validator-id=<validator ID here>")
+	if err := cmd.MarkFlagRequired(govCli.FlagValidatorID); err != nil {
+		logger.Error("GetCmdSubmitCancelUpgradeProposal | MarkFlagRequired | FlagValidatorID", "Error", err)
+	}
+
+	return cmd
+}
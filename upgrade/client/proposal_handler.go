@@ -0,0 +1,13 @@
+package client
+
+import (
+	govclient "github.com/maticnetwork/heimdall/gov/client"
+	"github.com/maticnetwork/heimdall/upgrade/client/cli"
+	"github.com/maticnetwork/heimdall/upgrade/client/rest"
+)
+
+// software upgrade proposal handlers
+var (
+	ProposalHandler       = govclient.NewProposalHandler(cli.GetCmdSubmitUpgradeProposal, rest.ProposalRESTHandler)
+	CancelProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitCancelUpgradeProposal, rest.ProposalCancelRESTHandler)
+)
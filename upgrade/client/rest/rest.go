@@ -0,0 +1,12 @@
+package rest
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+)
+
+// RegisterRoutes registers the upgrade module REST routes.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/upgrade/plan", planHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/upgrade/applied/{name}", appliedHandlerFn(cliCtx)).Methods("GET")
+}
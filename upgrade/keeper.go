@@ -0,0 +1,128 @@
+package upgrade
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+// Keeper stores upgrade plan and heights of applied upgrades
+type Keeper struct {
+	cdc *codec.Codec
+	// The (unexposed) keys used to access the stores from the Context.
+	storeKey sdk.StoreKey
+	// codespace
+	codespace sdk.CodespaceType
+	// handlers of upgrades known to this binary
+	upgradeHandlers map[string]types.UpgradeHandler
+}
+
+// NewKeeper create new keeper
+func NewKeeper(
+	cdc *codec.Codec,
+	storeKey sdk.StoreKey,
+	codespace sdk.CodespaceType,
+) Keeper {
+	return Keeper{
+		cdc:             cdc,
+		storeKey:        storeKey,
+		codespace:       codespace,
+		upgradeHandlers: make(map[string]types.UpgradeHandler),
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// Codespace returns the codespace
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// SetUpgradeHandler registers handler of upgrade with given name. Binary which registers it
+// doesn't halt at plan height and runs the handler instead.
+func (k Keeper) SetUpgradeHandler(name string, upgradeHandler types.UpgradeHandler) {
+	k.upgradeHandlers[name] = upgradeHandler
+}
+
+// HasHandler returns true if binary has handler of upgrade with given name
+func (k Keeper) HasHandler(name string) bool {
+	_, ok := k.upgradeHandlers[name]
+	return ok
+}
+
+// ScheduleUpgrade schedules an upgrade based on the specified plan, it overwrites current plan if any
+func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) sdk.Error {
+	if err := plan.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if plan.Height <= ctx.BlockHeight() {
+		return types.ErrUpgradeHeightPassed(k.codespace, plan.Height, ctx.BlockHeight())
+	}
+
+	if height := k.GetDoneHeight(ctx, plan.Name); height != 0 {
+		return types.ErrUpgradeAlreadyApplied(k.codespace, plan.Name, height)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PlanKey, k.cdc.MustMarshalBinaryBare(plan))
+	return nil
+}
+
+// GetUpgradePlan returns current upgrade plan, false if there is none
+func (k Keeper) GetUpgradePlan(ctx sdk.Context) (plan types.Plan, havePlan bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PlanKey)
+	if bz == nil {
+		return plan, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &plan)
+	return plan, true
+}
+
+// ClearUpgradePlan removes current upgrade plan
+func (k Keeper) ClearUpgradePlan(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PlanKey)
+}
+
+// GetDoneHeight returns height at which upgrade with given name was applied, 0 if it wasn't
+func (k Keeper) GetDoneHeight(ctx sdk.Context, name string) int64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDoneKey(name))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+// setDone marks upgrade with given name applied at current height
+func (k Keeper) setDone(ctx sdk.Context, name string) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(ctx.BlockHeight()))
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDoneKey(name), bz)
+}
+
+// ApplyUpgrade runs handler of the plan, marks it applied and clears it
+func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) {
+	handler, ok := k.upgradeHandlers[plan.Name]
+	if !ok {
+		panic("ApplyUpgrade should never be called without first checking HasHandler")
+	}
+
+	handler(ctx, plan)
+
+	k.ClearUpgradePlan(ctx)
+	k.setDone(ctx, plan.Name)
+}
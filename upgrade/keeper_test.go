@@ -0,0 +1,119 @@
+package upgrade_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/upgrade"
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app *app.HeimdallApp
+	ctx sdk.Context
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{Height: 10})
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// Tests
+
+func (suite *KeeperTestSuite) TestScheduleUpgrade() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	handler := upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)
+
+	_, found := app.UpgradeKeeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+
+	// height must be in the future
+	err := handler(ctx, types.NewSoftwareUpgradeProposal("title", "description", types.NewPlan("test", 10, "")))
+	require.Error(t, err)
+
+	plan := types.NewPlan("test", 20, "info")
+	require.Nil(t, handler(ctx, types.NewSoftwareUpgradeProposal("title", "description", plan)))
+
+	actual, found := app.UpgradeKeeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, plan, actual)
+
+	// cancel
+	require.Nil(t, handler(ctx, types.NewCancelSoftwareUpgradeProposal("title", "description")))
+	_, found = app.UpgradeKeeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+
+	require.Error(t, handler(ctx, types.NewCancelSoftwareUpgradeProposal("title", "description")))
+}
+
+func (suite *KeeperTestSuite) TestBeginBlocker() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	plan := types.NewPlan("test", 20, "")
+	require.Nil(t, app.UpgradeKeeper.ScheduleUpgrade(ctx, plan))
+
+	// nothing happens before plan height
+	require.NotPanics(t, func() {
+		upgrade.BeginBlocker(ctx.WithBlockHeight(19), abci.RequestBeginBlock{}, app.UpgradeKeeper)
+	})
+
+	// binary without handler halts at plan height
+	require.Panics(t, func() {
+		upgrade.BeginBlocker(ctx.WithBlockHeight(20), abci.RequestBeginBlock{}, app.UpgradeKeeper)
+	})
+
+	// binary with handler halts before plan height and applies upgrade at plan height
+	called := false
+	app.UpgradeKeeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan types.Plan) {
+		called = true
+	})
+	require.Panics(t, func() {
+		upgrade.BeginBlocker(ctx.WithBlockHeight(19), abci.RequestBeginBlock{}, app.UpgradeKeeper)
+	})
+	require.NotPanics(t, func() {
+		upgrade.BeginBlocker(ctx.WithBlockHeight(20), abci.RequestBeginBlock{}, app.UpgradeKeeper)
+	})
+	require.True(t, called)
+
+	_, found := app.UpgradeKeeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+	require.Equal(t, int64(20), app.UpgradeKeeper.GetDoneHeight(ctx.WithBlockHeight(20), "test"))
+
+	// applied plan can't be scheduled again
+	require.Error(t, app.UpgradeKeeper.ScheduleUpgrade(ctx, types.NewPlan("test", 30, "")))
+}
+
+func (suite *KeeperTestSuite) TestQuerier() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	querier := upgrade.NewQuerier(app.UpgradeKeeper)
+
+	res, err := querier(ctx, []string{types.QueryCurrent}, abci.RequestQuery{})
+	require.Nil(t, err)
+	require.Empty(t, res)
+
+	plan := types.NewPlan("test", 20, "info")
+	require.Nil(t, app.UpgradeKeeper.ScheduleUpgrade(ctx, plan))
+
+	res, err = querier(ctx, []string{types.QueryCurrent}, abci.RequestQuery{})
+	require.Nil(t, err)
+
+	var actual types.Plan
+	require.NoError(t, json.Unmarshal(res, &actual))
+	require.Equal(t, plan, actual)
+
+	data := app.Codec().MustMarshalJSON(types.NewQueryAppliedParams("test"))
+	res, err = querier(ctx, []string{types.QueryApplied}, abci.RequestQuery{Data: data})
+	require.Nil(t, err)
+	require.Empty(t, res)
+}
@@ -0,0 +1,136 @@
+package upgrade
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	hmModule "github.com/maticnetwork/heimdall/types/module"
+	upgradeCli "github.com/maticnetwork/heimdall/upgrade/client/cli"
+	upgradeRest "github.com/maticnetwork/heimdall/upgrade/client/rest"
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+var (
+	_ module.AppModule             = AppModule{}
+	_ module.AppModuleBasic        = AppModuleBasic{}
+	_ hmModule.HeimdallModuleBasic = AppModule{}
+)
+
+// AppModuleBasic defines the basic application module used by the upgrade module.
+type AppModuleBasic struct{}
+
+// Name returns the upgrade module's name.
+func (AppModuleBasic) Name() string {
+	return types.ModuleName
+}
+
+// RegisterCodec registers the upgrade module's types for the given codec.
+func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
+	types.RegisterCodec(cdc)
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the upgrade
+// module, plans are scheduled only by governance.
+func (AppModuleBasic) DefaultGenesis() json.RawMessage {
+	return []byte("{}")
+}
+
+// ValidateGenesis performs genesis state validation for the upgrade module.
+func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
+	return nil
+}
+
+// VerifyGenesis performs verification on upgrade module state.
+func (AppModuleBasic) VerifyGenesis(bz map[string]json.RawMessage) error {
+	return nil
+}
+
+// RegisterRESTRoutes registers the REST routes for the upgrade module.
+func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router) {
+	upgradeRest.RegisterRoutes(ctx, rtr)
+}
+
+// GetTxCmd returns the root tx command for the upgrade module, proposals are
+// submitted with gov commands.
+func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	return nil
+}
+
+// GetQueryCmd returns the root query command for the upgrade module.
+func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	return upgradeCli.GetQueryCmd(cdc)
+}
+
+//____________________________________________________________________________
+
+// AppModule implements an application module for the upgrade module.
+type AppModule struct {
+	AppModuleBasic
+
+	keeper Keeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(keeper Keeper) AppModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{},
+		keeper:         keeper,
+	}
+}
+
+// Name returns the upgrade module's name.
+func (AppModule) Name() string {
+	return types.ModuleName
+}
+
+// RegisterInvariants performs a no-op.
+func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+
+// Route returns the message routing key for the upgrade module.
+func (AppModule) Route() string {
+	return types.RouterKey
+}
+
+// NewHandler returns an sdk.Handler for the module.
+func (am AppModule) NewHandler() sdk.Handler {
+	return nil
+}
+
+// QuerierRoute returns the upgrade module's querier route name.
+func (AppModule) QuerierRoute() string {
+	return types.QuerierRoute
+}
+
+// NewQuerierHandler returns the upgrade module sdk.Querier.
+func (am AppModule) NewQuerierHandler() sdk.Querier {
+	return NewQuerier(am.keeper)
+}
+
+// InitGenesis is a no-op, it returns no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the upgrade
+// module.
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	return am.DefaultGenesis()
+}
+
+// BeginBlock halts the node at the height of upgrade plan or applies the upgrade.
+func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
+	BeginBlocker(ctx, req, am.keeper)
+}
+
+// EndBlock returns the end blocker for the upgrade module. It returns no validator
+// updates.
+func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}
@@ -0,0 +1,45 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+// NewSoftwareUpgradeProposalHandler creates a governance handler to manage new proposal types.
+// It enables SoftwareUpgradeProposal to propose an upgrade plan, and CancelSoftwareUpgradeProposal
+// to abort a previously voted upgrade.
+func NewSoftwareUpgradeProposalHandler(k Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, content govTypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case types.SoftwareUpgradeProposal:
+			return handleSoftwareUpgradeProposal(ctx, k, c)
+
+		case types.CancelSoftwareUpgradeProposal:
+			return handleCancelSoftwareUpgradeProposal(ctx, k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized software upgrade proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+func handleSoftwareUpgradeProposal(ctx sdk.Context, k Keeper, p types.SoftwareUpgradeProposal) sdk.Error {
+	k.Logger(ctx).Info(fmt.Sprintf("scheduling upgrade; name: %s, height: %d", p.Plan.Name, p.Plan.Height))
+	return k.ScheduleUpgrade(ctx, p.Plan)
+}
+
+func handleCancelSoftwareUpgradeProposal(ctx sdk.Context, k Keeper, p types.CancelSoftwareUpgradeProposal) sdk.Error {
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return types.ErrNoUpgradePlan(k.codespace)
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("cancelling upgrade; name: %s, height: %d", plan.Name, plan.Height))
+	k.ClearUpgradePlan(ctx)
+	return nil
+}
@@ -0,0 +1,55 @@
+package upgrade
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+// NewQuerier creates a querier for upgrade REST endpoints
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryCurrent:
+			return queryCurrent(ctx, req, keeper)
+		case types.QueryApplied:
+			return queryApplied(ctx, req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown upgrade query endpoint")
+		}
+	}
+}
+
+func queryCurrent(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	plan, found := keeper.GetUpgradePlan(ctx)
+	if !found {
+		return nil, nil
+	}
+
+	bz, err := json.Marshal(plan)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryApplied(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAppliedParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to parse params", err.Error()))
+	}
+
+	height := keeper.GetDoneHeight(ctx, params.Name)
+	if height == 0 {
+		return nil, nil
+	}
+
+	bz, err := json.Marshal(height)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
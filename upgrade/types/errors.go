@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Upgrade module codespace constants
+const (
+	DefaultCodespace sdk.CodespaceType = "upgrade"
+
+	CodeInvalidPlan   sdk.CodeType = 1
+	CodeUnknownUpdate sdk.CodeType = 2
+)
+
+// ErrInvalidPlan returns an error for invalid upgrade plan.
+func ErrInvalidPlan(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPlan, fmt.Sprintf("invalid upgrade plan: %s", msg))
+}
+
+// ErrUpgradeAlreadyApplied returns an error for plan which was applied already.
+func ErrUpgradeAlreadyApplied(codespace sdk.CodespaceType, name string, height int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPlan, fmt.Sprintf("upgrade with name %s has already been completed at height %d", name, height))
+}
+
+// ErrUpgradeHeightPassed returns an error for plan with height which is not in the future.
+func ErrUpgradeHeightPassed(codespace sdk.CodespaceType, height int64, blockHeight int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPlan, fmt.Sprintf("upgrade height %d must be greater than current height %d", height, blockHeight))
+}
+
+// ErrNoUpgradePlan returns an error when there is no plan to cancel.
+func ErrNoUpgradePlan(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownUpdate, "no upgrade plan found")
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpgradeHandler runs state migrations of a plan when the new binary reaches plan height
+type UpgradeHandler func(ctx sdk.Context, plan Plan)
+
+// Plan specifies information about a planned upgrade and at which height it should halt the chain
+type Plan struct {
+	// Name is used by the new binary to register its upgrade handler, it must be unique
+	Name string `json:"name" yaml:"name"`
+
+	// Height is the height at which the upgrade must be performed
+	Height int64 `json:"height" yaml:"height"`
+
+	// Info is any application specific upgrade info to be included on-chain,
+	// such as a git commit that validators could automatically upgrade to
+	Info string `json:"info,omitempty" yaml:"info,omitempty"`
+}
+
+// NewPlan creates new upgrade plan
+func NewPlan(name string, height int64, info string) Plan {
+	return Plan{
+		Name:   name,
+		Height: height,
+		Info:   info,
+	}
+}
+
+// String implements the Stringer interface.
+func (p Plan) String() string {
+	return fmt.Sprintf(`Upgrade Plan
+  Name:   %s
+  Height: %d
+  Info:   %s`, p.Name, p.Height, p.Info)
+}
+
+// ValidateBasic does basic validation of plan
+func (p Plan) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(p.Name)) == 0 {
+		return ErrInvalidPlan(DefaultCodespace, "name cannot be empty")
+	}
+
+	if p.Height <= 0 {
+		return ErrInvalidPlan(DefaultCodespace, "height must be greater than 0")
+	}
+
+	return nil
+}
+
+// ShouldExecute returns true if the plan must be executed at given block
+func (p Plan) ShouldExecute(ctx sdk.Context) bool {
+	return p.Height > 0 && p.Height <= ctx.BlockHeight()
+}
+
+// DueAt returns description of the height at which upgrade is due
+func (p Plan) DueAt() string {
+	return fmt.Sprintf("height: %d", p.Height)
+}
@@ -0,0 +1,17 @@
+package types
+
+// query endpoints supported by the upgrade Querier
+const (
+	QueryCurrent = "plan"
+	QueryApplied = "applied"
+)
+
+// QueryAppliedParams is passed as data with QueryApplied
+type QueryAppliedParams struct {
+	Name string `json:"name"`
+}
+
+// NewQueryAppliedParams creates a new instance to query the height at which upgrade was applied
+func NewQueryAppliedParams(name string) QueryAppliedParams {
+	return QueryAppliedParams{Name: name}
+}